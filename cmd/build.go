@@ -12,18 +12,16 @@ const defaultOutputPath = "./docs" // default document export path
 // Document source code path
 var output string
 
+// Documents format
+var format string
+
 // buildCmd represents the start command
 var buildCmd = &cobra.Command{
 	Use:   "build",
 	Short: "Generate documents",
 	Run: func(cmd *cobra.Command, args []string) {
 
-		config := &document.Config{
-			Path:   path,
-			Output: output,
-		}
-
-		corpus, err := document.NewCorpus(config)
+		corpus, err := document.NewCorpus(newConfig(cmd))
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -36,6 +34,7 @@ var buildCmd = &cobra.Command{
 
 func init() {
 	buildCmd.PersistentFlags().StringVarP(&output, "output", "o", defaultOutputPath, "Document source code path")
+	buildCmd.PersistentFlags().StringVarP(&format, "format", "f", document.HTMLFormat, "Documents format: html, json, markdown or bundle")
 
 	rootCmd.AddCommand(buildCmd)
 }
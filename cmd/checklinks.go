@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/miclle/gsd/document"
+	"github.com/spf13/cobra"
+)
+
+// crawl the live documents server instead of the export
+var checkServed bool
+
+// broken links report format
+var checkLinksFormat string
+
+// checkLinksCmd represents the check-links command
+var checkLinksCmd = &cobra.Command{
+	Use:   "check-links",
+	Short: "Report the broken links of the documents",
+	Long: `Crawl the documents from the root and package pages and report the internal
+links to missing pages or anchors, grouped by linking page. The HTML documents
+are exported to a temporary directory, or served by the documents server with
+--serve. The command exits with status 1 if there are broken links.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		config := newConfig(cmd)
+		config.Format = document.HTMLFormat
+
+		// the documents are exported to a temporary directory
+		var tmp string
+		if !checkServed {
+			var err error
+			if tmp, err = os.MkdirTemp("", "gsd-check-links-"); err != nil {
+				log.Fatal(err)
+			}
+			defer os.RemoveAll(tmp)
+			config.Output = tmp
+		}
+
+		corpus, err := document.NewCorpus(config)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		var handler http.Handler
+		if checkServed {
+			if err = corpus.ParsePackages(); err != nil {
+				log.Fatal(err)
+			}
+			handler = corpus.ServeMux()
+		} else {
+			if err = corpus.Export(); err != nil {
+				log.Fatal(err)
+			}
+			handler = corpus.ExportHandler()
+		}
+
+		links, err := corpus.CheckLinks(handler)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		switch checkLinksFormat {
+		case "text":
+			err = document.WriteBrokenLinks(os.Stdout, links)
+		case "json":
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			err = encoder.Encode(links)
+		default:
+			log.Fatalf("unknown check-links format %q", checkLinksFormat)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if len(links) > 0 {
+			log.Printf("%d broken links", len(links))
+			if tmp != "" {
+				os.RemoveAll(tmp)
+			}
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	checkLinksCmd.Flags().BoolVar(&checkServed, "serve", false, "Crawl the documents server instead of the exported documents")
+	checkLinksCmd.Flags().StringVarP(&checkLinksFormat, "format", "f", "text", "Report format: text or json")
+
+	rootCmd.AddCommand(checkLinksCmd)
+}
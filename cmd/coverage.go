@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"io"
+	"log"
+	"os"
+
+	"github.com/miclle/gsd/document"
+	"github.com/spf13/cobra"
+)
+
+// coverage report format
+var coverageFormat string
+
+// coverage report file
+var coverageOutput string
+
+// minimum percentage of documented identifiers
+var coverageMin float64
+
+// coverageCmd represents the coverage command
+var coverageCmd = &cobra.Command{
+	Use:   "coverage",
+	Short: "Report the exported identifiers without doc comment",
+	Long: `Report the exported package clauses, constants, variables, types, fields,
+functions and methods without doc comment, and the percentage of documented
+identifiers of every package and overall. The command exits with status 1 if
+a package does not load.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		config := newConfig(cmd)
+
+		corpus, err := document.NewCorpus(config)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if err = corpus.ParsePackages(); err != nil {
+			log.Fatal(err)
+		}
+
+		coverage, pkgErr := document.SnapshotCoverage(corpus.Snapshot(), config.EnablePrivateIndent)
+
+		var w io.Writer = os.Stdout
+		if coverageOutput != "" {
+			file, err := os.Create(coverageOutput)
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer file.Close()
+			w = file
+		}
+
+		switch coverageFormat {
+		case "text":
+			err = coverage.WriteText(w)
+		case "json":
+			err = coverage.WriteJSON(w)
+		case "junit":
+			err = coverage.WriteJUnit(w)
+		default:
+			log.Fatalf("unknown coverage format %q", coverageFormat)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if pkgErr != nil {
+			log.Println(pkgErr)
+			os.Exit(1)
+		}
+
+		if percent := coverage.Percent(); percent < coverageMin {
+			log.Printf("documentation coverage %.1f%% is below %.1f%%", percent, coverageMin)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	coverageCmd.Flags().StringVarP(&coverageFormat, "format", "f", "text", "Report format: text, json or junit")
+	coverageCmd.Flags().StringVarP(&coverageOutput, "output", "o", "", "Report file, defaults to the standard output")
+	coverageCmd.Flags().Float64Var(&coverageMin, "min", 0, "Exit with status 1 if the percentage of documented identifiers is below min")
+
+	rootCmd.AddCommand(coverageCmd)
+}
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"io"
+	"log"
+	"os"
+
+	"github.com/miclle/gsd/document"
+	"github.com/spf13/cobra"
+)
+
+// API diff report format
+var diffFormat string
+
+// API diff report file
+var diffOutput string
+
+// exit with an error status if there are breaking changes
+var failOnBreaking bool
+
+// diffCmd represents the diff command
+var diffCmd = &cobra.Command{
+	Use:   "diff <old-ref> [new-ref]",
+	Short: "Report the API changes between two git revisions",
+	Long: `Report the added, removed and changed identifiers between two git revisions,
+the new revision defaults to the working tree. The packages which do not load
+in either revision are left out and the command exits with status 1.`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+
+		config := newConfig(cmd)
+
+		oldRef, newRef := args[0], ""
+		if len(args) > 1 {
+			newRef = args[1]
+		}
+
+		base, err := document.LoadRevision(config, oldRef)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		head, err := document.LoadRevision(config, newRef)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		diff, pkgErr := document.DiffSnapshots(base, head, config.EnablePrivateIndent)
+		diff.Old, diff.New = oldRef, newRef
+
+		var w io.Writer = os.Stdout
+		if diffOutput != "" {
+			file, err := os.Create(diffOutput)
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer file.Close()
+			w = file
+		}
+
+		switch diffFormat {
+		case "text":
+			err = diff.WriteText(w)
+		case "json":
+			err = diff.WriteJSON(w)
+		case "html":
+			err = diff.WriteHTML(w)
+		default:
+			log.Fatalf("unknown diff format %q", diffFormat)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if pkgErr != nil {
+			log.Println(pkgErr)
+			os.Exit(1)
+		}
+
+		if n := diff.Breaking(); n > 0 && failOnBreaking {
+			log.Printf("%d breaking changes", n)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	diffCmd.Flags().StringVarP(&diffFormat, "format", "f", "text", "Report format: text, json or html")
+	diffCmd.Flags().StringVarP(&diffOutput, "output", "o", "", "Report file, defaults to the standard output")
+	diffCmd.Flags().BoolVar(&failOnBreaking, "fail", false, "Exit with status 1 if there are breaking changes")
+
+	rootCmd.AddCommand(diffCmd)
+}
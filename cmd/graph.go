@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"io"
+	"log"
+	"os"
+
+	"github.com/miclle/gsd/document"
+	"github.com/spf13/cobra"
+)
+
+// import graph format
+var graphFormat string
+
+// import graph file
+var graphOutput string
+
+// graphCmd represents the graph command
+var graphCmd = &cobra.Command{
+	Use:   "graph",
+	Short: "Write the import graph of the packages",
+	Long: `Write the imports between the packages as a Graphviz DOT graph, JSON or an
+SVG image, with the import cycles and the imports of internal packages from
+outside their tree highlighted.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		config := newConfig(cmd)
+
+		corpus, err := document.NewCorpus(config)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if err = corpus.ParsePackages(); err != nil {
+			log.Fatal(err)
+		}
+
+		graph := corpus.Snapshot().ImportGraph()
+
+		var w io.Writer = os.Stdout
+		if graphOutput != "" {
+			file, err := os.Create(graphOutput)
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer file.Close()
+			w = file
+		}
+
+		switch graphFormat {
+		case "dot":
+			err = graph.WriteDOT(w)
+		case "json":
+			err = graph.WriteJSON(w)
+		case "svg":
+			err = graph.WriteSVG(w, corpus.PackageURL)
+		default:
+			log.Fatalf("unknown graph format %q", graphFormat)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+func init() {
+	graphCmd.Flags().StringVarP(&graphFormat, "format", "f", "dot", "Graph format: dot, json or svg")
+	graphCmd.Flags().StringVarP(&graphOutput, "output", "o", "", "Graph file, defaults to the standard output")
+
+	rootCmd.AddCommand(graphCmd)
+}
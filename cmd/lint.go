@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/miclle/gsd/document"
+	"github.com/spf13/cobra"
+)
+
+// lint report format
+var lintFormat string
+
+// lintCmd represents the lint command
+var lintCmd = &cobra.Command{
+	Use:   "lint",
+	Short: "Check the doc comments",
+	Long: `Check the doc comments of the exported identifiers: the comments start with
+the identifier name, the deprecation notices are "Deprecated: " paragraphs,
+the "@gsd:" markers are known, the Markdown is well formed and the doc links
+resolve. The issues are reported as file:line:column, the command exits with
+status 1 if there are any or if a package does not load.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		config := newConfig(cmd)
+
+		corpus, err := document.NewCorpus(config)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if err = corpus.ParsePackages(); err != nil {
+			log.Fatal(err)
+		}
+
+		issues, pkgErr := document.LintSnapshot(corpus.Snapshot(), config.EnablePrivateIndent)
+
+		switch lintFormat {
+		case "text":
+			err = document.WriteLintText(os.Stdout, issues)
+		case "json":
+			err = document.WriteLintJSON(os.Stdout, issues)
+		default:
+			log.Fatalf("unknown lint format %q", lintFormat)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if pkgErr != nil {
+			log.Println(pkgErr)
+			os.Exit(1)
+		}
+
+		if len(issues) > 0 {
+			log.Printf("%d doc comment issues", len(issues))
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	lintCmd.Flags().StringVarP(&lintFormat, "format", "f", "text", "Report format: text or json")
+
+	rootCmd.AddCommand(lintCmd)
+}
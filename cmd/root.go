@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"log"
 	"os"
 
 	"github.com/miclle/gsd/document"
@@ -18,6 +19,30 @@ var path string
 // exclude paths
 var excludes []string
 
+// number of packages analyzed in parallel
+var workers int
+
+// exclude the paths ignored by the .gitignore files
+var gitignore bool
+
+// display the unexported identifiers
+var private bool
+
+// document the test-only identifiers
+var tests bool
+
+// documents theme
+var theme string
+
+// show the release adding the identifiers
+var versions bool
+
+// URL the documents are hosted at
+var baseURL string
+
+// project config file
+var configFile string
+
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
 	Use:     "gsd",
@@ -41,10 +66,82 @@ func init() {
 	cobra.OnInitialize(initConfig)
 
 	rootCmd.PersistentFlags().StringVarP(&path, "path", "p", defaultPath, "Document source code path")
-	rootCmd.PersistentFlags().StringSliceVarP(&excludes, "exclude", "e", []string{}, "Exclude paths")
+	rootCmd.PersistentFlags().StringSliceVarP(&excludes, "exclude", "e", []string{}, "Exclude paths, gitignore-style glob patterns")
+	rootCmd.PersistentFlags().BoolVar(&gitignore, "gitignore", false, "Exclude the paths ignored by the .gitignore files")
+	rootCmd.PersistentFlags().IntVarP(&workers, "workers", "j", 0, "Number of packages analyzed in parallel, defaults to GOMAXPROCS")
+	rootCmd.PersistentFlags().BoolVar(&private, "private", false, "Display unexported identifiers")
+	rootCmd.PersistentFlags().BoolVar(&tests, "tests", false, "Document the test-only identifiers and the external test packages")
+	rootCmd.PersistentFlags().StringVar(&theme, "theme", document.LightTheme, "Documents theme: light, dark or auto")
+	rootCmd.PersistentFlags().BoolVar(&versions, "versions", false, "Show the release adding the identifiers, from the semver git tags")
+	rootCmd.PersistentFlags().StringVar(&baseURL, "base-url", "", "URL the documents are hosted at, e.g. https://intranet/docs/myservice/")
+	rootCmd.PersistentFlags().StringVarP(&configFile, "config", "c", "", "Config file, defaults to the "+document.ConfigFilename+" found from the source code path upward")
 }
 
-// initConfig reads in config file and ENV variables if set.
+// initConfig finds the config file if it is not set.
 func initConfig() {
 
+	if configFile != "" {
+		return
+	}
+
+	filename, err := document.FindConfig(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	configFile = filename
+}
+
+// newConfig return the corpus config of cmd, the flags set on the command
+// line override the config file which overrides the flag defaults.
+func newConfig(cmd *cobra.Command) *document.Config {
+
+	var (
+		flags  = cmd.Flags()
+		config = &document.Config{}
+	)
+
+	apply := func(all bool) {
+		set := func(name string, fn func()) {
+			if all || flags.Changed(name) {
+				fn()
+			}
+		}
+
+		// the report commands have output and format flags of their own,
+		// the documents ones are the flags of the build command
+		setBuild := func(name string, fn func()) {
+			if all || cmd.Name() == "build" && flags.Changed(name) {
+				fn()
+			}
+		}
+
+		set("path", func() { config.Path = path })
+		set("exclude", func() { config.Excludes = excludes })
+		set("gitignore", func() { config.UseGitignore = gitignore })
+		set("workers", func() { config.Workers = workers })
+		set("private", func() { config.EnablePrivateIndent = private })
+		set("tests", func() { config.Tests = tests })
+		set("theme", func() { config.Theme = theme })
+		set("versions", func() { config.Versions = versions })
+		set("base-url", func() { config.BaseURL = baseURL })
+		setBuild("output", func() { config.Output = output })
+		setBuild("format", func() { config.Format = format })
+		set("http", func() { config.Addr = httpAddr })
+		set("open", func() { config.AutoOpenBrowser = autoOpenBrowser })
+	}
+
+	apply(true)
+
+	if configFile != "" {
+		log.Println("config file:", configFile)
+
+		if err := document.LoadConfig(configFile, config); err != nil {
+			log.Fatal(err)
+		}
+
+		apply(false)
+	}
+
+	return config
 }
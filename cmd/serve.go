@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"fmt"
 	"log"
 
 	"github.com/miclle/gsd/document"
@@ -27,20 +26,12 @@ var serveCmd = &cobra.Command{
 	Long:  "Start documentation webserver:\n\tgsd -http=" + defaultAddr + "\n",
 	Run: func(cmd *cobra.Command, args []string) {
 
-		fmt.Println(path, excludes)
-
-		config := &document.Config{
-			Path:            path,
-			Addr:            httpAddr,
-			AutoOpenBrowser: autoOpenBrowser,
-		}
-
-		corpus, err := document.NewCorpus(config)
+		corpus, err := document.NewCorpus(newConfig(cmd))
 		if err != nil {
 			log.Fatal(err)
 		}
 
-		if err := corpus.Watch(httpAddr); err != nil {
+		if err := corpus.Watch(corpus.Addr); err != nil {
 			log.Fatal(err)
 		}
 
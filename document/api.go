@@ -0,0 +1,568 @@
+// This file implements the machine-readable description of the corpus API.
+// The AST and type information of a package can't be marshaled, so the
+// declarations are described by plain values holding their printed source,
+// documentation, markers and positions.
+
+package document
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/ast"
+	"go/doc"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// APIVersion is the version of the JSON export format
+const APIVersion = 1
+
+// APIManifestFilename is the name of the corpus manifest in a JSON export
+const APIManifestFilename = "manifest.json"
+
+// APIPackageFilename is the name of the package documents in a JSON export,
+// in the directory of the package import path
+const APIPackageFilename = "package.json"
+
+// APIManifest lists the packages of a JSON export
+type APIManifest struct {
+	Version   int                   `json:"version"`
+	Generator string                `json:"generator"`
+	Packages  []*APIManifestPackage `json:"packages"`
+}
+
+// APIManifestPackage is a package of the manifest
+type APIManifestPackage struct {
+	ImportPath string `json:"import_path"`
+	Name       string `json:"name"`
+	Synopsis   string `json:"synopsis"`
+	File       string `json:"file"` // package document, relative to the manifest
+	Error      string `json:"error,omitempty"`
+}
+
+// APIPackage describes the API of a package
+type APIPackage struct {
+	Version    int                   `json:"version"`
+	ImportPath string                `json:"import_path"`
+	Name       string                `json:"name"`
+	Module     string                `json:"module,omitempty"`
+	Doc        string                `json:"doc"`
+	Markers    []*APIMarker          `json:"markers,omitempty"`
+	Filenames  []string              `json:"filenames"`
+	Imports    []string              `json:"imports"`
+	Consts     []*APIValue           `json:"consts"`
+	Vars       []*APIValue           `json:"vars"`
+	Funcs      []*APIFunc            `json:"funcs"`
+	Types      []*APIType            `json:"types"`
+	Examples   []*APIExample         `json:"examples,omitempty"`
+	Notes      map[string][]*APINote `json:"notes,omitempty"`
+	Error      string                `json:"error,omitempty"`
+}
+
+// APIMarker is a documentation block annotated by a "@gsd:" marker
+type APIMarker struct {
+	Marker string `json:"marker"`
+	Text   string `json:"text"`
+}
+
+// APIPosition is a source position, the filename is relative to the corpus path
+type APIPosition struct {
+	Filename string `json:"filename"`
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+}
+
+// APIValue is a declaration of constants or variables
+type APIValue struct {
+	Names   []string     `json:"names"`
+	Decl    string       `json:"decl"`
+	Doc     string       `json:"doc"`
+	Markers []*APIMarker `json:"markers,omitempty"`
+	Pos     *APIPosition `json:"pos"`
+}
+
+// APIType is a type declaration
+type APIType struct {
+	Name     string        `json:"name"`
+	Kind     TypeSpec      `json:"kind,omitempty"` // struct or interface
+	Decl     string        `json:"decl"`
+	Doc      string        `json:"doc"`
+	Markers  []*APIMarker  `json:"markers,omitempty"`
+	Pos      *APIPosition  `json:"pos"`
+	Fields   []*APIField   `json:"fields,omitempty"`
+	Consts   []*APIValue   `json:"consts,omitempty"`
+	Vars     []*APIValue   `json:"vars,omitempty"`
+	Funcs    []*APIFunc    `json:"funcs,omitempty"` // constructors and interface methods
+	Methods  []*APIFunc    `json:"methods,omitempty"`
+	Examples []*APIExample `json:"examples,omitempty"`
+}
+
+// APIField is a struct field or an embedded type
+type APIField struct {
+	Names    []string     `json:"names,omitempty"`
+	Type     string       `json:"type"`
+	Tag      string       `json:"tag,omitempty"`
+	Embedded bool         `json:"embedded,omitempty"`
+	Doc      string       `json:"doc"`
+	Markers  []*APIMarker `json:"markers,omitempty"`
+	Pos      *APIPosition `json:"pos"`
+}
+
+// APIFunc is a function, method or interface method
+type APIFunc struct {
+	Name      string        `json:"name"`
+	Recv      string        `json:"recv,omitempty"`
+	Signature string        `json:"signature"`
+	Doc       string        `json:"doc"`
+	Markers   []*APIMarker  `json:"markers,omitempty"`
+	Pos       *APIPosition  `json:"pos"`
+	Examples  []*APIExample `json:"examples,omitempty"`
+}
+
+// APIExample is an example of a package, type or func
+type APIExample struct {
+	Name      string `json:"name"`
+	Suffix    string `json:"suffix,omitempty"`
+	Doc       string `json:"doc"`
+	Code      string `json:"code"`
+	Output    string `json:"output,omitempty"`
+	Unordered bool   `json:"unordered,omitempty"`
+}
+
+// APINote is a marked note comment such as "BUG(uid): body"
+type APINote struct {
+	UID  string       `json:"uid"`
+	Body string       `json:"body"`
+	Pos  *APIPosition `json:"pos"`
+}
+
+// --------------------------------------------------------------------
+
+// apiBuilder describes the declarations of a package
+type apiBuilder struct {
+	pkg     *Package
+	root    string // corpus directory, the base of the positions
+	private bool   // describe the unexported identifiers
+}
+
+// NewAPIPackage return the API description of pkg. The positions are
+// relative to root, unexported identifiers are described only if private is true.
+func NewAPIPackage(pkg *Package, root string, private bool) *APIPackage {
+
+	b := &apiBuilder{pkg: pkg, root: root, private: private}
+
+	api := &APIPackage{
+		Version:    APIVersion,
+		ImportPath: pkg.ImportPath,
+		Name:       pkg.Name,
+		Doc:        pkg.Doc,
+		Markers:    docMarkers(pkg.Doc),
+		Filenames:  []string{},
+		Imports:    append([]string{}, pkg.Imports...),
+		Consts:     b.values(pkg.Consts),
+		Vars:       b.values(pkg.Vars),
+		Funcs:      b.funcs(pkg.Funcs),
+		Types:      []*APIType{},
+		Examples:   b.examples(pkg.Examples),
+	}
+
+	if pkg.Module != nil {
+		api.Module = pkg.Module.Path
+	}
+
+	if pkg.Err != nil {
+		api.Error = pkg.Err.Error()
+	}
+
+	for _, filename := range pkg.Filenames {
+		api.Filenames = append(api.Filenames, b.rel(filename))
+	}
+
+	for _, t := range pkg.Types {
+		if b.exported(t.Name) {
+			api.Types = append(api.Types, b.typ(t))
+		}
+	}
+
+	for marker, notes := range pkg.Notes {
+		if api.Notes == nil {
+			api.Notes = map[string][]*APINote{}
+		}
+		for _, note := range notes {
+			api.Notes[marker] = append(api.Notes[marker], &APINote{
+				UID:  note.UID,
+				Body: note.Body,
+				Pos:  b.pos(note.Pos),
+			})
+		}
+	}
+
+	return api
+}
+
+func (b *apiBuilder) exported(name string) bool {
+	return b.private || IsExported(name)
+}
+
+// rel return filename relative to the corpus directory
+func (b *apiBuilder) rel(filename string) string {
+	if rel, err := filepath.Rel(b.root, filename); err == nil && !strings.HasPrefix(rel, "..") {
+		return filepath.ToSlash(rel)
+	}
+	return filepath.ToSlash(filename)
+}
+
+func (b *apiBuilder) pos(pos token.Pos) *APIPosition {
+	if !pos.IsValid() || b.pkg.FSet == nil {
+		return nil
+	}
+	position := b.pkg.FSet.Position(pos)
+	return &APIPosition{
+		Filename: b.rel(position.Filename),
+		Line:     position.Line,
+		Column:   position.Column,
+	}
+}
+
+// node return the source of node
+func (b *apiBuilder) node(node interface{}) string {
+	return printNode(b.pkg.FSet, node)
+}
+
+// printNode return the source of node in the gofmt style
+func printNode(fset *token.FileSet, node interface{}) string {
+	var (
+		buf    bytes.Buffer
+		config = &printer.Config{Mode: printer.UseSpaces | printer.TabIndent, Tabwidth: 4}
+	)
+	if err := config.Fprint(&buf, fset, declNode(node)); err != nil {
+		log.Print(err)
+	}
+	return buf.String()
+}
+
+// declNode return node without the doc comments and function bodies of the
+// package AST, a shallow copy if node is a declaration, the documents show
+// the declarations alone
+func declNode(node interface{}) interface{} {
+	switch decl := node.(type) {
+	case *ast.FuncDecl:
+		d := *decl
+		d.Doc, d.Body = nil, nil
+		return &d
+
+	case *ast.GenDecl:
+		d := *decl
+		d.Doc = nil
+		if d.Tok == token.TYPE {
+			d.Specs = make([]ast.Spec, len(decl.Specs))
+			for i, spec := range decl.Specs {
+				if ts, ok := spec.(*ast.TypeSpec); ok && ts.Doc != nil {
+					t := *ts
+					t.Doc = nil
+					spec = &t
+				}
+				d.Specs[i] = spec
+			}
+		}
+		return &d
+	}
+	return node
+}
+
+func (b *apiBuilder) values(values []*doc.Value) []*APIValue {
+	result := []*APIValue{}
+	for _, v := range values {
+		var names []string
+		for _, name := range v.Names {
+			if b.exported(name) {
+				names = append(names, name)
+			}
+		}
+		if len(names) == 0 {
+			continue
+		}
+		result = append(result, &APIValue{
+			Names:   names,
+			Decl:    b.node(v.Decl),
+			Doc:     v.Doc,
+			Markers: docMarkers(v.Doc),
+			Pos:     b.pos(v.Decl.Pos()),
+		})
+	}
+	return result
+}
+
+func (b *apiBuilder) funcs(funcs []*Func) []*APIFunc {
+	result := []*APIFunc{}
+	for _, fn := range funcs {
+		if !b.exported(fn.Name) {
+			continue
+		}
+
+		api := &APIFunc{
+			Name:     fn.Name,
+			Recv:     fn.Recv,
+			Doc:      fn.Doc,
+			Markers:  docMarkers(fn.Doc),
+			Examples: b.examples(fn.Examples),
+		}
+
+		switch {
+		case fn.Decl != nil:
+			api.Signature = b.node(fn.Decl)
+			api.Pos = b.pos(fn.Decl.Name.Pos())
+		case fn.Field != nil && fn.FuncType != nil:
+			// interface methods
+			api.Signature = fn.Name + strings.TrimPrefix(b.node(fn.FuncType), "func")
+			api.Pos = b.pos(fn.Field.Pos())
+		}
+
+		result = append(result, api)
+	}
+	return result
+}
+
+func (b *apiBuilder) typ(t *Type) *APIType {
+
+	api := &APIType{
+		Name:     t.Name,
+		Kind:     t.TypeSpec,
+		Decl:     b.node(b.decl(t.Decl)),
+		Doc:      t.Doc,
+		Markers:  docMarkers(t.Doc),
+		Pos:      b.pos(t.Decl.Pos()),
+		Consts:   b.values(t.Consts),
+		Vars:     b.values(t.Vars),
+		Funcs:    b.funcs(t.Funcs),
+		Methods:  b.funcs(t.Methods),
+		Examples: b.examples(t.Examples),
+	}
+
+	if t.TypeSpec == StructType {
+		for _, f := range t.Fields {
+			if field := b.field(f); field != nil {
+				api.Fields = append(api.Fields, field)
+			}
+		}
+	}
+
+	return api
+}
+
+func (b *apiBuilder) field(f *Field) *APIField {
+
+	api := &APIField{
+		Type: b.node(f.Field.Type),
+		Pos:  b.pos(f.Field.Pos()),
+	}
+
+	for _, name := range f.JoinNames() {
+		if b.exported(name) {
+			api.Names = append(api.Names, name)
+		}
+	}
+
+	if len(f.Field.Names) == 0 {
+		api.Embedded = true
+		if name := embeddedName(f.Field.Type); !b.exported(name) {
+			return nil
+		}
+	} else if len(api.Names) == 0 {
+		return nil
+	}
+
+	if f.Field.Tag != nil {
+		api.Tag = f.Field.Tag.Value
+	}
+
+	var doc bytes.Buffer
+	if f.Field.Doc != nil {
+		doc.WriteString(f.Field.Doc.Text())
+	}
+	if f.Field.Comment != nil {
+		doc.WriteString(f.Field.Comment.Text())
+	}
+	api.Doc = doc.String()
+	api.Markers = docMarkers(api.Doc)
+
+	return api
+}
+
+// decl return the type declaration of the described identifiers
+func (b *apiBuilder) decl(decl *ast.GenDecl) *ast.GenDecl {
+	if b.private {
+		return decl
+	}
+	return exportedDecl(decl)
+}
+
+// exportedDecl return a copy of the type declaration decl without the
+// unexported struct fields and interface methods
+func exportedDecl(decl *ast.GenDecl) *ast.GenDecl {
+
+	filter := func(list *ast.FieldList) (filtered *ast.FieldList, incomplete bool) {
+		filtered = &ast.FieldList{Opening: list.Opening, Closing: list.Closing}
+		for _, field := range list.List {
+			name := embeddedName(field.Type)
+			if len(field.Names) > 0 {
+				var names []*ast.Ident
+				for _, ident := range field.Names {
+					if IsExported(ident.Name) {
+						names = append(names, ident)
+					}
+				}
+				if len(names) == 0 {
+					incomplete = true
+					continue
+				}
+				copied := *field
+				copied.Names = names
+				field = &copied
+			} else if !IsExported(name) {
+				incomplete = true
+				continue
+			}
+			filtered.List = append(filtered.List, field)
+		}
+		return
+	}
+
+	copied := *decl
+	copied.Specs = nil
+
+	for _, spec := range decl.Specs {
+		if typeSpec, ok := spec.(*ast.TypeSpec); ok {
+			ts := *typeSpec
+			switch t := ts.Type.(type) {
+			case *ast.StructType:
+				st := *t
+				st.Fields, st.Incomplete = filter(t.Fields)
+				ts.Type = &st
+			case *ast.InterfaceType:
+				it := *t
+				it.Methods, it.Incomplete = filter(t.Methods)
+				ts.Type = &it
+			}
+			spec = &ts
+		}
+		copied.Specs = append(copied.Specs, spec)
+	}
+
+	return &copied
+}
+
+// embeddedName return the type name of an embedded field type
+func embeddedName(x ast.Expr) string {
+	switch t := x.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.StarExpr:
+		return embeddedName(t.X)
+	case *ast.SelectorExpr:
+		return t.Sel.Name
+	case *ast.IndexExpr:
+		return embeddedName(t.X)
+	case *ast.IndexListExpr:
+		return embeddedName(t.X)
+	}
+	return ""
+}
+
+func (b *apiBuilder) examples(examples []*doc.Example) (result []*APIExample) {
+	for _, eg := range examples {
+		result = append(result, &APIExample{
+			Name:      eg.Name,
+			Suffix:    eg.Suffix,
+			Doc:       eg.Doc,
+			Code:      b.node(&printer.CommentedNode{Node: eg.Code, Comments: eg.Comments}),
+			Output:    eg.Output,
+			Unordered: eg.Unordered,
+		})
+	}
+	return
+}
+
+// docMarkers return the documentation blocks annotated by "@gsd:" markers
+func docMarkers(text string) (markers []*APIMarker) {
+	for _, block := range strings.Split(strings.Trim(text, " "), "\n\n") {
+		if output, marker, match := Annotation(block); match {
+			markers = append(markers, &APIMarker{Marker: marker, Text: strings.TrimSpace(output)})
+		}
+	}
+	return
+}
+
+// --------------------------------------------------------------------
+
+// exportJSON writes the JSON documents of the packages of snapshot and their manifest
+func (c *Corpus) exportJSON(snapshot *Snapshot) error {
+
+	root, err := filepath.Abs(c.Path)
+	if err != nil {
+		return err
+	}
+
+	var importPaths []string
+	for importPath := range snapshot.Packages {
+		importPaths = append(importPaths, importPath)
+	}
+	sort.Strings(importPaths)
+
+	synopsis := new(doc.Package)
+
+	manifest := &APIManifest{
+		Version:   APIVersion,
+		Generator: "gsd " + Version,
+		Packages:  []*APIManifestPackage{},
+	}
+
+	for _, importPath := range importPaths {
+		pkg := snapshot.Packages[importPath]
+
+		api := NewAPIPackage(pkg, root, c.EnablePrivateIndent)
+
+		file := importPath + "/" + APIPackageFilename
+		filename := filepath.Join(c.Output, filepath.FromSlash(file))
+
+		log.Printf("write package %s api: %s\n", pkg.Name, filename)
+
+		if err := writeJSON(filename, api); err != nil {
+			return err
+		}
+
+		manifest.Packages = append(manifest.Packages, &APIManifestPackage{
+			ImportPath: importPath,
+			Name:       pkg.Name,
+			Synopsis:   synopsis.Synopsis(pkg.Doc),
+			File:       file,
+			Error:      api.Error,
+		})
+	}
+
+	filename := filepath.Join(c.Output, APIManifestFilename)
+
+	log.Println("write manifest:", filename)
+
+	return writeJSON(filename, manifest)
+}
+
+// writeJSON writes the indented JSON encoding of v to filename, creating its directory
+func writeJSON(filename string, v interface{}) error {
+
+	if err := os.MkdirAll(filepath.Dir(filename), os.ModePerm); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, append(data, '\n'), 0644)
+}
@@ -0,0 +1,103 @@
+package document_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miclle/gsd/document"
+)
+
+func TestExportJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	root := writeModule(t, map[string]string{
+		"go.mod": "module example.com/m\n\ngo 1.16\n",
+		"a/a.go": `// Package a is an example.
+package a
+
+// Max is the maximum.
+const Max = 10
+
+// T is a type.
+//
+// @gsd:note remember T
+type T struct {
+	// Name of T
+	Name string ` + "`json:\"name\"`" + `
+	hidden int
+}
+
+// New return a T.
+func New() *T { return &T{} }
+
+// Hello says hello.
+func (t *T) Hello(name string) string { return "hello " + name }
+
+// I is an interface.
+type I interface {
+	// Do does.
+	Do() error
+}
+`,
+		"a/a_test.go": `package a
+
+func ExampleNew() {
+	New()
+	// Output:
+}
+`,
+	})
+
+	output := t.TempDir()
+
+	corpus, err := document.NewCorpus(&document.Config{Path: root, Output: output, Format: document.JSONFormat})
+	assert.Nil(err)
+	assert.Nil(corpus.Export())
+
+	var manifest document.APIManifest
+	data, err := os.ReadFile(filepath.Join(output, document.APIManifestFilename))
+	assert.Nil(err)
+	assert.Nil(json.Unmarshal(data, &manifest))
+	assert.Len(manifest.Packages, 1)
+	assert.Equal("example.com/m/a", manifest.Packages[0].ImportPath)
+	assert.Equal("Package a is an example.", manifest.Packages[0].Synopsis)
+
+	var pkg document.APIPackage
+	data, err = os.ReadFile(filepath.Join(output, filepath.FromSlash(manifest.Packages[0].File)))
+	assert.Nil(err)
+	assert.Nil(json.Unmarshal(data, &pkg))
+
+	assert.Equal("a", pkg.Name)
+	assert.Equal([]string{"a/a.go"}, pkg.Filenames)
+	assert.Equal([]string{"Max"}, pkg.Consts[0].Names)
+	assert.Equal(&document.APIPosition{Filename: "a/a.go", Line: 5, Column: 1}, pkg.Consts[0].Pos)
+
+	assert.Len(pkg.Types, 2)
+
+	i, typ := pkg.Types[0], pkg.Types[1]
+	assert.Equal("I", i.Name)
+	assert.Equal(document.InterfaceType, i.Kind)
+	assert.Equal("Do() error", i.Funcs[0].Signature)
+
+	assert.Equal("T", typ.Name)
+	assert.Equal(document.StructType, typ.Kind)
+	assert.Equal([]*document.APIMarker{{Marker: "note", Text: "remember T"}}, typ.Markers)
+
+	assert.Len(typ.Fields, 1)
+	assert.Equal([]string{"Name"}, typ.Fields[0].Names)
+	assert.Equal("string", typ.Fields[0].Type)
+	assert.Equal("`json:\"name\"`", typ.Fields[0].Tag)
+	assert.Equal("Name of T\n", typ.Fields[0].Doc)
+
+	assert.Equal("New", typ.Funcs[0].Name)
+	assert.Equal("func New() *T", typ.Funcs[0].Signature)
+	assert.Len(typ.Funcs[0].Examples, 1)
+
+	assert.Equal("Hello", typ.Methods[0].Name)
+	assert.Equal("*T", typ.Methods[0].Recv)
+	assert.Equal("func (t *T) Hello(name string) string", typ.Methods[0].Signature)
+}
@@ -0,0 +1,176 @@
+// This file implements the single-file bundle export. The bundle is one
+// self-contained HTML file with the static assets and the search index
+// inlined, every package, type and func document is a section of the
+// page, linked by in-page anchors.
+
+package document
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"html/template"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/miclle/gsd/static"
+)
+
+// BundleFilename is the name of the bundle file in the output directory,
+// an output path ending with ".html" is the bundle file itself.
+const BundleFilename = "index.html"
+
+// exportBundle writes the documents of snapshot into a single HTML file
+func (c *Corpus) exportBundle(snapshot *Snapshot) (err error) {
+
+	filename := c.Output
+	if filepath.Ext(filename) != ".html" {
+		filename = filepath.Join(c.Output, BundleFilename)
+	}
+
+	if err = os.MkdirAll(filepath.Dir(filename), os.ModePerm); err != nil {
+		return
+	}
+
+	page := NewPage(c)
+	page.Snapshot = snapshot
+	page.Static = true
+	page.Bundle = true
+
+	var importPaths []string
+	for importPath := range snapshot.Packages {
+		importPaths = append(importPaths, importPath)
+	}
+	sort.Strings(importPaths)
+
+	var body bytes.Buffer
+	for _, importPath := range importPaths {
+		if err = c.renderBundlePackage(&body, page, snapshot.Packages[importPath]); err != nil {
+			return
+		}
+	}
+
+	page.Package, page.Type, page.Func = nil, nil, nil
+
+	var buf bytes.Buffer
+	if err = page.RenderBody(&buf, body.Bytes()); err != nil {
+		return
+	}
+
+	log.Println("write documents bundle:", filename)
+
+	return os.WriteFile(filename, buf.Bytes(), 0644)
+}
+
+// renderBundlePackage writes the package, types and funcs sections of pkg
+func (c *Corpus) renderBundlePackage(w *bytes.Buffer, page *Page, pkg *Package) (err error) {
+
+	section := func(url string, t *template.Template, name string) error {
+		body, err := applyTemplate(t, name, page)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "<section class=\"bundle-section\" id=\"%s\">\n", html.EscapeString(anchorFunc(url)))
+		w.Write(body)
+		w.WriteString("\n</section>\n")
+		return nil
+	}
+
+	page.Package, page.Type, page.Func = pkg, nil, nil
+
+	if err = section(page.packageURL(pkg.ImportPath), page.PackageHTML, "package"); err != nil {
+		return
+	}
+
+	for _, t := range pkg.Types {
+		if !c.EnablePrivateIndent && !IsExported(t.Name) {
+			continue
+		}
+
+		page.Type, page.Func = t, nil
+
+		if err = section(page.typeURL(pkg.ImportPath, t.Name), page.TypeHTML, "type"); err != nil {
+			return
+		}
+
+		var funcs []*Func
+		funcs = append(funcs, t.Funcs...)
+		funcs = append(funcs, t.Methods...)
+
+		for _, fn := range funcs {
+			if !c.EnablePrivateIndent && !IsExported(fn.Name) {
+				continue
+			}
+
+			page.Func = fn
+
+			if err = section(page.funcURL(pkg.ImportPath, t.Name, fn.Name), page.FuncHTML, "func"); err != nil {
+				return
+			}
+		}
+	}
+
+	return
+}
+
+// anchorFunc return the element id of an in-page anchor URL
+func anchorFunc(url string) string {
+	return strings.TrimPrefix(url, "#")
+}
+
+// staticFileFunc return the content of a static asset, for inlining
+// into a style or script element.
+func staticFileFunc(name string) (interface{}, error) {
+	content, exists := static.Files[name]
+	if !exists {
+		return nil, fmt.Errorf("static file not found: %s", name)
+	}
+
+	switch filepath.Ext(name) {
+	case ".css":
+		return template.CSS(content), nil
+	case ".js":
+		return template.JS(content), nil
+	}
+
+	return nil, fmt.Errorf("static file %s can't be inlined", name)
+}
+
+// searchIndexFunc return the client-side search index of the page
+// snapshot, linking the bundle sections.
+func (page *Page) searchIndexFunc() (template.JS, error) {
+
+	index := page.Snapshot.SearchIndex.withURLs(page.bundleURL)
+
+	// the JSON encoder escapes "<" and ">", the index can't close the script
+	var buf bytes.Buffer
+	if err := index.WriteStatic(&buf); err != nil {
+		return "", err
+	}
+
+	return template.JS(strings.TrimSpace(buf.String())), nil
+}
+
+// bundleURL return the bundle anchor of a search item
+func (page *Page) bundleURL(item *SearchItem) string {
+
+	switch item.Kind {
+	case SearchPackage, SearchConst, SearchVar:
+		return page.packageURL(item.ImportPath)
+	case SearchField:
+		typeName, _, _ := strings.Cut(item.Name, ".")
+		return page.typeURL(item.ImportPath, typeName)
+	}
+
+	// types, funcs and methods, the funcs without type have no dot
+	if typeName, name, ok := strings.Cut(item.Name, "."); ok {
+		return page.funcURL(item.ImportPath, typeName, name)
+	}
+	if item.Kind == SearchType {
+		return page.typeURL(item.ImportPath, item.Name)
+	}
+	return page.funcURL(item.ImportPath, "", item.Name)
+}
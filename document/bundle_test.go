@@ -0,0 +1,70 @@
+package document_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miclle/gsd/document"
+)
+
+func TestExportBundle(t *testing.T) {
+	assert := assert.New(t)
+
+	root := writeModule(t, map[string]string{
+		"go.mod": "module example.com/m\n\ngo 1.16\n",
+		"a/a.go": `// Package a is an example.
+package a
+
+// T is a type.
+type T struct{ Name string }
+
+// Hello says hello.
+func (t *T) Hello(name string) string { return "hello " + name }
+
+// Run runs.
+func Run() {
+	// BUG(gsd): Run does nothing.
+	new(T).Hello("world")
+}
+`,
+	})
+
+	filename := filepath.Join(t.TempDir(), "api.html")
+
+	corpus, err := document.NewCorpus(&document.Config{Path: root, Output: filename, Format: document.BundleFormat})
+	assert.Nil(err)
+	assert.Nil(corpus.Export())
+
+	data, err := os.ReadFile(filename)
+	assert.Nil(err)
+	bundle := string(data)
+
+	// the assets are inlined
+	assert.NotContains(bundle, "/_static/")
+	assert.Contains(bundle, "<style>")
+	assert.Contains(bundle, "initStaticSearch")
+	assert.NotContains(bundle, "data-live-reload")
+
+	// every package, type and func is a section linked by anchors
+	assert.Contains(bundle, `<section class="bundle-section" id="/example.com/m/a">`)
+	assert.Contains(bundle, `<section class="bundle-section" id="/example.com/m/a/T">`)
+	assert.Contains(bundle, `<section class="bundle-section" id="/example.com/m/a/T.Hello">`)
+	assert.Contains(bundle, `id="/example.com/m/a/Run"`)
+	assert.Contains(bundle, `href="#/example.com/m/a/T"`)
+	assert.Contains(bundle, `href="#/example.com/m/a/T.Hello"`)
+
+	// the search index is embedded with the anchors
+	assert.Contains(bundle, `data-search-index="#search-index"`)
+	assert.Contains(bundle, `["method","T.Hello","example.com/m/a","#/example.com/m/a/T.Hello","Hello says hello."]`)
+	assert.Contains(bundle, `["func","Run","example.com/m/a","#/example.com/m/a/Run","Run runs."]`)
+
+	// the source pages are not bundled, the declarations, notes and uses
+	// are not linked to them
+	assert.Contains(bundle, `<h2 id="uses">Uses</h2>`)
+	assert.Contains(bundle, `<h2 id="Run">func Run`)
+	assert.Contains(bundle, "Run does nothing.")
+	assert.NotRegexp(`href="[^"]*/src/`, bundle)
+}
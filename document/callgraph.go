@@ -0,0 +1,251 @@
+// This file implements the static call graph of the documented funcs and
+// methods of the corpus. The calls are collected from the function bodies
+// with the type information of the packages. The calls of interface
+// methods are resolved to the methods of the concrete types implementing
+// the interface.
+
+package document
+
+import (
+	"go/ast"
+	"go/types"
+	"sort"
+)
+
+// CallRef is a reference to a documented func or method of the corpus
+type CallRef struct {
+	ImportPath  string
+	PackageName string
+	Type        string // type of the func page, empty for the package funcs
+	Name        string
+
+	// Dynamic is true if the call is an interface method call resolved to
+	// a method of a concrete type implementing the interface
+	Dynamic bool
+}
+
+// Qualified return the name of the func, "Func" or "Type.Method", qualified
+// by its package name unless it is declared by the package importPath
+func (r *CallRef) Qualified(importPath string) string {
+	name := r.Name
+	if r.Type != "" {
+		name = r.Type + "." + name
+	}
+	if r.ImportPath == importPath {
+		return name
+	}
+	return r.PackageName + "." + name
+}
+
+// key return the key of the func in the call graph
+func (r *CallRef) key() string {
+	return r.ImportPath + "." + r.Type + "." + r.Name
+}
+
+// Calls are the calls of a func, and to the func
+type Calls struct {
+	Calls    []*CallRef // funcs called by the func
+	CalledBy []*CallRef // funcs calling the func
+}
+
+// Calls return the calls of the func name of the type typeName of the
+// package importPath, typeName is empty for the package funcs. The result
+// is nil if the func neither calls nor is called by a documented func.
+func (s *Snapshot) Calls(importPath, typeName, name string) *Calls {
+	s.callsOnce.Do(s.indexCalls)
+	return s.calls[importPath+"."+typeName+"."+name]
+}
+
+// indexCalls builds the call graph of the funcs and methods with documents
+func (s *Snapshot) indexCalls() {
+
+	var (
+		funcs   = map[string]*CallRef{} // by object key, the objects of the reparsed packages are distinct
+		methods = map[string]*CallRef{} // by "import/path.Type.Method", promoted methods included
+	)
+
+	for _, pkg := range s.Packages {
+		add := func(typeName string, fn *Func) {
+			if !s.private && !IsExported(fn.Name) {
+				return
+			}
+
+			ref := &CallRef{ImportPath: pkg.ImportPath, PackageName: pkg.Name, Type: typeName, Name: fn.Name}
+			if fn.Recv != "" || fn.Field != nil {
+				methods[ref.key()] = ref
+			}
+
+			// the promoted methods are documented by their embedded types
+			if fn.Object != nil && fn.Level == 0 {
+				funcs[s.objectKey(fn.Object)] = ref
+			}
+		}
+
+		for _, fn := range pkg.Funcs {
+			add("", fn)
+		}
+
+		for _, t := range pkg.Types {
+			if !s.private && !IsExported(t.Name) {
+				continue
+			}
+			for _, fn := range t.Funcs {
+				add(t.Name, fn)
+			}
+			for _, fn := range t.Methods {
+				add(t.Name, fn)
+			}
+		}
+	}
+
+	// callees return the funcs with documents a call of fn may call
+	callees := func(fn *types.Func) (refs []*CallRef) {
+
+		fn = fn.Origin()
+		if ref := funcs[s.objectKey(fn)]; ref != nil {
+			refs = append(refs, ref)
+		}
+
+		recv := fn.Type().(*types.Signature).Recv()
+		if recv == nil || !types.IsInterface(recv.Type()) {
+			return
+		}
+
+		named, ok := recv.Type().(*types.Named)
+		if !ok || named.Obj().Pkg() == nil {
+			return
+		}
+
+		impls := s.Implementations(named.Obj().Pkg().Path(), named.Obj().Name())
+		if impls == nil {
+			return
+		}
+
+		for _, impl := range impls.ImplementedBy {
+			if ref := methods[impl.ImportPath+"."+impl.Name+"."+fn.Name()]; ref != nil {
+				dynamic := *ref
+				dynamic.Dynamic = true
+				refs = append(refs, &dynamic)
+			}
+		}
+		return
+	}
+
+	var (
+		calls   = map[string]map[string]*CallRef{}
+		callers = map[string]map[string]*CallRef{}
+	)
+
+	link := func(set map[string]map[string]*CallRef, from string, to *CallRef) {
+		if set[from] == nil {
+			set[from] = map[string]*CallRef{}
+		}
+		// a static call wins over the dynamic ones
+		if previous := set[from][to.key()]; previous == nil || previous.Dynamic && !to.Dynamic {
+			set[from][to.key()] = to
+		}
+	}
+
+	for _, pkg := range s.Packages {
+		if pkg.TypesInfo == nil {
+			continue
+		}
+
+		for _, filename := range pkg.sortedFilenames() {
+			for _, decl := range pkg.PAst[filename].Decls {
+				decl, ok := decl.(*ast.FuncDecl)
+				if !ok || decl.Body == nil {
+					continue
+				}
+
+				fn, _ := pkg.TypesInfo.Defs[decl.Name].(*types.Func)
+				if fn == nil {
+					continue
+				}
+				caller := funcs[s.objectKey(fn)]
+				if caller == nil {
+					continue
+				}
+
+				ast.Inspect(decl.Body, func(n ast.Node) bool {
+					call, ok := n.(*ast.CallExpr)
+					if !ok {
+						return true
+					}
+
+					callee, _ := pkg.TypesInfo.Uses[calleeIdent(call.Fun)].(*types.Func)
+					if callee == nil {
+						return true
+					}
+
+					for _, ref := range callees(callee) {
+						if ref.key() == caller.key() {
+							continue
+						}
+						link(calls, caller.key(), ref)
+
+						calledBy := *caller
+						calledBy.Dynamic = ref.Dynamic
+						link(callers, ref.key(), &calledBy)
+					}
+					return true
+				})
+			}
+		}
+	}
+
+	list := func(set map[string]*CallRef) (result []*CallRef) {
+		for _, ref := range set {
+			result = append(result, ref)
+		}
+		sortCallRefs(result)
+		return
+	}
+
+	s.calls = map[string]*Calls{}
+
+	for key, set := range calls {
+		s.calls[key] = &Calls{Calls: list(set)}
+	}
+
+	for key, set := range callers {
+		if s.calls[key] == nil {
+			s.calls[key] = &Calls{}
+		}
+		s.calls[key].CalledBy = list(set)
+	}
+}
+
+// calleeIdent return the identifier of the func called by the expression
+// fun, such as "F" of "pkg.F[int]", nil if the callee is not named
+func calleeIdent(fun ast.Expr) *ast.Ident {
+	for {
+		switch expr := fun.(type) {
+		case *ast.ParenExpr:
+			fun = expr.X
+		case *ast.IndexExpr:
+			fun = expr.X
+		case *ast.IndexListExpr:
+			fun = expr.X
+		case *ast.SelectorExpr:
+			return expr.Sel
+		case *ast.Ident:
+			return expr
+		default:
+			return nil
+		}
+	}
+}
+
+// sortCallRefs sorts refs by import path, type and name
+func sortCallRefs(refs []*CallRef) {
+	sort.Slice(refs, func(i, j int) bool {
+		if refs[i].ImportPath != refs[j].ImportPath {
+			return refs[i].ImportPath < refs[j].ImportPath
+		}
+		if refs[i].Type != refs[j].Type {
+			return refs[i].Type < refs[j].Type
+		}
+		return refs[i].Name < refs[j].Name
+	})
+}
@@ -0,0 +1,113 @@
+package document_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miclle/gsd/document"
+)
+
+func TestCalls(t *testing.T) {
+	assert := assert.New(t)
+
+	root := writeModule(t, map[string]string{
+		"go.mod": "module example.com/m\n\ngo 1.18\n",
+		"a/a.go": `// Package a declares the shapes.
+package a
+
+// Shape is a shape.
+type Shape interface {
+	Area() float64
+}
+
+// Square is a square.
+type Square struct{ Side float64 }
+
+// NewSquare returns a square.
+func NewSquare(side float64) Square { return Square{Side: side} }
+
+// Area returns the area.
+func (s Square) Area() float64 { return square(s.Side) }
+
+func square(x float64) float64 { return x * x }
+
+// Max returns the greatest.
+func Max[T int | float64](a, b T) T {
+	if a > b {
+		return a
+	}
+	return b
+}
+`,
+		"b/b.go": `// Package b sums the shapes.
+package b
+
+import "example.com/m/a"
+
+// Circle is a circle.
+type Circle struct{ R float64 }
+
+// Area returns the area.
+func (c *Circle) Area() float64 { return 3 * c.R * c.R }
+
+// Total sums the areas.
+func Total(shapes ...a.Shape) (total float64) {
+	for _, s := range shapes {
+		total += s.Area()
+	}
+	return a.Max(total, a.NewSquare(1).Area())
+}
+`,
+	})
+
+	corpus, err := document.NewCorpus(&document.Config{Path: root})
+	assert.Nil(err)
+	assert.Nil(corpus.ParsePackages())
+
+	snapshot := corpus.Snapshot()
+
+	total := snapshot.Calls("example.com/m/b", "", "Total")
+	if assert.NotNil(total) {
+		assert.Equal([]*document.CallRef{
+			{ImportPath: "example.com/m/a", PackageName: "a", Name: "Max"},
+			{ImportPath: "example.com/m/a", PackageName: "a", Type: "Shape", Name: "Area"},
+			{ImportPath: "example.com/m/a", PackageName: "a", Type: "Square", Name: "Area"},
+			{ImportPath: "example.com/m/a", PackageName: "a", Type: "Square", Name: "NewSquare"},
+			{ImportPath: "example.com/m/b", PackageName: "b", Type: "Circle", Name: "Area", Dynamic: true},
+		}, total.Calls)
+		assert.Empty(total.CalledBy)
+	}
+
+	// the unexported funcs are not documented
+	area := snapshot.Calls("example.com/m/a", "Square", "Area")
+	if assert.NotNil(area) {
+		assert.Empty(area.Calls)
+		assert.Equal([]*document.CallRef{
+			{ImportPath: "example.com/m/b", PackageName: "b", Name: "Total"},
+		}, area.CalledBy)
+	}
+
+	circle := snapshot.Calls("example.com/m/b", "Circle", "Area")
+	if assert.NotNil(circle) {
+		assert.Equal([]*document.CallRef{
+			{ImportPath: "example.com/m/b", PackageName: "b", Name: "Total", Dynamic: true},
+		}, circle.CalledBy)
+	}
+
+	mux := corpus.ServeMux()
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/example.com/m/b/Circle.Area.html", nil))
+	body := recorder.Body.String()
+	assert.Contains(body, `<h2 id="called-by">Called by</h2>`)
+	assert.Contains(body, `<li><a href="/example.com/m/b#Total"><code>Total</code></a> <span class="badge badge-light" title="Interface method call">dynamic</span></li>`)
+
+	recorder = httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/example.com/m/b", nil))
+	body = recorder.Body.String()
+	assert.Contains(body, `<li><a href="/example.com/m/a/Square.NewSquare.html"><code>a.Square.NewSquare</code></a></li>`)
+	assert.Contains(body, `<li><a href="/example.com/m/a#Max"><code>a.Max</code></a></li>`)
+}
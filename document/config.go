@@ -0,0 +1,143 @@
+package document
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config with corpus
+type Config struct {
+	// source code path
+	Path string `yaml:"path"`
+
+	// exclude paths, gitignore-style glob patterns
+	Excludes []string `yaml:"exclude"`
+
+	// exclude the paths ignored by the .gitignore files
+	UseGitignore bool `yaml:"gitignore"`
+
+	// output docs path
+	Output string `yaml:"output"`
+
+	// output docs format, html, json, markdown or bundle
+	Format string `yaml:"format"`
+
+	// http server address
+	Addr string `yaml:"addr"`
+
+	// auto open browser
+	AutoOpenBrowser bool `yaml:"open"`
+
+	// number of packages analyzed in parallel, GOMAXPROCS if zero
+	Workers int `yaml:"workers"`
+
+	// display the unexported identifiers
+	EnablePrivateIndent bool `yaml:"private"`
+
+	// document the test-only identifiers and the external test packages
+	Tests bool `yaml:"tests"`
+
+	// documents theme, light, dark or auto
+	Theme string `yaml:"theme"`
+
+	// show the release adding the identifiers, from the semver git tags
+	Versions bool `yaml:"versions"`
+
+	// URL the documents are hosted at, e.g. https://intranet/docs/myservice/,
+	// the domain root if empty
+	BaseURL string `yaml:"base_url"`
+}
+
+// ConfigFilename is the name of the project config file
+const ConfigFilename = ".gsd.yaml"
+
+// Formats of the exported documents
+const (
+	HTMLFormat     = "html"
+	JSONFormat     = "json"     // API description per package, with a manifest
+	MarkdownFormat = "markdown" // GitHub-flavored Markdown
+	BundleFormat   = "bundle"   // single self-contained HTML file
+)
+
+// Themes of the documents
+const (
+	LightTheme = "light"
+	DarkTheme  = "dark"
+	AutoTheme  = "auto" // follows the color scheme of the browser
+)
+
+// FindConfig return the config file in dir or its nearest parent directory,
+// an empty string if there is none.
+func FindConfig(dir string) (string, error) {
+
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		filename := filepath.Join(dir, ConfigFilename)
+		if stat, err := os.Stat(filename); err == nil && !stat.IsDir() {
+			return filename, nil
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
+
+// LoadConfig reads the config file into config, only the fields set by the
+// file are changed. The relative source code and output paths of the file
+// are relative to the directory of the file.
+func LoadConfig(filename string, config *Config) error {
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	var file Config
+	if err = decodeConfig(data, &file); err != nil {
+		return fmt.Errorf("parse config file %s: %w", filename, err)
+	}
+
+	// decode again over config to keep the fields missing in the file
+	if err = decodeConfig(data, config); err != nil {
+		return fmt.Errorf("parse config file %s: %w", filename, err)
+	}
+
+	dir := filepath.Dir(filename)
+
+	if file.Path != "" && !filepath.IsAbs(file.Path) {
+		config.Path = filepath.Join(dir, file.Path)
+	}
+
+	if file.Output != "" && !filepath.IsAbs(file.Output) {
+		config.Output = filepath.Join(dir, file.Output)
+	}
+
+	return nil
+}
+
+// decodeConfig decodes the YAML data into config, the unknown keys, such as
+// the misspelled ones, are errors naming the key
+func decodeConfig(data []byte, config *Config) error {
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	// an empty file is an empty config
+	if err := decoder.Decode(config); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	return nil
+}
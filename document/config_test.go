@@ -0,0 +1,61 @@
+package document_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miclle/gsd/document"
+)
+
+func TestLoadConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	root := t.TempDir()
+	sub := filepath.Join(root, "a", "b")
+	assert.Nil(os.MkdirAll(sub, 0755))
+
+	filename, err := document.FindConfig(sub)
+	assert.Nil(err)
+	assert.Empty(filename)
+
+	content := "exclude:\n  - vendor\noutput: site\nopen: false\nprivate: true\ntheme: dark\n"
+	assert.Nil(os.WriteFile(filepath.Join(root, document.ConfigFilename), []byte(content), 0644))
+
+	filename, err = document.FindConfig(sub)
+	assert.Nil(err)
+	assert.Equal(filepath.Join(root, document.ConfigFilename), filename)
+
+	config := &document.Config{
+		Path:            "./",
+		Addr:            "localhost:3000",
+		AutoOpenBrowser: true,
+	}
+
+	assert.Nil(document.LoadConfig(filename, config))
+	assert.Equal("./", config.Path)
+	assert.Equal("localhost:3000", config.Addr)
+	assert.Equal([]string{"vendor"}, config.Excludes)
+	assert.Equal(filepath.Join(root, "site"), config.Output)
+	assert.False(config.AutoOpenBrowser)
+	assert.True(config.EnablePrivateIndent)
+	assert.Equal(document.DarkTheme, config.Theme)
+
+	assert.Nil(os.WriteFile(filename, []byte("theme: [\n"), 0644))
+	assert.NotNil(document.LoadConfig(filename, config))
+
+	// the misspelled keys are named by the error
+	assert.Nil(os.WriteFile(filename, []byte("output: site\nprivte: true\n"), 0644))
+	err = document.LoadConfig(filename, config)
+	if assert.NotNil(err) {
+		assert.Contains(err.Error(), "field privte not found")
+	}
+
+	assert.Nil(os.WriteFile(filename, nil, 0644))
+	assert.Nil(document.LoadConfig(filename, config))
+
+	_, err = document.NewCorpus(&document.Config{Path: root, Theme: "blue"})
+	assert.NotNil(err)
+}
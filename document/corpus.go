@@ -3,7 +3,6 @@ package document
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"go/ast"
 	"go/doc"
@@ -11,17 +10,20 @@ import (
 	"io/ioutil"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
-	"os/exec"
 	"os/signal"
 	"path/filepath"
+	"runtime"
+	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 	"unicode"
 	"unicode/utf8"
 
 	"github.com/fsnotify/fsnotify"
-	"golang.org/x/xerrors"
 
 	"github.com/miclle/gsd/static"
 	"github.com/miclle/gsd/util"
@@ -30,24 +32,6 @@ import (
 // Version info
 const Version = "0.0.2"
 
-// Config with corpus
-type Config struct {
-	// source code path
-	Path string
-
-	// exclude paths
-	Excludes []string
-
-	// output docs path
-	Output string
-
-	// http server address
-	Addr string
-
-	// auto open browser
-	AutoOpenBrowser bool
-}
-
 // A Corpus holds all the package document
 //
 type Corpus struct {
@@ -60,46 +44,100 @@ type Corpus struct {
 	// output docs path
 	Output string
 
+	// output docs format
+	Format string
+
 	// http server address
 	Addr string
 
-	// Packages is all packages cache
-	Packages map[string]*Package
-
 	// auto open browser when webserver startup
 	AutoOpenBrowser bool
 
-	// Tree is packages tree struct
-	// - a
-	// 	- a-a
-	// - b
-	//
-	Tree Packages
+	// number of packages analyzed in parallel
+	Workers int
+
+	// documents theme
+	Theme string
+
+	// URL the documents are hosted at, without trailing slash
+	BaseURL string
 
 	// pkgAPIInfo contains the information about which package API
-	// features were added in which version of Go.
+	// features were added in which version of Go, or of the module.
 	pkgAPIInfo apiVersions
 
+	// show the release adding the identifiers of the module
+	Versions bool
+
+	// versionsOnce initializes pkgAPIInfo before the first parse
+	versionsOnce sync.Once
+
 	EnablePrivateIndent bool
 
+	// document the test-only identifiers and the external test packages
+	Tests bool
+
 	excludeMatcher Matcher
+
+	// snapshot holds the current *Snapshot, replaced by every reparse
+	snapshot atomic.Value
+
+	// parseMu serializes the reparses
+	parseMu sync.Mutex
+
+	// events publishes corpus changes to the browsers
+	events *eventBroker
 }
 
 // NewCorpus return a new Corpus
 func NewCorpus(config *Config) (*Corpus, error) {
 
 	corpus := &Corpus{
-		Path:            config.Path,
-		Packages:        map[string]*Package{},
-		Output:          config.Output,
-		Addr:            config.Addr,
-		AutoOpenBrowser: config.AutoOpenBrowser,
+		Path:                config.Path,
+		Excludes:            config.Excludes,
+		Output:              config.Output,
+		Format:              config.Format,
+		Addr:                config.Addr,
+		AutoOpenBrowser:     config.AutoOpenBrowser,
+		Workers:             config.Workers,
+		Theme:               config.Theme,
+		BaseURL:             strings.TrimRight(config.BaseURL, "/"),
+		Versions:            config.Versions,
+		EnablePrivateIndent: config.EnablePrivateIndent,
+		Tests:               config.Tests,
+		events:              newEventBroker(),
 	}
 
 	if corpus.Output == "" {
 		corpus.Output = "docs"
 	}
 
+	if corpus.Workers <= 0 {
+		corpus.Workers = runtime.GOMAXPROCS(0)
+	}
+
+	switch corpus.Format {
+	case "":
+		corpus.Format = HTMLFormat
+	case HTMLFormat, JSONFormat, MarkdownFormat, BundleFormat:
+	default:
+		return nil, fmt.Errorf("unknown format %q", corpus.Format)
+	}
+
+	switch corpus.Theme {
+	case "":
+		corpus.Theme = LightTheme
+	case LightTheme, DarkTheme, AutoTheme:
+	default:
+		return nil, fmt.Errorf("unknown theme %q", corpus.Theme)
+	}
+
+	if base, err := url.Parse(corpus.BaseURL); err != nil {
+		return nil, fmt.Errorf("invalid base URL %q: %w", config.BaseURL, err)
+	} else if base.RawQuery != "" || base.Fragment != "" {
+		return nil, fmt.Errorf("invalid base URL %q: query and fragment are not allowed", config.BaseURL)
+	}
+
 	directory, err := filepath.Abs(config.Path)
 	if err != nil {
 		return nil, err
@@ -108,18 +146,57 @@ func NewCorpus(config *Config) (*Corpus, error) {
 	log.Println("document source code path:", directory)
 	log.Println("the documents export path:", corpus.Output)
 
-	config.Excludes = append(config.Excludes, corpus.Output)
+	matcher := &globMatcher{}
 
-	matcher, err := ParseMatchers(config.Excludes)
-	if err != nil {
+	if config.UseGitignore {
+		if err = matcher.addGitignore(directory); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = matcher.add(directory, corpus.Excludes); err != nil {
 		return nil, fmt.Errorf("Error parse match role %s", err.Error())
 	}
 
+	// exclude the documents export path
+	if output, err := filepath.Abs(corpus.Output); err == nil {
+		if rel, err := filepath.Rel(directory, output); err == nil && rel != "." && !strings.HasPrefix(rel, "..") {
+			matcher.add(directory, []string{"/" + filepath.ToSlash(rel) + "/"})
+		}
+	}
+
 	corpus.excludeMatcher = multiMatcher{defaultExcludeMatcher, matcher}
 
+	corpus.snapshot.Store(emptySnapshot)
+
 	return corpus, nil
 }
 
+// Snapshot return the current state of the corpus.
+// The snapshot must not be modified.
+func (c *Corpus) Snapshot() *Snapshot {
+	return c.snapshot.Load().(*Snapshot)
+}
+
+// absURL return the URL of path, a path from the documents root
+func (c *Corpus) absURL(path string) string {
+	return c.BaseURL + path
+}
+
+// PackageURL return the URL of the document of the package importPath
+func (c *Corpus) PackageURL(importPath string) string {
+	return c.absURL("/" + importPath)
+}
+
+// basePath return the URL path the documents are hosted at, without
+// trailing slash
+func (c *Corpus) basePath() string {
+	if base, err := url.Parse(c.BaseURL); err == nil {
+		return strings.TrimRight(base.Path, "/")
+	}
+	return ""
+}
+
 // Export store documents
 func (c *Corpus) Export() (err error) {
 
@@ -127,6 +204,17 @@ func (c *Corpus) Export() (err error) {
 		return err
 	}
 
+	snapshot := c.Snapshot()
+
+	switch c.Format {
+	case JSONFormat:
+		return c.exportJSON(snapshot)
+	case MarkdownFormat:
+		return c.exportMarkdown(snapshot)
+	case BundleFormat:
+		return c.exportBundle(snapshot)
+	}
+
 	// write static asset files
 	for filename, content := range static.Files {
 		if filepath.Ext(filename) == ".html" {
@@ -146,8 +234,91 @@ func (c *Corpus) Export() (err error) {
 		}
 	}
 
+	// write client-side search index
+	{
+		var buf bytes.Buffer
+		index := snapshot.SearchIndex.withURLs(func(item *SearchItem) string {
+			return c.absURL(item.URL)
+		})
+
+		if err = index.WriteStatic(&buf); err != nil {
+			return
+		}
+
+		filename := filepath.Join(c.Output, "_static", StaticIndexFilename)
+
+		log.Println("write search index:", filename)
+
+		if err = ioutil.WriteFile(filename, buf.Bytes(), 0644); err != nil {
+			return
+		}
+	}
+
+	// write the root page, the README of the source code path as served
+	{
+		body, err := c.readme("")
+		if err != nil {
+			return err
+		}
+
+		page := NewPage(c)
+		page.Static = true
+
+		var buf bytes.Buffer
+		if err = page.RenderBody(&buf, body); err != nil {
+			return err
+		}
+
+		filename := filepath.Join(c.Output, "index.html")
+
+		log.Println("write root page:", filename)
+
+		if err = ioutil.WriteFile(filename, buf.Bytes(), 0644); err != nil {
+			return err
+		}
+	}
+
+	// write the import graph page and the graph files
+	{
+		page := NewPage(c)
+		page.Static = true
+
+		var buf bytes.Buffer
+		if err = page.renderGraph(&buf); err != nil {
+			return err
+		}
+
+		dir := filepath.Join(c.Output, "_graph")
+		if err = os.MkdirAll(dir, os.ModePerm); err != nil {
+			return err
+		}
+
+		log.Println("write import graph:", dir)
+
+		if err = ioutil.WriteFile(filepath.Join(dir, "index.html"), buf.Bytes(), 0644); err != nil {
+			return err
+		}
+
+		graph := snapshot.ImportGraph()
+		for name, write := range map[string]func(io.Writer) error{
+			"graph.dot":  graph.WriteDOT,
+			"graph.json": graph.WriteJSON,
+			"graph.svg": func(w io.Writer) error {
+				return graph.WriteSVG(w, page.packageURL)
+			},
+		} {
+			buf.Reset()
+			if err = write(&buf); err != nil {
+				return err
+			}
+			if err = ioutil.WriteFile(filepath.Join(dir, name), buf.Bytes(), 0644); err != nil {
+				return err
+			}
+		}
+	}
+
 	// write documents
-	for _, pkg := range c.Packages {
+	for _, pkg := range snapshot.Packages {
 		if err := c.renderPackage(pkg); err != nil {
 			return err
 		}
@@ -171,6 +342,7 @@ func (c *Corpus) renderPackage(pkg *Package) (err error) {
 	// generate package info page
 	page := NewPage(c)
 	page.Package = pkg
+	page.Static = true
 
 	{
 		var buf bytes.Buffer
@@ -189,8 +361,8 @@ func (c *Corpus) renderPackage(pkg *Package) (err error) {
 
 	// generate packate types page
 	for _, t := range pkg.Types {
-		if IsExported(t.Name) == false {
-			break
+		if !c.EnablePrivateIndent && !IsExported(t.Name) {
+			continue
 		}
 
 		page.Type = t
@@ -213,8 +385,8 @@ func (c *Corpus) renderPackage(pkg *Package) (err error) {
 		funcs = append(funcs, t.Methods...)
 
 		for _, fn := range funcs {
-			if IsExported(fn.Name) == false {
-				break
+			if !c.EnablePrivateIndent && !IsExported(fn.Name) {
+				continue
 			}
 
 			page.Func = fn
@@ -233,6 +405,34 @@ func (c *Corpus) renderPackage(pkg *Package) (err error) {
 		}
 	}
 
+	// generate package source files pages
+	var files []string
+	for filename := range pkg.PAst {
+		files = append(files, filename)
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		page := NewPage(c)
+		page.Package = pkg
+		page.Static = true
+
+		var buf bytes.Buffer
+		if err = page.renderSource(&buf, file, "", nil); err != nil {
+			return err
+		}
+
+		filename := filepath.Join(c.Output, "src", filepath.FromSlash(page.SourcePath)+".html")
+		if err = os.MkdirAll(filepath.Dir(filename), os.ModePerm); err != nil {
+			return err
+		}
+
+		log.Printf("write source %s: %s\n", page.SourcePath, filename)
+		if err = ioutil.WriteFile(filename, buf.Bytes(), 0644); err != nil {
+			return err
+		}
+	}
+
 	return err
 }
 
@@ -270,6 +470,7 @@ func (c *Corpus) Watch(address string) (err error) {
 	server.RegisterOnShutdown(func() {
 		log.Println("webserver shutdown")
 
+		c.events.close()
 		watcher.Close()
 	})
 
@@ -277,7 +478,7 @@ func (c *Corpus) Watch(address string) (err error) {
 	go func() {
 		log.Printf("Listening and serving HTTP on %s\n", address)
 
-		if err := server.ListenAndServe(); err != nil {
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 			log.Fatal(err)
 		}
 	}()
@@ -309,101 +510,248 @@ func (c *Corpus) Watch(address string) (err error) {
 
 func (c *Corpus) broadcast(in <-chan string) {
 
+	var (
+		mu      sync.Mutex
+		changed = map[string]bool{} // changed file names since the last reparse
+	)
+
 	debounced := util.Debouncer(100 * time.Millisecond)
 
 	for e := range in {
 		log.Println("file changed:", e)
 
+		mu.Lock()
+		changed[e] = true
+		mu.Unlock()
+
 		debounced(func() {
 
+			mu.Lock()
+			var names []string
+			for name := range changed {
+				names = append(names, name)
+			}
+			changed = map[string]bool{}
+			mu.Unlock()
+
 			log.Print("parse packages ")
 
-			if err := c.ParsePackages(); err != nil {
+			importPaths, err := c.ReparsePackages(names)
+			if err != nil {
 				log.Println("error", err)
+				c.events.publish(&Event{Type: ErrorEvent, Error: err.Error()})
+				return
 			}
 
 			log.Println("success")
+
+			c.events.publish(&Event{Type: UpdatedEvent, Packages: importPaths})
+
+			// the broken packages render their errors, still notify the browsers
+			var errs []string
+			for _, importPath := range importPaths {
+				if pkg, exists := c.Snapshot().Packages[importPath]; exists && pkg.Err != nil {
+					errs = append(errs, pkg.Err.Error())
+				}
+			}
+			if len(errs) > 0 {
+				c.events.publish(&Event{Type: ErrorEvent, Error: strings.Join(errs, "\n")})
+			}
 		})
 	}
 }
 
-// ParsePackages return packages
+// ParsePackages parses all packages and replaces the corpus snapshot.
+// The current snapshot is kept if parsing fails.
 func (c *Corpus) ParsePackages() error {
 
-	cmd := exec.Command("go", "list", "-json", "./...")
-	cmd.Dir = c.Path
+	c.parseMu.Lock()
+	defer c.parseMu.Unlock()
+
+	c.versionsOnce.Do(func() {
+		c.InitVersionInfo()
+		if c.Versions {
+			c.InitModuleVersions()
+		}
+	})
 
-	out, err := cmd.Output()
-	if ee := (*exec.ExitError)(nil); xerrors.As(err, &ee) {
-		return fmt.Errorf("go list command exited unsuccessfully: %v\n%s", ee.ProcessState.String(), ee.Stderr)
-	} else if err != nil {
+	pkgs, err := c.loadPackages("./...")
+	if err != nil {
 		return err
 	}
 
-	c.Packages = map[string]*Package{}
+	c.analyzePackages(pkgs)
 
-	for dec := json.NewDecoder(bytes.NewReader(out)); ; {
-		var dpkg PackagePublic
-		err := dec.Decode(&dpkg)
-		if err == io.EOF {
-			break
-		} else if err != nil {
-			return err
+	c.snapshot.Store(newSnapshot(pkgs, c.EnablePrivateIndent))
+
+	return nil
+}
+
+// ReparsePackages parses again the packages in the directories of the
+// changed file names, new and removed directories included, and patches
+// them into a new snapshot. All packages are parsed again if a go.mod file
+// changed. It return the import paths of the added, changed and removed packages.
+// The other packages keep the types of their own load, the indexes across
+// packages match the types by their qualified names.
+func (c *Corpus) ReparsePackages(names []string) (importPaths []string, err error) {
+
+	for _, name := range names {
+		if filepath.Base(name) == "go.mod" {
+			if err = c.ParsePackages(); err != nil {
+				return nil, err
+			}
+
+			for importPath := range c.Snapshot().Packages {
+				importPaths = append(importPaths, importPath)
+			}
+			sort.Strings(importPaths)
+
+			return importPaths, nil
+		}
+	}
+
+	c.parseMu.Lock()
+	defer c.parseMu.Unlock()
+
+	root, err := filepath.Abs(c.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		dirs    = map[string]bool{} // directories of the changed packages
+		removed []string            // removed names, maybe directories of packages
+	)
+
+	for _, name := range names {
+		dir := strings.TrimSuffix(name, "/")
+
+		if _, err := os.Stat(dir); os.IsNotExist(err) {
+			removed = append(removed, dir)
+			dirs[filepath.Dir(dir)] = true
+			continue
 		}
 
-		c.Packages[dpkg.ImportPath] = &Package{
-			Dir:         dpkg.Dir,
-			Doc:         dpkg.Doc,
-			Name:        dpkg.Name,
-			ImportPath:  dpkg.ImportPath,
-			Module:      dpkg.Module,
-			Imports:     dpkg.Imports,
-			Stale:       dpkg.Stale,
-			StaleReason: dpkg.StaleReason,
+		if !strings.HasSuffix(name, "/") {
+			dirs[filepath.Dir(dir)] = true
+			continue
 		}
+
+		// a new directory may contain any new packages, skip the
+		// directories ignored by the go command or excluded
+		filepath.Walk(dir, func(path string, f os.FileInfo, err error) error {
+			if err != nil || !f.IsDir() {
+				return nil
+			}
+			base := f.Name()
+			if path != dir && (base == "testdata" || strings.HasPrefix(base, ".") || strings.HasPrefix(base, "_")) {
+				return filepath.SkipDir
+			}
+			if c.excludeMatcher.ExcludePrefix(normalize(path, true)) {
+				return filepath.SkipDir
+			}
+			dirs[path] = true
+			return nil
+		})
 	}
 
-	// parse packages tree
-	for _, pkg := range c.Packages {
-		if pkg.Module != nil && pkg.Module.Path == pkg.ImportPath {
+	var patterns []string
+	for dir := range dirs {
+		rel, err := filepath.Rel(root, dir)
+		if err != nil || strings.HasPrefix(rel, "..") || !hasGoFiles(dir) {
 			continue
 		}
+		patterns = append(patterns, "./"+filepath.ToSlash(rel))
+	}
+	sort.Strings(patterns)
 
-		var (
-			seps       []string
-			parentPath = pkg.ImportPath
-		)
+	var loaded []*Package
+	if len(patterns) > 0 {
+		if loaded, err = c.loadPackages(patterns...); err != nil {
+			return nil, err
+		}
+	}
 
-		if pkg.Module != nil {
-			seps = strings.Split(strings.TrimPrefix(pkg.ImportPath, pkg.Module.Path+"/"), "/")
-		} else {
-			seps = strings.Split(pkg.ImportPath, "/")
+	c.analyzePackages(loaded)
+
+	var (
+		changed = map[string]bool{}
+		pkgs    []*Package
+	)
+
+	for _, pkg := range c.Snapshot().Packages {
+		if dirs[pkg.Dir] || isUnder(pkg.Dir, removed) {
+			changed[pkg.ImportPath] = true
+			continue
 		}
+		pkgs = append(pkgs, pkg)
+	}
+
+	for _, pkg := range loaded {
+		changed[pkg.ImportPath] = true
+		pkgs = append(pkgs, pkg)
+	}
 
-		for i := len(seps); i > 0; i-- {
-			parentPath = strings.TrimSuffix(parentPath, "/"+seps[i-1])
-			if parentPkg, exists := c.Packages[parentPath]; exists {
-				pkg.ParentImportPath = parentPkg.ParentImportPath
-				pkg.Parent = parentPkg
-				parentPkg.SubPackages = append(parentPkg.SubPackages, pkg)
-				break
+	c.snapshot.Store(newSnapshot(pkgs, c.EnablePrivateIndent))
+
+	for importPath := range changed {
+		importPaths = append(importPaths, importPath)
+	}
+	sort.Strings(importPaths)
+
+	return importPaths, nil
+}
+
+// analyzePackages analyzes pkgs by the corpus workers, the error of a
+// package is kept in its Err and does not stop the others
+func (c *Corpus) analyzePackages(pkgs []*Package) {
+
+	var (
+		wg    sync.WaitGroup
+		queue = make(chan *Package)
+	)
+
+	for i := 0; i < c.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for pkg := range queue {
+				pkg.analyze()
 			}
+		}()
+	}
+
+	for _, pkg := range pkgs {
+		if pkg.Err == nil {
+			pkg.Tests = c.Tests
+			queue <- pkg
 		}
 	}
+	close(queue)
 
-	c.Tree = []*Package{}
+	wg.Wait()
+}
 
-	for _, pkg := range c.Packages {
-		if pkg.Parent == nil {
-			c.Tree = append(c.Tree, pkg)
+// hasGoFiles reports whether dir contains any go files other than tests
+func hasGoFiles(dir string) bool {
+	filenames, _ := filepath.Glob(filepath.Join(dir, "*.go"))
+	for _, filename := range filenames {
+		base := filepath.Base(filename)
+		if !strings.HasSuffix(base, "_test.go") && !strings.HasPrefix(base, ".") && !strings.HasPrefix(base, "_") {
+			return true
 		}
+	}
+	return false
+}
 
-		if err = pkg.Analyze(); err != nil {
-			return err
+// isUnder reports whether path is one of dirs or inside them
+func isUnder(path string, dirs []string) bool {
+	for _, dir := range dirs {
+		if path == dir || strings.HasPrefix(path, dir+string(filepath.Separator)) {
+			return true
 		}
 	}
-
-	return nil
+	return false
 }
 
 // --------------------------------------------------------------------
@@ -425,10 +773,12 @@ func (c *Corpus) IndentFilter(nodes interface{}) (result interface{}) {
 				if IsExported(name) {
 					names = append(names, name)
 				}
-				if len(names) > 0 {
-					node.Names = names
-					values = append(values, node)
-				}
+			}
+			if len(names) > 0 {
+				// the nodes are shared by concurrent requests, filter a copy
+				value := *node
+				value.Names = names
+				values = append(values, &value)
 			}
 		}
 		return values
@@ -459,10 +809,11 @@ func (c *Corpus) IndentFilter(nodes interface{}) (result interface{}) {
 				if IsExported(name.Name) {
 					idents = append(idents, name)
 				}
-				if len(idents) > 0 {
-					node.Names = idents
-					fields = append(fields, node)
-				}
+			}
+			if len(idents) > 0 {
+				field := *node
+				field.Names = idents
+				fields = append(fields, &field)
 			}
 		}
 		return fields
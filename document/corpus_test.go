@@ -1,6 +1,10 @@
 package document_test
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -21,11 +25,235 @@ func TestPackageList(t *testing.T) {
 
 	err = corpus.ParsePackages()
 	assert.Nil(err)
-	assert.NotEmpty(corpus.Packages)
+	assert.NotEmpty(corpus.Snapshot().Packages)
 
-	for _, p := range corpus.Packages {
+	for _, p := range corpus.Snapshot().Packages {
 		assert.NotEmpty(p.Dir)
 		assert.NotEmpty(p.ImportPath)
 		assert.NotEmpty(p.Name)
 	}
 }
+
+func TestSnapshotSwap(t *testing.T) {
+	assert := assert.New(t)
+
+	corpus, err := document.NewCorpus(&document.Config{Path: "."})
+	assert.Nil(err)
+
+	err = corpus.ParsePackages()
+	assert.Nil(err)
+
+	var (
+		handler  = corpus.ServeMux()
+		snapshot = corpus.Snapshot()
+		done     = make(chan struct{})
+	)
+
+	go func() {
+		defer close(done)
+		assert.Nil(corpus.ParsePackages())
+	}()
+
+	// requests served during the reparse see a consistent snapshot
+	for i := 0; i < 5; i++ {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest("GET", "/github.com/miclle/gsd/document", nil))
+		assert.Equal(http.StatusOK, w.Code)
+	}
+
+	<-done
+
+	assert.NotSame(snapshot, corpus.Snapshot())
+	assert.Equal(len(snapshot.Packages), len(corpus.Snapshot().Packages))
+	assert.NotEmpty(snapshot.Tree)
+}
+
+func TestReparsePackages(t *testing.T) {
+	assert := assert.New(t)
+
+	root := writeModule(t, map[string]string{
+		"go.mod": "module example.com/m\n\ngo 1.16\n",
+		"a/a.go": "package a\n\nfunc A() {}\n",
+		"b/b.go": "package b\n\nfunc B() {}\n",
+	})
+
+	corpus, err := document.NewCorpus(&document.Config{Path: root})
+	assert.Nil(err)
+	assert.Nil(corpus.ParsePackages())
+
+	before := corpus.Snapshot()
+	assert.Len(before.Packages, 2)
+
+	// changed file
+	writeFiles(t, root, map[string]string{
+		"a/a.go": "package a\n\nfunc A() {}\n\nfunc A2() {}\n",
+	})
+
+	importPaths, err := corpus.ReparsePackages([]string{filepath.Join(root, "a/a.go")})
+	assert.Nil(err)
+	assert.Equal([]string{"example.com/m/a"}, importPaths)
+
+	after := corpus.Snapshot()
+	assert.Len(after.Packages["example.com/m/a"].Funcs, 2)
+	assert.Same(before.Packages["example.com/m/b"].DocPackage, after.Packages["example.com/m/b"].DocPackage)
+	assert.Len(before.Packages["example.com/m/a"].Funcs, 1)
+
+	// new directory
+	writeFiles(t, root, map[string]string{
+		"c/d/d.go": "package d\n",
+	})
+
+	importPaths, err = corpus.ReparsePackages([]string{filepath.Join(root, "c") + "/"})
+	assert.Nil(err)
+	assert.Equal([]string{"example.com/m/c/d"}, importPaths)
+	assert.Len(corpus.Snapshot().Packages, 3)
+
+	// removed directory
+	assert.Nil(os.RemoveAll(filepath.Join(root, "b")))
+
+	importPaths, err = corpus.ReparsePackages([]string{filepath.Join(root, "b")})
+	assert.Nil(err)
+	assert.Equal([]string{"example.com/m/b"}, importPaths)
+	assert.NotContains(corpus.Snapshot().Packages, "example.com/m/b")
+
+	// syntax errors break the package only
+	writeFiles(t, root, map[string]string{
+		"a/a.go": "package a\n\nfunc A() {\n",
+	})
+
+	importPaths, err = corpus.ReparsePackages([]string{filepath.Join(root, "a/a.go")})
+	assert.Nil(err)
+	assert.Equal([]string{"example.com/m/a"}, importPaths)
+	assert.NotNil(corpus.Snapshot().Packages["example.com/m/a"].Err)
+	assert.Nil(corpus.Snapshot().Packages["example.com/m/c/d"].Err)
+
+	// go.mod changes reload all packages
+	writeFiles(t, root, map[string]string{
+		"a/a.go": "package a\n",
+	})
+
+	importPaths, err = corpus.ReparsePackages([]string{filepath.Join(root, "go.mod")})
+	assert.Nil(err)
+	assert.Equal([]string{"example.com/m/a", "example.com/m/c/d"}, importPaths)
+}
+
+func TestReparseIndexes(t *testing.T) {
+	assert := assert.New(t)
+
+	root := writeModule(t, map[string]string{
+		"go.mod": "module example.com/m\n\ngo 1.18\n",
+		"a/a.go": `package a
+
+import "io"
+
+// I reads.
+type I interface {
+	Read(r io.Reader, f func(n int) error) error
+}
+
+// S is a struct.
+type S struct{ Name string }
+
+// F does nothing.
+func F() {}
+`,
+		"b/b.go": `package b
+
+import (
+	"io"
+
+	"example.com/m/a"
+)
+
+// T reads.
+type T struct{}
+
+// Read reads.
+func (T) Read(io.Reader, func(int) error) error { return nil }
+
+// G calls F.
+func G() string {
+	a.F()
+	return a.S{}.Name
+}
+`,
+	})
+
+	corpus, err := document.NewCorpus(&document.Config{Path: root})
+	assert.Nil(err)
+	assert.Nil(corpus.ParsePackages())
+
+	// the cross package indexes survive the reparse of either package
+	check := func() {
+		snapshot := corpus.Snapshot()
+
+		g := snapshot.Calls("example.com/m/b", "", "G")
+		if assert.NotNil(g) {
+			assert.Equal([]*document.CallRef{{ImportPath: "example.com/m/a", PackageName: "a", Name: "F"}}, g.Calls)
+		}
+
+		i := snapshot.Implementations("example.com/m/a", "I")
+		if assert.NotNil(i) {
+			assert.Equal([]*document.TypeRef{{ImportPath: "example.com/m/b", PackageName: "b", Name: "T"}}, i.ImplementedBy)
+		}
+
+		assert.Len(snapshot.References("example.com/m/a", "S.Name"), 2)
+	}
+	check()
+
+	writeFiles(t, root, map[string]string{
+		"a/a.go": "package a\n\nimport \"io\"\n\n// I reads.\ntype I interface {\n\tRead(r io.Reader, f func(n int) error) error\n}\n\n// S is a struct.\ntype S struct{ Name string }\n\n// F does nothing.\nfunc F() {}\n\n// F2 does nothing.\nfunc F2() {}\n",
+	})
+	_, err = corpus.ReparsePackages([]string{filepath.Join(root, "a/a.go")})
+	assert.Nil(err)
+	check()
+
+	_, err = corpus.ReparsePackages([]string{filepath.Join(root, "b/b.go")})
+	assert.Nil(err)
+	check()
+}
+
+func TestPackageErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	root := writeModule(t, map[string]string{
+		"go.mod":      "module example.com/m\n\ngo 1.16\n",
+		"a/a.go":      "package a\n\nfunc A() {}\n",
+		"b/b.go":      "package b\n\nfunc B() {}\n",
+		"b/b_test.go": "package b\n\nfunc TestB( {}\n",
+		"c/c.go":      "package c\n\nfunc C() {\n",
+	})
+
+	corpus, err := document.NewCorpus(&document.Config{Path: root, Workers: 2})
+	assert.Nil(err)
+	assert.Nil(corpus.ParsePackages())
+
+	packages := corpus.Snapshot().Packages
+	assert.Len(packages, 3)
+
+	assert.Nil(packages["example.com/m/a"].Err)
+	assert.Len(packages["example.com/m/a"].Funcs, 1)
+	assert.NotNil(packages["example.com/m/c"].Err)
+
+	// a broken test file leaves out the examples only
+	assert.Nil(packages["example.com/m/b"].Err)
+	assert.Len(packages["example.com/m/b"].Funcs, 1)
+
+	// broken packages render their errors
+	w := httptest.NewRecorder()
+	corpus.ServeMux().ServeHTTP(w, httptest.NewRequest("GET", "/example.com/m/c", nil))
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Contains(w.Body.String(), "package-error")
+
+	w = httptest.NewRecorder()
+	corpus.ServeMux().ServeHTTP(w, httptest.NewRequest("GET", "/example.com/m/b", nil))
+	assert.Equal(http.StatusOK, w.Code)
+	assert.NotContains(w.Body.String(), "package-error")
+
+	// and the test API
+	corpus, err = document.NewCorpus(&document.Config{Path: root, Tests: true})
+	assert.Nil(err)
+	assert.Nil(corpus.ParsePackages())
+	assert.Nil(corpus.Snapshot().Packages["example.com/m/b"].Err)
+	assert.Empty(corpus.Snapshot().Packages["example.com/m/b"].TestAPI)
+}
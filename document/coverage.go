@@ -0,0 +1,327 @@
+// This file implements the documentation coverage report of a corpus
+// snapshot: the exported identifiers of every package are counted, the ones
+// without a doc comment are listed with their source position.
+
+package document
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/doc"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Coverage is the documentation coverage of a corpus snapshot
+type Coverage struct {
+	Documented int                `json:"documented"`
+	Total      int                `json:"total"`
+	Packages   []*PackageCoverage `json:"packages"`
+}
+
+// PackageCoverage is the documentation coverage of a package
+type PackageCoverage struct {
+	ImportPath   string          `json:"import_path"`
+	Documented   int             `json:"documented"`
+	Total        int             `json:"total"`
+	Undocumented []*Undocumented `json:"undocumented,omitempty"`
+}
+
+// Undocumented is an identifier without doc comment
+type Undocumented struct {
+	Kind   string `json:"kind"` // package, const, var, type, field, func or method
+	Name   string `json:"name"` // qualified name, e.g. "Corpus.Export"
+	File   string `json:"file"`
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+}
+
+// Position return the "file:line:column" position of the identifier
+func (u *Undocumented) Position() string {
+	return fmt.Sprintf("%s:%d:%d", u.File, u.Line, u.Column)
+}
+
+// Percent return the percentage of documented identifiers, 100 if there are none
+func (c *Coverage) Percent() float64 {
+	return percent(c.Documented, c.Total)
+}
+
+// Percent return the percentage of documented identifiers, 100 if there are none
+func (c *PackageCoverage) Percent() float64 {
+	return percent(c.Documented, c.Total)
+}
+
+func percent(documented, total int) float64 {
+	if total == 0 {
+		return 100
+	}
+	return float64(documented) * 100 / float64(total)
+}
+
+// SnapshotCoverage return the documentation coverage of the snapshot
+// packages. Unexported identifiers are counted only if private is true.
+// The packages with errors are left out of the coverage, their errors are
+// joined in the returned error.
+func SnapshotCoverage(snapshot *Snapshot, private bool) (*Coverage, error) {
+
+	coverage := &Coverage{Packages: []*PackageCoverage{}}
+
+	var importPaths []string
+	for importPath := range snapshot.Packages {
+		importPaths = append(importPaths, importPath)
+	}
+	sort.Strings(importPaths)
+
+	var errs []error
+	for _, importPath := range importPaths {
+		pkg := snapshot.Packages[importPath]
+		if pkg.Err != nil {
+			errs = append(errs, pkg.Err)
+			continue
+		}
+		if pkg.DocPackage == nil {
+			continue
+		}
+
+		pc := packageCoverage(pkg, private)
+		coverage.Documented += pc.Documented
+		coverage.Total += pc.Total
+		coverage.Packages = append(coverage.Packages, pc)
+	}
+
+	return coverage, errors.Join(errs...)
+}
+
+// packageCoverage counts the documented identifiers of pkg
+func packageCoverage(pkg *Package, private bool) *PackageCoverage {
+
+	var (
+		pc       = &PackageCoverage{ImportPath: pkg.ImportPath}
+		exported = func(name string) bool { return private || IsExported(name) }
+	)
+
+	count := func(documented bool, kind, name string, pos token.Pos) {
+		pc.Total++
+		if documented {
+			pc.Documented++
+			return
+		}
+
+		position := pkg.FSet.Position(pos)
+		pc.Undocumented = append(pc.Undocumented, &Undocumented{
+			Kind:   kind,
+			Name:   name,
+			File:   displayPath(position.Filename),
+			Line:   position.Line,
+			Column: position.Column,
+		})
+	}
+
+	// the package clause of the first file
+	var filenames []string
+	for filename := range pkg.PAst {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+	if len(filenames) > 0 {
+		count(pkg.Doc != "", "package", pkg.Name, pkg.PAst[filenames[0]].Name.Pos())
+	}
+
+	// a value is documented by the comment of its group or its own
+	values := func(values []*doc.Value) {
+		for _, v := range values {
+			kind := v.Decl.Tok.String()
+			for _, spec := range v.Decl.Specs {
+				vs := spec.(*ast.ValueSpec)
+				for _, ident := range vs.Names {
+					if exported(ident.Name) {
+						count(v.Doc != "" || vs.Doc != nil || vs.Comment != nil, kind, ident.Name, ident.Pos())
+					}
+				}
+			}
+		}
+	}
+
+	// the interface methods have no declaration, they are counted with the fields
+	funcs := func(prefix string, funcs []*Func) {
+		for _, fn := range funcs {
+			if !exported(fn.Name) || fn.Decl == nil || fn.Level > 0 {
+				continue
+			}
+			kind := "func"
+			if fn.Recv != "" {
+				kind = "method"
+			}
+			count(fn.Doc != "", kind, prefix+fn.Name, fn.Decl.Name.Pos())
+		}
+	}
+
+	values(pkg.Consts)
+	values(pkg.Vars)
+	funcs("", pkg.Funcs)
+
+	for _, t := range pkg.Types {
+		if !exported(t.Name) {
+			continue
+		}
+
+		spec := typeSpecOf(t)
+		if spec != nil {
+			count(t.Doc != "" || spec.Doc != nil || spec.Comment != nil, "type", t.Name, spec.Name.Pos())
+		}
+
+		values(t.Consts)
+		values(t.Vars)
+		funcs("", t.Funcs)
+		funcs(t.Name+".", t.Methods)
+
+		if spec == nil {
+			continue
+		}
+
+		// the embedded fields and interfaces are documented by their own type
+		_, iface := spec.Type.(*ast.InterfaceType)
+		for _, f := range TypeFields(t) {
+			kind := "field"
+			if iface {
+				if !isFuncType(f.Field.Type) {
+					continue
+				}
+				kind = "method"
+			}
+			for _, ident := range f.Field.Names {
+				if exported(ident.Name) {
+					count(f.Field.Doc != nil || f.Field.Comment != nil, kind, t.Name+"."+ident.Name, ident.Pos())
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(pc.Undocumented, func(i, j int) bool {
+		a, b := pc.Undocumented[i], pc.Undocumented[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		return a.Line < b.Line || a.Line == b.Line && a.Column < b.Column
+	})
+
+	return pc
+}
+
+// displayPath return filename relative to the working directory if it is
+// below it, else filename
+func displayPath(filename string) string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return filename
+	}
+	rel, err := filepath.Rel(wd, filename)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return filename
+	}
+	return rel
+}
+
+// --------------------------------------------------------------------
+
+// WriteText writes the coverage as plain text, the percentage of every
+// package followed by its undocumented identifiers.
+func (c *Coverage) WriteText(w io.Writer) error {
+
+	var buf bytes.Buffer
+	printf := func(format string, args ...interface{}) {
+		fmt.Fprintf(&buf, format, args...)
+	}
+
+	for _, pkg := range c.Packages {
+		printf("%5.1f%%  %s (%d/%d)\n", pkg.Percent(), pkg.ImportPath, pkg.Documented, pkg.Total)
+		for _, u := range pkg.Undocumented {
+			printf("\t%s: %s %s is undocumented\n", u.Position(), u.Kind, u.Name)
+		}
+	}
+
+	printf("\n%5.1f%%  total (%d/%d)\n", c.Percent(), c.Documented, c.Total)
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// WriteJSON writes the indented JSON encoding of the coverage
+func (c *Coverage) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(c)
+}
+
+// junitTestSuites is the JUnit XML report of the coverage, every package
+// is a test case failing if it has undocumented identifiers
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit writes the coverage as a JUnit XML report
+func (c *Coverage) WriteJUnit(w io.Writer) error {
+
+	suite := junitTestSuite{Name: "documentation coverage"}
+
+	for _, pkg := range c.Packages {
+		tc := junitTestCase{Name: pkg.ImportPath, ClassName: "gsd.coverage"}
+
+		if len(pkg.Undocumented) > 0 {
+			var text strings.Builder
+			for _, u := range pkg.Undocumented {
+				fmt.Fprintf(&text, "%s: %s %s is undocumented\n", u.Position(), u.Kind, u.Name)
+			}
+			tc.Failure = &junitFailure{
+				Message: fmt.Sprintf("%d undocumented identifiers, %.1f%% documented", len(pkg.Undocumented), pkg.Percent()),
+				Text:    text.String(),
+			}
+			suite.Failures++
+		}
+
+		suite.Cases = append(suite.Cases, tc)
+		suite.Tests++
+	}
+
+	report := junitTestSuites{Tests: suite.Tests, Failures: suite.Failures, Suites: []junitTestSuite{suite}}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
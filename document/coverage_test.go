@@ -0,0 +1,136 @@
+package document_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miclle/gsd/document"
+)
+
+func TestCoverage(t *testing.T) {
+	assert := assert.New(t)
+
+	root := writeModule(t, map[string]string{
+		"go.mod": "module example.com/m\n\ngo 1.16\n",
+		"a/a.go": `// Package a is documented.
+package a
+
+// Limits.
+const (
+	Min = 0
+	Max = 10
+)
+
+var (
+	// Debug enables the logs.
+	Debug bool
+	Verbose bool
+)
+
+// T is a type.
+type T struct {
+	// Name is documented.
+	Name string
+	Size int // Size is documented.
+	Tags []string
+	hidden int
+}
+
+type I interface {
+	// Close is documented.
+	Close() error
+	Flush() error
+}
+
+// Run is documented.
+func Run() {}
+
+func Stop() {}
+
+func (t *T) Len() int { return 0 }
+
+func helper() {}
+`,
+		"b/b.go": "package b\n\n// B is documented.\nfunc B() {}\n",
+		"c/c.go": "package c\n\nfunc C() {\n",
+	})
+
+	corpus, err := document.NewCorpus(&document.Config{Path: root})
+	assert.Nil(err)
+	assert.Nil(corpus.ParsePackages())
+
+	// the broken package is reported, not counted
+	coverage, err := document.SnapshotCoverage(corpus.Snapshot(), false)
+	if assert.NotNil(err) {
+		assert.Contains(err.Error(), "example.com/m/c")
+	}
+
+	packages := map[string]*document.PackageCoverage{}
+	for _, pkg := range coverage.Packages {
+		packages[pkg.ImportPath] = pkg
+	}
+
+	a := packages["example.com/m/a"]
+	if assert.NotNil(a) {
+		undocumented := map[string]string{}
+		for _, u := range a.Undocumented {
+			undocumented[u.Name] = u.Kind
+		}
+		assert.Equal(map[string]string{
+			"Verbose": "var",
+			"T.Tags":  "field",
+			"I":       "type",
+			"I.Flush": "method",
+			"Stop":    "func",
+			"T.Len":   "method",
+		}, undocumented)
+
+		// package, Min, Max, Debug, Verbose, T, Name, Size, Tags, I, Close, Flush, Run, Stop, Len
+		assert.Equal(15, a.Total)
+		assert.Equal(9, a.Documented)
+		assert.Equal(filepath.Join(root, "a", "a.go"), a.Undocumented[0].File)
+		assert.Equal(13, a.Undocumented[0].Line)
+	}
+
+	assert.NotContains(packages, "example.com/m/c")
+
+	b := packages["example.com/m/b"]
+	if assert.NotNil(b) {
+		assert.Len(b.Undocumented, 1)
+		assert.Equal("package", b.Undocumented[0].Kind)
+		assert.Equal("b", b.Undocumented[0].Name)
+		assert.Equal(50.0, b.Percent())
+	}
+
+	assert.Equal(17, coverage.Total)
+	assert.Equal(10, coverage.Documented)
+
+	var buf bytes.Buffer
+	assert.Nil(coverage.WriteText(&buf))
+	assert.Contains(buf.String(), " 60.0%  example.com/m/a (9/15)\n")
+	assert.Contains(buf.String(), "a.go:13:2: var Verbose is undocumented\n")
+	assert.Contains(buf.String(), " 58.8%  total (10/17)\n")
+
+	buf.Reset()
+	assert.Nil(coverage.WriteJSON(&buf))
+	var decoded document.Coverage
+	assert.Nil(json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(coverage.Total, decoded.Total)
+	assert.Equal(len(coverage.Packages), len(decoded.Packages))
+
+	buf.Reset()
+	assert.Nil(coverage.WriteJUnit(&buf))
+	var junit struct {
+		Tests    int `xml:"tests,attr"`
+		Failures int `xml:"failures,attr"`
+	}
+	assert.Nil(xml.Unmarshal(buf.Bytes(), &junit))
+	assert.Equal(2, junit.Tests)
+	assert.Equal(2, junit.Failures)
+	assert.Contains(buf.String(), `<testcase name="example.com/m/a" classname="gsd.coverage">`)
+}
@@ -0,0 +1,417 @@
+// This file implements the API diff of two corpus revisions. The exported
+// identifiers of every package are flattened into symbols holding their
+// printed signature, the symbols of the revisions are compared by name.
+
+package document
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/doc"
+	"go/token"
+	"go/types"
+	"html/template"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/miclle/gsd/static"
+)
+
+// DiffChange is the kind of a change between two revisions
+type DiffChange string
+
+const (
+	// DiffAdded the identifier or package is new
+	DiffAdded DiffChange = "added"
+	// DiffRemoved the identifier or package is gone
+	DiffRemoved DiffChange = "removed"
+	// DiffChanged the signature of the identifier, or the API of the package, changed
+	DiffChanged DiffChange = "changed"
+)
+
+// APIDiff is the API difference of two revisions of a corpus
+type APIDiff struct {
+	Old      string         `json:"old"`
+	New      string         `json:"new"`
+	Packages []*PackageDiff `json:"packages"`
+}
+
+// PackageDiff is the API difference of a package
+type PackageDiff struct {
+	ImportPath string       `json:"import_path"`
+	Change     DiffChange   `json:"change"`
+	Changes    []*APIChange `json:"changes,omitempty"`
+}
+
+// APIChange is a changed identifier of a package
+type APIChange struct {
+	Change   DiffChange `json:"change"`
+	Kind     string     `json:"kind"` // const, var, func, type, method or field
+	Name     string     `json:"name"` // qualified name, e.g. "Corpus.Export"
+	Old      string     `json:"old,omitempty"`
+	New      string     `json:"new,omitempty"`
+	Breaking bool       `json:"breaking"`
+}
+
+// apiSymbol is an identifier of a package API
+type apiSymbol struct {
+	kind      string
+	signature string
+	iface     bool // interface method, adding one breaks the implementations
+}
+
+// DiffSnapshots return the API difference of the base snapshot, the old
+// revision, and the head snapshot, the new one. Unexported identifiers are
+// compared only if private is true. The packages with errors in either
+// revision are left out of the diff, their errors are joined in the returned
+// error.
+func DiffSnapshots(base, head *Snapshot, private bool) (*APIDiff, error) {
+
+	diff := &APIDiff{Packages: []*PackageDiff{}}
+
+	importPaths := map[string]bool{}
+	for importPath := range base.Packages {
+		importPaths[importPath] = true
+	}
+	for importPath := range head.Packages {
+		importPaths[importPath] = true
+	}
+
+	var sorted []string
+	for importPath := range importPaths {
+		sorted = append(sorted, importPath)
+	}
+	sort.Strings(sorted)
+
+	var errs []error
+	for _, importPath := range sorted {
+		var (
+			oldPkg = base.Packages[importPath]
+			newPkg = head.Packages[importPath]
+		)
+
+		// the identifiers of a broken package are unknown, not removed
+		var broken bool
+		if oldPkg != nil && oldPkg.Err != nil {
+			errs = append(errs, fmt.Errorf("old revision: %w", oldPkg.Err))
+			broken = true
+		}
+		if newPkg != nil && newPkg.Err != nil {
+			errs = append(errs, fmt.Errorf("new revision: %w", newPkg.Err))
+			broken = true
+		}
+		if broken {
+			continue
+		}
+
+		switch {
+		case oldPkg == nil:
+			diff.Packages = append(diff.Packages, &PackageDiff{ImportPath: importPath, Change: DiffAdded})
+		case newPkg == nil:
+			diff.Packages = append(diff.Packages, &PackageDiff{ImportPath: importPath, Change: DiffRemoved})
+		default:
+			if changes := diffSymbols(apiSymbols(oldPkg, private), apiSymbols(newPkg, private)); len(changes) > 0 {
+				diff.Packages = append(diff.Packages, &PackageDiff{ImportPath: importPath, Change: DiffChanged, Changes: changes})
+			}
+		}
+	}
+
+	return diff, errors.Join(errs...)
+}
+
+// diffSymbols return the changes from the base to the head symbols, sorted by name
+func diffSymbols(base, head map[string]*apiSymbol) (changes []*APIChange) {
+
+	for name, o := range base {
+		n, exists := head[name]
+		switch {
+		case !exists:
+			changes = append(changes, &APIChange{Change: DiffRemoved, Kind: o.kind, Name: name, Old: o.signature, Breaking: true})
+		case o.signature != n.signature || o.kind != n.kind:
+			changes = append(changes, &APIChange{Change: DiffChanged, Kind: n.kind, Name: name, Old: o.signature, New: n.signature, Breaking: true})
+		}
+	}
+
+	for name, n := range head {
+		if _, exists := base[name]; !exists {
+			changes = append(changes, &APIChange{Change: DiffAdded, Kind: n.kind, Name: name, New: n.signature, Breaking: n.iface})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Name < changes[j].Name
+	})
+
+	return
+}
+
+// apiSymbols return the identifiers of the pkg API by qualified name
+func apiSymbols(pkg *Package, private bool) map[string]*apiSymbol {
+
+	var (
+		symbols  = map[string]*apiSymbol{}
+		exported = func(name string) bool { return private || IsExported(name) }
+		print    = func(node interface{}) string { return printNode(pkg.FSet, node) }
+	)
+
+	if pkg.DocPackage == nil {
+		return symbols
+	}
+
+	values := func(values []*doc.Value) {
+		for _, v := range values {
+			kind := v.Decl.Tok.String()
+			for _, spec := range v.Decl.Specs {
+				vs := spec.(*ast.ValueSpec)
+				for i, ident := range vs.Names {
+					if !exported(ident.Name) {
+						continue
+					}
+					// the value of a variable is not part of the API, only its type
+					single := &ast.ValueSpec{Names: []*ast.Ident{ident}, Type: vs.Type}
+					if v.Decl.Tok == token.CONST && i < len(vs.Values) {
+						single.Values = []ast.Expr{vs.Values[i]}
+					}
+					signature := kind + " " + print(single)
+					if v.Decl.Tok == token.VAR && pkg.TypesPackage != nil {
+						if obj := pkg.TypesPackage.Scope().Lookup(ident.Name); obj != nil {
+							signature = kind + " " + ident.Name + " " + types.TypeString(obj.Type(), types.RelativeTo(pkg.TypesPackage))
+						}
+					}
+					symbols[ident.Name] = &apiSymbol{kind: kind, signature: signature}
+				}
+			}
+		}
+	}
+
+	// the interface methods have no declaration, they are listed by TypeFields
+	funcs := func(prefix string, funcs []*Func) {
+		for _, fn := range funcs {
+			if !exported(fn.Name) || fn.Decl == nil || fn.Level > 0 {
+				continue
+			}
+			kind := "func"
+			if fn.Recv != "" {
+				kind = "method"
+			}
+			decl := &ast.FuncDecl{
+				Recv: unnamedFields(fn.Decl.Recv),
+				Name: fn.Decl.Name,
+				Type: unnamedFuncType(fn.Decl.Type),
+			}
+			symbols[prefix+fn.Name] = &apiSymbol{kind: kind, signature: print(decl)}
+		}
+	}
+
+	values(pkg.Consts)
+	values(pkg.Vars)
+	funcs("", pkg.Funcs)
+
+	for _, t := range pkg.Types {
+		if !exported(t.Name) {
+			continue
+		}
+
+		values(t.Consts)
+		values(t.Vars)
+		funcs("", t.Funcs)
+		funcs(t.Name+".", t.Methods)
+
+		spec := typeSpecOf(t)
+		if spec == nil {
+			continue
+		}
+
+		// the struct and interface members are compared one by one
+		_, iface := spec.Type.(*ast.InterfaceType)
+
+		decl := *spec
+		decl.Doc, decl.Comment = nil, nil
+		switch spec.Type.(type) {
+		case *ast.StructType:
+			decl.Type = ast.NewIdent("struct")
+		case *ast.InterfaceType:
+			decl.Type = ast.NewIdent("interface")
+		}
+		symbols[t.Name] = &apiSymbol{kind: "type", signature: "type " + print(&decl)}
+
+		for _, f := range TypeFields(t) {
+			typ := print(f.Field.Type)
+
+			if len(f.Field.Names) == 0 {
+				// embedded field, or interface embedding a qualified interface
+				if name := embeddedName(f.Field.Type); exported(name) {
+					kind := "field"
+					if iface {
+						kind = "method"
+					}
+					symbols[t.Name+"."+name] = &apiSymbol{kind: kind, signature: "embedded " + typ + fieldTag(f.Field), iface: iface}
+				}
+				continue
+			}
+
+			for _, ident := range f.Field.Names {
+				if !exported(ident.Name) {
+					continue
+				}
+				switch {
+				case !iface:
+					symbols[t.Name+"."+ident.Name] = &apiSymbol{kind: "field", signature: ident.Name + " " + typ + fieldTag(f.Field)}
+				case isFuncType(f.Field.Type):
+					typ = print(unnamedFuncType(f.Field.Type.(*ast.FuncType)))
+					symbols[t.Name+"."+ident.Name] = &apiSymbol{kind: "method", signature: ident.Name + strings.TrimPrefix(typ, "func"), iface: true}
+				default:
+					// embedded interface, named by TypeFields
+					symbols[t.Name+"."+ident.Name] = &apiSymbol{kind: "method", signature: "embedded " + typ, iface: true}
+				}
+			}
+		}
+	}
+
+	return symbols
+}
+
+// typeSpecOf return the type spec declaring t
+func typeSpecOf(t *Type) *ast.TypeSpec {
+	for _, spec := range t.Decl.Specs {
+		if ts, ok := spec.(*ast.TypeSpec); ok && ts.Name.Name == t.Name {
+			return ts
+		}
+	}
+	return nil
+}
+
+func isFuncType(x ast.Expr) bool {
+	_, ok := x.(*ast.FuncType)
+	return ok
+}
+
+// fieldTag return the printed tag of a struct field, with a leading space
+func fieldTag(f *ast.Field) string {
+	if f.Tag == nil || f.Tag.Kind != token.STRING {
+		return ""
+	}
+	return " " + f.Tag.Value
+}
+
+// unnamedFuncType return a copy of the func type without parameter names,
+// renaming a parameter does not change the API.
+func unnamedFuncType(ft *ast.FuncType) *ast.FuncType {
+	return &ast.FuncType{
+		TypeParams: ft.TypeParams,
+		Params:     unnamedFields(ft.Params),
+		Results:    unnamedFields(ft.Results),
+	}
+}
+
+// unnamedFields return a copy of list with one unnamed field per name
+func unnamedFields(list *ast.FieldList) *ast.FieldList {
+	if list == nil {
+		return nil
+	}
+	unnamed := &ast.FieldList{}
+	for _, f := range list.List {
+		for n := 0; n < len(f.Names) || n == 0; n++ {
+			unnamed.List = append(unnamed.List, &ast.Field{Type: f.Type})
+		}
+	}
+	return unnamed
+}
+
+// --------------------------------------------------------------------
+
+// Breaking return the number of breaking changes
+func (diff *APIDiff) Breaking() (n int) {
+	for _, pkg := range diff.Packages {
+		if pkg.Change == DiffRemoved {
+			n++
+		}
+		for _, change := range pkg.Changes {
+			if change.Breaking {
+				n++
+			}
+		}
+	}
+	return
+}
+
+// WriteText writes the difference as plain text, one change per line
+// prefixed by "+", "-" or "~".
+func (diff *APIDiff) WriteText(w io.Writer) error {
+
+	var buf bytes.Buffer
+	printf := func(format string, args ...interface{}) {
+		fmt.Fprintf(&buf, format, args...)
+	}
+
+	printf("API diff %s..%s\n", revisionName(diff.Old), revisionName(diff.New))
+
+	marks := map[DiffChange]string{DiffAdded: "+", DiffRemoved: "-", DiffChanged: "~"}
+
+	for _, pkg := range diff.Packages {
+		printf("\n%s %s", marks[pkg.Change], pkg.ImportPath)
+		if pkg.Change != DiffChanged {
+			printf(" (package %s)", pkg.Change)
+		}
+		printf("\n")
+
+		for _, change := range pkg.Changes {
+			printf("\t%s %s %s", marks[change.Change], change.Kind, change.Name)
+			if change.Breaking {
+				printf(" [breaking]")
+			}
+			printf("\n")
+
+			for _, line := range strings.Split(change.Old, "\n") {
+				if change.Old != "" {
+					printf("\t\t- %s\n", line)
+				}
+			}
+			for _, line := range strings.Split(change.New, "\n") {
+				if change.New != "" {
+					printf("\t\t+ %s\n", line)
+				}
+			}
+		}
+	}
+
+	printf("\n%d packages changed, %d breaking changes\n", len(diff.Packages), diff.Breaking())
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// WriteJSON writes the indented JSON encoding of the difference
+func (diff *APIDiff) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(diff)
+}
+
+// WriteHTML writes the difference as a self-contained HTML report page
+func (diff *APIDiff) WriteHTML(w io.Writer) error {
+
+	funcs := template.FuncMap{
+		"static_file": staticFileFunc,
+		"revision":    revisionName,
+	}
+
+	t, err := template.New("diff.html").Funcs(funcs).Parse(static.Files["diff.html"])
+	if err != nil {
+		return err
+	}
+
+	return t.Execute(w, diff)
+}
+
+// revisionName return the display name of a revision, the working tree if empty
+func revisionName(ref string) string {
+	if ref == "" {
+		return "working tree"
+	}
+	return ref
+}
@@ -0,0 +1,170 @@
+package document_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miclle/gsd/document"
+)
+
+func TestDiffRevisions(t *testing.T) {
+	assert := assert.New(t)
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not found")
+	}
+
+	root := writeModule(t, map[string]string{
+		"go.mod": "module example.com/m\n\ngo 1.16\n",
+		"a/a.go": `package a
+
+// T is a type.
+type T struct {
+	Name string
+	Size int
+}
+
+// I is an interface.
+type I interface {
+	Close() error
+}
+
+func Run(name string) {}
+
+func Stop() {}
+
+func (t *T) Hello(name string) string { return name }
+`,
+		"b/b.go": "package b\n\nfunc B() {}\n",
+		"d/d.go": "package d\n\nfunc D() {}\n",
+	})
+
+	git := func(args ...string) {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=gsd", "-c", "user.email=gsd@example.com"}, args...)...)
+		cmd.Dir = root
+		output, err := cmd.CombinedOutput()
+		assert.Nil(err, string(output))
+	}
+
+	git("init", "--quiet")
+	git("add", "-A")
+	git("commit", "--quiet", "-m", "v1")
+	git("tag", "v1.0.0")
+
+	writeFiles(t, root, map[string]string{
+		"a/a.go": `package a
+
+// T is a type.
+type T struct {
+	Name string
+	Tags []string
+}
+
+// I is an interface.
+type I interface {
+	Close() error
+	Flush() error
+}
+
+func Run(ctx string, opts ...int) {}
+
+func Start() {}
+
+func (c *T) Hello(s string) string { return s }
+`,
+	})
+	assert.Nil(os.RemoveAll(filepath.Join(root, "b")))
+	writeFiles(t, root, map[string]string{
+		"c/c.go": "package c\n",
+		"d/d.go": "package d\n\nfunc D() {\n",
+	})
+
+	config := &document.Config{Path: root}
+
+	base, err := document.LoadRevision(config, "v1.0.0")
+	assert.Nil(err)
+
+	head, err := document.LoadRevision(config, "")
+	assert.Nil(err)
+
+	// the broken package is reported, its identifiers are not removed
+	diff, err := document.DiffSnapshots(base, head, false)
+	if assert.NotNil(err) {
+		assert.Contains(err.Error(), "new revision: load package example.com/m/d")
+	}
+	diff.Old = "v1.0.0"
+
+	changes := map[string]*document.APIChange{}
+	packages := map[string]document.DiffChange{}
+	for _, pkg := range diff.Packages {
+		packages[pkg.ImportPath] = pkg.Change
+		for _, change := range pkg.Changes {
+			changes[change.Name] = change
+		}
+	}
+
+	assert.Equal(document.DiffChanged, packages["example.com/m/a"])
+	assert.Equal(document.DiffRemoved, packages["example.com/m/b"])
+	assert.Equal(document.DiffAdded, packages["example.com/m/c"])
+	assert.NotContains(packages, "example.com/m/d")
+
+	if assert.Contains(changes, "Run") {
+		assert.Equal(document.DiffChanged, changes["Run"].Change)
+		assert.Equal("func Run(string)", changes["Run"].Old)
+		assert.Equal("func Run(string, ...int)", changes["Run"].New)
+		assert.True(changes["Run"].Breaking)
+	}
+	if assert.Contains(changes, "Stop") {
+		assert.Equal(document.DiffRemoved, changes["Stop"].Change)
+	}
+	if assert.Contains(changes, "Start") {
+		assert.Equal(document.DiffAdded, changes["Start"].Change)
+		assert.False(changes["Start"].Breaking)
+	}
+	if assert.Contains(changes, "T.Size") {
+		assert.Equal(document.DiffRemoved, changes["T.Size"].Change)
+		assert.Equal("field", changes["T.Size"].Kind)
+	}
+	if assert.Contains(changes, "T.Tags") {
+		assert.False(changes["T.Tags"].Breaking)
+	}
+	if assert.Contains(changes, "I.Flush") {
+		assert.Equal("method", changes["I.Flush"].Kind)
+		assert.True(changes["I.Flush"].Breaking)
+	}
+
+	// renamed parameters and receivers are not changes
+	assert.NotContains(changes, "T.Hello")
+	assert.NotContains(changes, "T")
+	assert.NotContains(changes, "T.Name")
+
+	assert.Equal(5, diff.Breaking())
+
+	var buf bytes.Buffer
+	assert.Nil(diff.WriteText(&buf))
+	assert.Contains(buf.String(), "API diff v1.0.0..working tree")
+	assert.Contains(buf.String(), "\t~ func Run [breaking]\n\t\t- func Run(string)\n\t\t+ func Run(string, ...int)\n")
+	assert.Contains(buf.String(), "- example.com/m/b (package removed)")
+
+	buf.Reset()
+	assert.Nil(diff.WriteJSON(&buf))
+	var decoded document.APIDiff
+	assert.Nil(json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(len(diff.Packages), len(decoded.Packages))
+
+	buf.Reset()
+	assert.Nil(diff.WriteHTML(&buf))
+	assert.Contains(buf.String(), "5 breaking changes")
+	assert.Contains(buf.String(), "<code>I.Flush</code>")
+
+	// the temporary worktree is removed
+	output, err := exec.Command("git", "-C", root, "worktree", "list").Output()
+	assert.Nil(err)
+	assert.Equal(1, bytes.Count(output, []byte("\n")))
+}
@@ -39,6 +39,8 @@ type Documentation struct {
 	Body string // markdown content
 
 	Summary Markdown // summary annotation content
+
+	Blocks []Markdown // paragraphs of the content, with their annotation marks
 }
 
 // Markdown type
@@ -81,6 +83,12 @@ func NewDocumentation(text string) Documentation {
 			fmt.Fprintf(segment, "</div>\n\n")
 		}
 
+		doc.Blocks = append(doc.Blocks, Markdown{
+			Text:   output,
+			HTML:   segment.String(),
+			Marker: marker,
+		})
+
 		if i == 0 {
 			doc.Summary = Markdown{
 				Text: output,
@@ -140,6 +148,18 @@ func MarkdownConvert(text string) string {
 
 var markerRx = lazyregexp.New(`^[ \t]*\@(GSD|gsd):([\w]+)?`)
 
+// knownMarkers are the annotation markers handled by the documents, the
+// other markers are reported by the linter
+var knownMarkers = map[string]bool{
+	"summary":   true,
+	"ignore":    true,
+	"note":      true,
+	"tip":       true,
+	"important": true,
+	"warning":   true,
+	"caution":   true,
+}
+
 // Annotation extracts the expected output and whether there was a valid output comment
 func Annotation(text string) (output, marker string, match bool) {
 
@@ -0,0 +1,134 @@
+// This file implements the corpus events pushed to the browsers with
+// Server-Sent Events, so open documents reload after the sources change.
+
+package document
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// EventType corpus event type
+type EventType string
+
+const (
+	// UpdatedEvent is sent after a successful reparse of the corpus
+	UpdatedEvent EventType = "updated"
+	// ErrorEvent is sent after a failed reparse of the corpus
+	ErrorEvent EventType = "error"
+)
+
+// An Event describes a change of the corpus
+type Event struct {
+	Type     EventType `json:"type"`
+	Packages []string  `json:"packages,omitempty"` // import paths of the changed packages
+	Error    string    `json:"error,omitempty"`    // reparse error message
+}
+
+// eventBroker fans out corpus events to the subscribed clients
+type eventBroker struct {
+	mu      sync.Mutex
+	clients map[chan *Event]struct{}
+
+	done      chan struct{} // closed when the broker is closed
+	closeOnce sync.Once
+}
+
+func newEventBroker() *eventBroker {
+	return &eventBroker{
+		clients: map[chan *Event]struct{}{},
+		done:    make(chan struct{}),
+	}
+}
+
+// close ends the event streams, the open ones and the ones opened later.
+// The server shutdown waits for the active connections, the streams of the
+// open documents included, so it closes the broker first.
+func (b *eventBroker) close() {
+	b.closeOnce.Do(func() {
+		close(b.done)
+	})
+}
+
+// subscribe return a channel receiving all events published from now on
+func (b *eventBroker) subscribe() chan *Event {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ch := make(chan *Event, 8)
+	b.clients[ch] = struct{}{}
+	return ch
+}
+
+// unsubscribe stops sending events to ch
+func (b *eventBroker) unsubscribe(ch chan *Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.clients, ch)
+}
+
+// publish sends the event to all clients, slow clients miss it
+func (b *eventBroker) publish(event *Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.clients {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// keepAliveInterval is the interval of the comments sent to keep idle event streams open
+const keepAliveInterval = 30 * time.Second
+
+// EventsHandler streams the corpus events as Server-Sent Events
+func (c *Corpus) EventsHandler(w http.ResponseWriter, req *http.Request) {
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	events := c.events.subscribe()
+	defer c.events.unsubscribe(events)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ticker := time.NewTicker(keepAliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-req.Context().Done():
+			return
+
+		case <-c.events.done:
+			return
+
+		case <-ticker.C:
+			fmt.Fprint(w, ": keep-alive\n\n")
+			flusher.Flush()
+
+		case event := <-events:
+			data, err := json.Marshal(event)
+			if err != nil {
+				log.Println("event encode error", err.Error())
+				continue
+			}
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, data)
+			flusher.Flush()
+		}
+	}
+}
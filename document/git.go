@@ -0,0 +1,137 @@
+package document
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// git runs the git command with args in dir and return its trimmed output
+func git(dir string, args ...string) (string, error) {
+
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("git %s: %s", strings.Join(args, " "), msg)
+		}
+		return "", fmt.Errorf("git %s: %w", strings.Join(args, " "), err)
+	}
+
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// LoadRevision return the snapshot of the corpus of config at the git
+// revision ref, the working tree if ref is empty. The revision is checked
+// out into a temporary worktree, which is removed once it is parsed.
+func LoadRevision(config *Config, ref string) (*Snapshot, error) {
+
+	if ref == "" {
+		corpus, err := NewCorpus(config)
+		if err != nil {
+			return nil, err
+		}
+		if err = corpus.ParsePackages(); err != nil {
+			return nil, err
+		}
+		return corpus.Snapshot(), nil
+	}
+
+	directory, err := filepath.Abs(config.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	top, err := git(directory, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return nil, err
+	}
+
+	// the source code path in the worktree, symbolic links resolved as git does
+	if resolved, err := filepath.EvalSymlinks(directory); err == nil {
+		directory = resolved
+	}
+	rel, err := filepath.Rel(top, directory)
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := os.MkdirTemp("", "gsd-worktree-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmp)
+
+	worktree := filepath.Join(tmp, "src")
+	if _, err = git(top, "worktree", "add", "--detach", "--quiet", worktree, ref); err != nil {
+		return nil, err
+	}
+	defer git(top, "worktree", "remove", "--force", worktree)
+
+	revision := *config
+	revision.Path = filepath.Join(worktree, rel)
+
+	corpus, err := NewCorpus(&revision)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = corpus.ParsePackages(); err != nil {
+		return nil, fmt.Errorf("parse revision %s: %w", ref, err)
+	}
+
+	return corpus.Snapshot(), nil
+}
+
+// gitBlobs return the contents of the git objects named by hashes
+func gitBlobs(dir string, hashes []string) (map[string][]byte, error) {
+
+	blobs := map[string][]byte{}
+	if len(hashes) == 0 {
+		return blobs, nil
+	}
+
+	cmd := exec.Command("git", "cat-file", "--batch")
+	cmd.Dir = dir
+	cmd.Stdin = strings.NewReader(strings.Join(hashes, "\n") + "\n")
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git cat-file: %w", err)
+	}
+
+	// every object is a "<hash> <type> <size>" line, its content and a newline
+	for len(output) > 0 {
+		eol := bytes.IndexByte(output, '\n')
+		if eol < 0 {
+			return nil, fmt.Errorf("git cat-file: truncated output")
+		}
+
+		header := strings.Fields(string(output[:eol]))
+		output = output[eol+1:]
+
+		if len(header) != 3 {
+			// "<hash> missing"
+			continue
+		}
+
+		size, err := strconv.Atoi(header[2])
+		if err != nil || size+1 > len(output) {
+			return nil, fmt.Errorf("git cat-file: invalid object %s", header[0])
+		}
+
+		blobs[header[0]] = output[:size]
+		output = output[size+1:]
+	}
+
+	return blobs, nil
+}
@@ -0,0 +1,522 @@
+// This file implements the import graph of the corpus packages, with the
+// import cycles and the layering violations, rendered as SVG by a layered
+// layout, or written as DOT and JSON.
+
+package document
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// InternalViolation is the layering rule broken by an import of an
+// internal package from outside the tree rooted at its parent directory
+const InternalViolation = "internal"
+
+// ImportGraph is the import graph of the packages of a snapshot
+type ImportGraph struct {
+	Packages []string      `json:"packages"`         // sorted import paths
+	Edges    []*ImportEdge `json:"edges"`            // imports between the packages, sorted
+	Cycles   [][]string    `json:"cycles,omitempty"` // sorted import paths of each cycle
+
+	imports    map[string][]*ImportEdge // by importer
+	importedBy map[string][]*ImportEdge // by imported package
+}
+
+// ImportEdge is an import of a package by another
+type ImportEdge struct {
+	From      string `json:"from"`                // importer
+	To        string `json:"to"`                  // imported package
+	Cycle     bool   `json:"cycle,omitempty"`     // the import is part of a cycle
+	Violation string `json:"violation,omitempty"` // layering rule broken by the import
+}
+
+// ImportGraph return the import graph of the packages of the snapshot
+func (s *Snapshot) ImportGraph() *ImportGraph {
+	s.graphOnce.Do(func() {
+		s.graph = NewImportGraph(s.Packages)
+	})
+	return s.graph
+}
+
+// NewImportGraph return the import graph of pkgs, by import path. The
+// imports of other packages are left out, but their layering violations.
+func NewImportGraph(pkgs map[string]*Package) *ImportGraph {
+
+	g := &ImportGraph{
+		Packages:   []string{},
+		Edges:      []*ImportEdge{},
+		imports:    map[string][]*ImportEdge{},
+		importedBy: map[string][]*ImportEdge{},
+	}
+
+	for importPath := range pkgs {
+		g.Packages = append(g.Packages, importPath)
+	}
+	sort.Strings(g.Packages)
+
+	for _, importPath := range g.Packages {
+		for _, imported := range pkgs[importPath].Imports {
+			edge := &ImportEdge{From: importPath, To: imported}
+			if internalViolation(importPath, imported) {
+				edge.Violation = InternalViolation
+			}
+
+			g.imports[importPath] = append(g.imports[importPath], edge)
+			if _, exists := pkgs[imported]; exists {
+				g.importedBy[imported] = append(g.importedBy[imported], edge)
+				g.Edges = append(g.Edges, edge)
+			}
+		}
+	}
+
+	// the imports within a strongly connected component are cycles
+	components := g.components()
+	cycles := map[int][]string{}
+	for _, edge := range g.Edges {
+		if components[edge.From] == components[edge.To] {
+			edge.Cycle = true
+		}
+	}
+	for _, importPath := range g.Packages {
+		c := components[importPath]
+		cycles[c] = append(cycles[c], importPath)
+	}
+	for _, importPath := range g.Packages {
+		cycle := cycles[components[importPath]]
+		if cycle[0] == importPath && (len(cycle) > 1 || g.importsItself(importPath)) {
+			g.Cycles = append(g.Cycles, cycle)
+		}
+	}
+
+	return g
+}
+
+// internalViolation reports whether the import of path by importer breaks
+// the internal packages rule, "a/b/internal/c" is importable by the tree
+// rooted at "a/b" only
+func internalViolation(importer, path string) bool {
+
+	var parent string
+	switch {
+	case strings.HasSuffix(path, "/internal"):
+		parent = strings.TrimSuffix(path, "/internal")
+	case strings.Contains(path, "/internal/"):
+		parent = path[:strings.LastIndex(path, "/internal/")]
+	default:
+		// the internal packages of the standard library are never
+		// imported by the corpus
+		return false
+	}
+
+	return importer != parent && !strings.HasPrefix(importer, parent+"/")
+}
+
+func (g *ImportGraph) importsItself(importPath string) bool {
+	for _, edge := range g.imports[importPath] {
+		if edge.To == importPath {
+			return true
+		}
+	}
+	return false
+}
+
+// components return the strongly connected component of every package,
+// numbered in reverse topological order by Tarjan's algorithm
+func (g *ImportGraph) components() map[string]int {
+
+	var (
+		index     = map[string]int{}
+		lowlink   = map[string]int{}
+		onStack   = map[string]bool{}
+		stack     []string
+		component = map[string]int{}
+		count     int
+	)
+
+	var connect func(v string)
+	connect = func(v string) {
+		index[v] = len(index)
+		lowlink[v] = index[v]
+		stack = append(stack, v)
+		onStack[v] = true
+
+		for _, edge := range g.importedEdges(v) {
+			w := edge.To
+			if _, visited := index[w]; !visited {
+				connect(w)
+				if lowlink[w] < lowlink[v] {
+					lowlink[v] = lowlink[w]
+				}
+			} else if onStack[w] && index[w] < lowlink[v] {
+				lowlink[v] = index[w]
+			}
+		}
+
+		if lowlink[v] == index[v] {
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[w] = false
+				component[w] = count
+				if w == v {
+					break
+				}
+			}
+			count++
+		}
+	}
+
+	for _, importPath := range g.Packages {
+		if _, visited := index[importPath]; !visited {
+			connect(importPath)
+		}
+	}
+
+	return component
+}
+
+// importedEdges return the imports of importPath within the graph
+func (g *ImportGraph) importedEdges(importPath string) (edges []*ImportEdge) {
+	for _, edge := range g.imports[importPath] {
+		if len(g.importedBy[edge.To]) > 0 {
+			edges = append(edges, edge)
+		}
+	}
+	return
+}
+
+// Imports return the imports of the package importPath, of the graph
+// packages or not
+func (g *ImportGraph) Imports(importPath string) []*ImportEdge {
+	return g.imports[importPath]
+}
+
+// ImportedBy return the imports of the package importPath by the graph
+// packages
+func (g *ImportGraph) ImportedBy(importPath string) []*ImportEdge {
+	return g.importedBy[importPath]
+}
+
+// Violations return the imports breaking a layering rule, of the graph
+// packages or not
+func (g *ImportGraph) Violations() (edges []*ImportEdge) {
+	for _, importPath := range g.Packages {
+		for _, edge := range g.imports[importPath] {
+			if edge.Violation != "" {
+				edges = append(edges, edge)
+			}
+		}
+	}
+	return
+}
+
+// WriteJSON writes the graph as indented JSON
+func (g *ImportGraph) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(g)
+}
+
+// WriteDOT writes the graph in the DOT language of Graphviz, the cycles
+// in red and the layering violations dashed in orange
+func (g *ImportGraph) WriteDOT(w io.Writer) error {
+
+	var buf bytes.Buffer
+
+	buf.WriteString("digraph imports {\n")
+	buf.WriteString("\tnode [shape=box, fontname=\"sans-serif\", fontsize=10];\n")
+
+	for _, importPath := range g.Packages {
+		fmt.Fprintf(&buf, "\t%s;\n", strconv.Quote(importPath))
+	}
+
+	for _, edge := range g.Edges {
+		var attrs []string
+		switch {
+		case edge.Violation != "":
+			attrs = append(attrs, "color=orange", "style=dashed", "label="+strconv.Quote(edge.Violation))
+		case edge.Cycle:
+			attrs = append(attrs, "color=red")
+		}
+
+		fmt.Fprintf(&buf, "\t%s -> %s", strconv.Quote(edge.From), strconv.Quote(edge.To))
+		if len(attrs) > 0 {
+			fmt.Fprintf(&buf, " [%s]", strings.Join(attrs, ", "))
+		}
+		buf.WriteString(";\n")
+	}
+
+	buf.WriteString("}\n")
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// graph layout metrics, in pixels
+const (
+	graphMargin     = 10
+	graphNodeHeight = 24
+	graphCharWidth  = 7
+	graphNodePad    = 10 // left and right of the label
+	graphNodeGap    = 24 // between the nodes of a layer
+	graphLayerGap   = 60 // between the layers
+)
+
+// graphNode is a package placed by the layout
+type graphNode struct {
+	importPath string
+	label      string
+	layer      int
+	order      float64 // barycenter of the importers, in the layer
+	x, y, w    int
+}
+
+// WriteSVG writes the graph as a standalone SVG image, the importers above
+// the imported packages, linked to their documents by url
+func (g *ImportGraph) WriteSVG(w io.Writer, url func(importPath string) string) error {
+
+	nodes := g.layout()
+
+	var width, height int
+	for _, node := range nodes {
+		if right := node.x + node.w + graphMargin; right > width {
+			width = right
+		}
+		if bottom := node.y + graphNodeHeight + graphMargin + graphLayerGap/2; bottom > height {
+			height = bottom
+		}
+	}
+
+	var buf bytes.Buffer
+	printf := func(format string, args ...interface{}) {
+		fmt.Fprintf(&buf, format, args...)
+	}
+
+	printf(`<svg xmlns="http://www.w3.org/2000/svg" class="import-graph" width="%d" height="%d" viewBox="0 0 %d %d">`+"\n", width, height, width, height)
+	printf(`<style>
+.import-graph-node rect { fill: #fff; stroke: #375EAB; }
+.import-graph-node text { font: 12px sans-serif; fill: #375EAB; }
+.import-graph-edge { fill: none; stroke: #999; }
+.import-graph-cycle { stroke: #d73a49; }
+.import-graph-violation { stroke: #e36209; stroke-dasharray: 4 3; }
+</style>
+<defs>
+`)
+	for _, marker := range []struct{ id, color string }{{"import-graph-arrow", "#999"}, {"import-graph-arrow-cycle", "#d73a49"}, {"import-graph-arrow-violation", "#e36209"}} {
+		printf(`<marker id="%s" viewBox="0 0 10 10" refX="10" refY="5" markerWidth="6" markerHeight="6" orient="auto"><path d="M0,0L10,5L0,10z" fill="%s"/></marker>`+"\n", marker.id, marker.color)
+	}
+	printf("</defs>\n")
+
+	for _, edge := range g.Edges {
+		from, to := nodes[edge.From], nodes[edge.To]
+
+		class, marker := "import-graph-edge", "import-graph-arrow"
+		switch {
+		case edge.Violation != "":
+			class, marker = class+" import-graph-violation", marker+"-violation"
+		case edge.Cycle:
+			class, marker = class+" import-graph-cycle", marker+"-cycle"
+		}
+
+		x1, x2 := from.x+from.w/2, to.x+to.w/2
+		var d string
+		if from.layer == to.layer {
+			// the imports of a cycle, between the bottoms of the nodes
+			y := from.y + graphNodeHeight
+			d = fmt.Sprintf("M%d,%d C%d,%d %d,%d %d,%d", x1, y, x1, y+graphLayerGap/2, x2, y+graphLayerGap/2, x2, y)
+		} else {
+			y1, y2 := from.y+graphNodeHeight, to.y
+			d = fmt.Sprintf("M%d,%d C%d,%d %d,%d %d,%d", x1, y1, x1, y1+graphLayerGap/2, x2, y2-graphLayerGap/2, x2, y2)
+		}
+
+		printf(`<path class="%s" d="%s" marker-end="url(#%s)"><title>%s imports %s</title></path>`+"\n",
+			class, d, marker, template.HTMLEscapeString(edge.From), template.HTMLEscapeString(edge.To))
+	}
+
+	for _, importPath := range g.Packages {
+		node := nodes[importPath]
+		printf(`<a class="import-graph-node" href="%s"><title>%s</title>`, template.HTMLEscapeString(url(importPath)), template.HTMLEscapeString(importPath))
+		printf(`<rect x="%d" y="%d" width="%d" height="%d" rx="3"/>`, node.x, node.y, node.w, graphNodeHeight)
+		printf(`<text x="%d" y="%d">%s</text></a>`+"\n", node.x+graphNodePad, node.y+graphNodeHeight-8, template.HTMLEscapeString(node.label))
+	}
+
+	printf("</svg>\n")
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// layout places the packages in layers, every package below its
+// importers, the packages of a cycle in the same layer. The packages of
+// a layer are ordered by the barycenter of their importers.
+func (g *ImportGraph) layout() map[string]*graphNode {
+
+	nodes := map[string]*graphNode{}
+	prefix := commonPathPrefix(g.Packages)
+	for _, importPath := range g.Packages {
+		label := strings.TrimPrefix(strings.TrimPrefix(importPath, prefix), "/")
+		if label == "" {
+			label = importPath[strings.LastIndex(importPath, "/")+1:]
+		}
+		nodes[importPath] = &graphNode{importPath: importPath, label: label}
+	}
+
+	// the components are numbered in reverse topological order, the
+	// importers have the greater numbers
+	components := g.components()
+	var ordered []string
+	ordered = append(ordered, g.Packages...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return components[ordered[i]] > components[ordered[j]]
+	})
+
+	layers := map[int]int{} // by component
+	for _, importPath := range ordered {
+		c := components[importPath]
+		for _, edge := range g.importedEdges(importPath) {
+			if d := components[edge.To]; d != c && layers[d] < layers[c]+1 {
+				layers[d] = layers[c] + 1
+			}
+		}
+	}
+
+	var rows [][]*graphNode
+	for _, importPath := range g.Packages {
+		node := nodes[importPath]
+		node.layer = layers[components[importPath]]
+		for len(rows) <= node.layer {
+			rows = append(rows, nil)
+		}
+		rows[node.layer] = append(rows[node.layer], node)
+	}
+
+	var width int
+	for i, row := range rows {
+		if i > 0 {
+			for _, node := range row {
+				var sum, count float64
+				for _, edge := range g.importedBy[node.importPath] {
+					if importer := nodes[edge.From]; importer.layer < node.layer {
+						sum += float64(importer.x + importer.w/2)
+						count++
+					}
+				}
+				if count > 0 {
+					node.order = sum / count
+				}
+			}
+			sort.SliceStable(row, func(i, j int) bool {
+				return row[i].order < row[j].order
+			})
+		}
+
+		x := graphMargin
+		for _, node := range row {
+			node.w = len(node.label)*graphCharWidth + 2*graphNodePad
+			node.x = x
+			node.y = graphMargin + i*(graphNodeHeight+graphLayerGap)
+			x += node.w + graphNodeGap
+		}
+		if x > width {
+			width = x
+		}
+	}
+
+	// center the layers
+	for _, row := range rows {
+		if len(row) == 0 {
+			continue
+		}
+		last := row[len(row)-1]
+		shift := (width - (last.x + last.w + graphNodeGap)) / 2
+		for _, node := range row {
+			node.x += shift
+		}
+	}
+
+	return nodes
+}
+
+// commonPathPrefix return the longest common directory of paths, empty for
+// a single path
+func commonPathPrefix(paths []string) string {
+	if len(paths) < 2 {
+		return ""
+	}
+
+	prefix := strings.Split(paths[0], "/")
+	for _, path := range paths[1:] {
+		elems := strings.Split(path, "/")
+		i := 0
+		for i < len(prefix) && i < len(elems) && prefix[i] == elems[i] {
+			i++
+		}
+		prefix = prefix[:i]
+	}
+
+	return strings.Join(prefix, "/")
+}
+
+// renderGraph renders the import graph page of the page snapshot
+func (page *Page) renderGraph(w *bytes.Buffer) error {
+
+	page.Graph = page.Snapshot.ImportGraph()
+	page.Title = "Import graph"
+
+	var svg bytes.Buffer
+	if err := page.Graph.WriteSVG(&svg, page.packageURL); err != nil {
+		return err
+	}
+	page.GraphSVG = svg.String()
+
+	return page.Render(w, GraphPage)
+}
+
+// GraphHandler serve the import graph page, "/_graph/", and the graph as
+// "graph.svg", "graph.dot" and "graph.json"
+func (c *Corpus) GraphHandler(w http.ResponseWriter, req *http.Request) {
+
+	// logging
+	log.Printf("%s %s\n", req.RemoteAddr, req.URL)
+
+	var (
+		page = NewPage(c)
+		buf  bytes.Buffer
+		err  error
+	)
+
+	switch strings.TrimPrefix(req.URL.Path, "/_graph/") {
+	case "":
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		err = page.renderGraph(&buf)
+	case "graph.svg":
+		w.Header().Set("Content-Type", "image/svg+xml")
+		err = page.Snapshot.ImportGraph().WriteSVG(&buf, page.packageURL)
+	case "graph.dot":
+		w.Header().Set("Content-Type", "text/vnd.graphviz; charset=utf-8")
+		err = page.Snapshot.ImportGraph().WriteDOT(&buf)
+	case "graph.json":
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = page.Snapshot.ImportGraph().WriteJSON(&buf)
+	default:
+		http.NotFound(w, req)
+		return
+	}
+
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	w.Write(buf.Bytes())
+}
@@ -0,0 +1,102 @@
+package document_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miclle/gsd/document"
+)
+
+func TestImportGraph(t *testing.T) {
+	assert := assert.New(t)
+
+	// the loader rejects the cycles, the graph is built by hand
+	graph := document.NewImportGraph(map[string]*document.Package{
+		"m/a":            {ImportPath: "m/a", Imports: []string{"fmt", "m/b"}},
+		"m/b":            {ImportPath: "m/b", Imports: []string{"m/c"}},
+		"m/c":            {ImportPath: "m/c", Imports: []string{"m/a", "m/d/internal/x"}},
+		"m/d":            {ImportPath: "m/d", Imports: []string{"m/d/internal/x"}},
+		"m/d/internal/x": {ImportPath: "m/d/internal/x"},
+	})
+
+	assert.Equal([][]string{{"m/a", "m/b", "m/c"}}, graph.Cycles)
+	assert.Equal([]*document.ImportEdge{
+		{From: "m/c", To: "m/d/internal/x", Violation: document.InternalViolation},
+	}, graph.Violations())
+	assert.Len(graph.Imports("m/a"), 2)
+	assert.Equal([]*document.ImportEdge{{From: "m/b", To: "m/c", Cycle: true}}, graph.ImportedBy("m/c"))
+
+	var buf bytes.Buffer
+	assert.Nil(graph.WriteDOT(&buf))
+	assert.Equal(`digraph imports {
+	node [shape=box, fontname="sans-serif", fontsize=10];
+	"m/a";
+	"m/b";
+	"m/c";
+	"m/d";
+	"m/d/internal/x";
+	"m/a" -> "m/b" [color=red];
+	"m/b" -> "m/c" [color=red];
+	"m/c" -> "m/a" [color=red];
+	"m/c" -> "m/d/internal/x" [color=orange, style=dashed, label="internal"];
+	"m/d" -> "m/d/internal/x";
+}
+`, buf.String())
+
+	buf.Reset()
+	assert.Nil(graph.WriteSVG(&buf, func(importPath string) string { return "/" + importPath }))
+	svg := buf.String()
+	assert.Contains(svg, `<a class="import-graph-node" href="/m/d/internal/x"><title>m/d/internal/x</title>`)
+	assert.Contains(svg, `<path class="import-graph-edge import-graph-cycle"`)
+	assert.Contains(svg, `<path class="import-graph-edge import-graph-violation"`)
+
+	// the documents
+
+	root := writeModule(t, map[string]string{
+		"go.mod": "module example.com/m\n\ngo 1.16\n",
+		"a/a.go": "// Package a is imported.\npackage a\n\n// A is a.\nconst A = 1\n",
+		"b/b.go": "// Package b imports a.\npackage b\n\nimport \"example.com/m/a\"\n\n// B is b.\nconst B = a.A\n",
+	})
+
+	corpus, err := document.NewCorpus(&document.Config{Path: root})
+	assert.Nil(err)
+	assert.Nil(corpus.ParsePackages())
+
+	mux := corpus.ServeMux()
+	get := func(path string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		return recorder
+	}
+
+	body := get("/example.com/m/a/").Body.String()
+	assert.Contains(body, `<h2 id="pkg-imported-by">Imported by</h2>`)
+	assert.Contains(body, `<li><a href="/example.com/m/b">example.com/m/b</a></li>`)
+
+	recorder := get("/_graph/")
+	assert.Equal(http.StatusOK, recorder.Code)
+	assert.Contains(recorder.Body.String(), `<a class="import-graph-node" href="/example.com/m/a">`)
+
+	recorder = get("/_graph/graph.json")
+	assert.Equal(http.StatusOK, recorder.Code)
+	assert.JSONEq(`{
+		"packages": ["example.com/m/a", "example.com/m/b"],
+		"edges": [{"from": "example.com/m/b", "to": "example.com/m/a"}]
+	}`, recorder.Body.String())
+
+	assert.Equal(http.StatusNotFound, get("/_graph/graph.png").Code)
+
+	output := t.TempDir()
+	corpus, err = document.NewCorpus(&document.Config{Path: root, Output: output})
+	assert.Nil(err)
+	assert.Nil(corpus.Export())
+
+	for _, name := range []string{"index.html", "graph.svg", "graph.dot", "graph.json"} {
+		assert.FileExists(filepath.Join(output, "_graph", name))
+	}
+}
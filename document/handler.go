@@ -2,6 +2,7 @@ package document
 
 import (
 	"bytes"
+	"encoding/json"
 	"io/ioutil"
 	"log"
 	"mime"
@@ -30,8 +31,21 @@ func (c *Corpus) ServeMux() *http.ServeMux {
 	})
 
 	mux.HandleFunc("/_static/", c.StaticHandler)
+	mux.HandleFunc("/search", c.SearchHandler)
+	mux.HandleFunc("/src/", c.SourceHandler)
+	mux.HandleFunc("/_references", c.ReferencesHandler)
+	mux.HandleFunc("/_graph/", c.GraphHandler)
+	mux.HandleFunc("/_events", c.EventsHandler)
 	mux.HandleFunc("/", c.DocumentHandler)
 
+	// serve under the path of the base URL
+	if path := c.basePath(); path != "" {
+		root := http.NewServeMux()
+		root.Handle(path+"/", http.StripPrefix(path, mux))
+		root.Handle(path, http.RedirectHandler(path+"/", http.StatusMovedPermanently))
+		return root
+	}
+
 	return mux
 }
 
@@ -88,19 +102,24 @@ func (c *Corpus) DocumentHandler(w http.ResponseWriter, req *http.Request) {
 		}
 	}
 
+	var page = NewPage(c)
+
 	// get package
-	pkg, exists := c.Packages[importPath]
+	pkg, exists := page.Snapshot.Packages[importPath]
 	if !exists {
 		c.ReadmeHandler(w, req)
 		return
 	}
 
-	var page = NewPage(c)
 	page.Package = pkg
 	page.Title = pkg.Name
 	page.PageType = PackagePage
 
 	for _, t := range pkg.Types {
+		if !c.EnablePrivateIndent && !IsExported(t.Name) {
+			// not exported, as renderPackage
+			continue
+		}
 		if typeName != "" && typeName == t.Name {
 			page.Title = t.Name
 			page.Type = t
@@ -111,6 +130,9 @@ func (c *Corpus) DocumentHandler(w http.ResponseWriter, req *http.Request) {
 			funcs = append(funcs, t.Methods...)
 
 			for _, fn := range funcs {
+				if !c.EnablePrivateIndent && !IsExported(fn.Name) {
+					continue
+				}
 				if funcName != "" && funcName == fn.Name {
 					page.Func = fn
 					page.Title = fn.Name
@@ -120,6 +142,12 @@ func (c *Corpus) DocumentHandler(w http.ResponseWriter, req *http.Request) {
 		}
 	}
 
+	// the type or func has no page
+	if typeName != "" && page.Type == nil || funcName != "" && page.Func == nil {
+		http.NotFound(w, req)
+		return
+	}
+
 	// render page
 	if err := page.Render(w, page.PageType); err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
@@ -127,44 +155,109 @@ func (c *Corpus) DocumentHandler(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
-// ReadmeHandler handle the README.md file
-func (c *Corpus) ReadmeHandler(w http.ResponseWriter, req *http.Request) {
+// maxSearchResults is the maximum number of search results served
+const maxSearchResults = 100
 
-	var path = strings.Trim(req.URL.Path, "/")
+// SearchHandler serve search results of the "q" query parameter,
+// as JSON if requested with "format=json" or an Accept header, as a page otherwise.
+func (c *Corpus) SearchHandler(w http.ResponseWriter, req *http.Request) {
 
-	var filename string
+	// logging
+	log.Printf("%s %s\n", req.RemoteAddr, req.URL)
 
-	for _, name := range ReadmeFileNames {
-		if dir := filepath.Join(c.Path, path, name); fileExists(dir) {
-			filename = dir
-			break
+	var (
+		query   = strings.TrimSpace(req.FormValue("q"))
+		results = c.Snapshot().SearchIndex.Search(query, maxSearchResults)
+	)
+
+	// the results are new, the indexed items are shared by the snapshot
+	for _, result := range results {
+		item := *result.SearchItem
+		item.URL = c.absURL(item.URL)
+		result.SearchItem = &item
+	}
+
+	if req.FormValue("format") == "json" || strings.Contains(req.Header.Get("Accept"), "application/json") {
+		if results == nil {
+			results = []*SearchResult{}
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		if err := json.NewEncoder(w).Encode(results); err != nil {
+			log.Println("search encode error", err.Error())
 		}
+		return
 	}
 
-	data, err := ioutil.ReadFile(filename)
-	if err != nil {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+	var page = NewPage(c)
+	page.Title = "Search"
+	page.Query = query
+	page.SearchResults = results
+
+	if err := page.Render(w, SearchPage); err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		w.Write([]byte(err.Error()))
-		return
 	}
+}
 
-	var buf bytes.Buffer
-	if err := md.Convert(data, &buf); err != nil {
+// ReadmeHandler handle the README.md file. The root page without README
+// is empty, the other directories without README are not found.
+func (c *Corpus) ReadmeHandler(w http.ResponseWriter, req *http.Request) {
+
+	var path = strings.Trim(req.URL.Path, "/")
+
+	body, err := c.readme(path)
+	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		w.Write([]byte(err.Error()))
 		return
 	}
 
-	cjk := autocorrect.Format(buf.String())
+	if body == nil && path != "" {
+		http.NotFound(w, req)
+		return
+	}
 
 	// render page
 	page := NewPage(c)
-	if err := page.RenderBody(w, []byte(cjk)); err != nil {
+	if err := page.RenderBody(w, body); err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		w.Write([]byte(err.Error()))
 	}
 }
 
+// readme return the HTML of the README file of the source directory dir,
+// nil if there is none
+func (c *Corpus) readme(dir string) ([]byte, error) {
+
+	var filename string
+
+	for _, name := range ReadmeFileNames {
+		if path := filepath.Join(c.Path, dir, name); fileExists(path) {
+			filename = path
+			break
+		}
+	}
+
+	if filename == "" {
+		return nil, nil
+	}
+
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := md.Convert(data, &buf); err != nil {
+		return nil, err
+	}
+
+	return []byte(autocorrect.Format(buf.String())), nil
+}
+
 // fileExists checks if a file exists and is not a directory before we
 // try using it to prevent further errors.
 func fileExists(filename string) bool {
@@ -0,0 +1,306 @@
+// This file implements the "implements" relationships of the types of the
+// corpus, between its concrete types and interfaces, and the well-known
+// interfaces of the standard library.
+
+package document
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// TypeRef is a reference to a type of the corpus, or of the standard library
+type TypeRef struct {
+	ImportPath  string // "builtin" for the predeclared error
+	PackageName string // empty for the predeclared error
+	Name        string
+
+	// Pointer is true if only the pointer type *Name of the concrete type
+	// implements the interface, by its pointer receiver methods
+	Pointer bool
+}
+
+// Qualified return the name of the type qualified by its package name,
+// unless it is declared by the package importPath
+func (r *TypeRef) Qualified(importPath string) string {
+	if r.PackageName == "" || r.ImportPath == importPath {
+		return r.Name
+	}
+	return r.PackageName + "." + r.Name
+}
+
+// Implementations are the "implements" relationships of a type
+type Implementations struct {
+	Implements    []*TypeRef // interfaces implemented by the concrete type
+	ImplementedBy []*TypeRef // concrete types of the corpus implementing the interface
+}
+
+// wellKnownInterfaces are the interfaces of the standard library whose
+// methods are declared with predeclared types only, so that they are
+// type-checked without the standard library
+var wellKnownInterfaces = []struct {
+	importPath, name, methods string
+}{
+	{builtinPkgPath, "error", "Error() string"},
+	{"fmt", "Stringer", "String() string"},
+	{"fmt", "GoStringer", "GoString() string"},
+	{"io", "Reader", "Read(p []byte) (n int, err error)"},
+	{"io", "Writer", "Write(p []byte) (n int, err error)"},
+	{"io", "Closer", "Close() error"},
+	{"io", "Seeker", "Seek(offset int64, whence int) (int64, error)"},
+	{"io", "ByteReader", "ReadByte() (byte, error)"},
+	{"io", "ByteWriter", "WriteByte(c byte) error"},
+	{"io", "StringWriter", "WriteString(s string) (n int, err error)"},
+	{"sort", "Interface", "Len() int; Less(i, j int) bool; Swap(i, j int)"},
+	{"encoding", "TextMarshaler", "MarshalText() (text []byte, err error)"},
+	{"encoding", "TextUnmarshaler", "UnmarshalText(text []byte) error"},
+	{"encoding", "BinaryMarshaler", "MarshalBinary() (data []byte, err error)"},
+	{"encoding", "BinaryUnmarshaler", "UnmarshalBinary(data []byte) error"},
+	{"encoding/json", "Marshaler", "MarshalJSON() ([]byte, error)"},
+	{"encoding/json", "Unmarshaler", "UnmarshalJSON([]byte) error"},
+}
+
+var (
+	wellKnownOnce  sync.Once
+	wellKnownTypes []*types.Interface // by wellKnownInterfaces index
+)
+
+// wellKnownInterfaceTypes return the type-checked well-known interfaces
+func wellKnownInterfaceTypes() []*types.Interface {
+	wellKnownOnce.Do(func() {
+		var src strings.Builder
+		src.WriteString("package wellknown\n")
+		for i, iface := range wellKnownInterfaces {
+			fmt.Fprintf(&src, "type I%d interface { %s }\n", i, iface.methods)
+		}
+
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, "wellknown.go", src.String(), 0)
+		if err != nil {
+			panic(err)
+		}
+
+		pkg, err := new(types.Config).Check("wellknown", fset, []*ast.File{file}, nil)
+		if err != nil {
+			panic(err)
+		}
+
+		for i := range wellKnownInterfaces {
+			obj := pkg.Scope().Lookup(fmt.Sprintf("I%d", i))
+			wellKnownTypes = append(wellKnownTypes, obj.Type().Underlying().(*types.Interface))
+		}
+	})
+	return wellKnownTypes
+}
+
+// Implementations return the "implements" relationships of the type name
+// of the package importPath, nil if there are none
+func (s *Snapshot) Implementations(importPath, name string) *Implementations {
+	s.implementsOnce.Do(s.indexImplementations)
+	return s.implements[importPath+"."+name]
+}
+
+// indexImplementations checks every concrete type of the snapshot against
+// every interface, of the snapshot and the well-known ones. The method
+// signatures are compared by their qualified names, a reparse loads the
+// changed packages apart from the others and the types of the loads are
+// distinct objects.
+func (s *Snapshot) indexImplementations() {
+
+	type namedType struct {
+		ref     *TypeRef
+		typ     types.Type
+		methods map[string]string // signatures by method key, nil for a concrete type
+	}
+
+	var interfaces, concretes []*namedType
+
+	for _, pkg := range s.Packages {
+		for _, t := range pkg.Types {
+			if t.Object == nil || !s.private && !IsExported(t.Name) {
+				continue
+			}
+
+			// the generic types are implemented by their instances only
+			named, ok := t.Object.Type().(*types.Named)
+			if !ok || named.TypeParams().Len() > 0 {
+				continue
+			}
+
+			n := &namedType{
+				ref: &TypeRef{ImportPath: pkg.ImportPath, PackageName: pkg.Name, Name: t.Name},
+				typ: named,
+			}
+
+			if iface, ok := named.Underlying().(*types.Interface); ok {
+				// the empty interfaces are implemented by anything, the
+				// constraints by nothing
+				if iface.NumMethods() == 0 || !iface.IsMethodSet() {
+					continue
+				}
+				n.methods = interfaceMethods(iface)
+				interfaces = append(interfaces, n)
+			} else {
+				concretes = append(concretes, n)
+			}
+		}
+	}
+
+	// the well-known interfaces of the packages out of the snapshot
+	for i, iface := range wellKnownInterfaceTypes() {
+		known := wellKnownInterfaces[i]
+		if _, exists := s.Packages[known.importPath]; exists {
+			continue
+		}
+
+		ref := &TypeRef{ImportPath: known.importPath, Name: known.name}
+		if known.importPath != builtinPkgPath {
+			ref.PackageName = known.importPath[strings.LastIndex(known.importPath, "/")+1:]
+		}
+		interfaces = append(interfaces, &namedType{ref: ref, typ: iface, methods: interfaceMethods(iface)})
+	}
+
+	implementations := func(ref *TypeRef) *Implementations {
+		key := ref.ImportPath + "." + ref.Name
+		if s.implements[key] == nil {
+			s.implements[key] = &Implementations{}
+		}
+		return s.implements[key]
+	}
+
+	s.implements = map[string]*Implementations{}
+
+	for _, concrete := range concretes {
+
+		// the methods of *T are a superset of the methods of T
+		var (
+			valueMethods   = methodSetMethods(types.NewMethodSet(concrete.typ))
+			pointerMethods = methodSetMethods(types.NewMethodSet(types.NewPointer(concrete.typ)))
+		)
+
+		for _, iface := range interfaces {
+			var byPointer bool
+			switch {
+			case implementsMethods(valueMethods, iface.methods):
+			case implementsMethods(pointerMethods, iface.methods):
+				byPointer = true
+			default:
+				continue
+			}
+
+			implements := *iface.ref
+			implements.Pointer = byPointer
+			implementations(concrete.ref).Implements = append(implementations(concrete.ref).Implements, &implements)
+
+			// the well-known interfaces have no pages
+			if _, ok := iface.typ.(*types.Named); ok {
+				implementedBy := *concrete.ref
+				implementedBy.Pointer = byPointer
+				implementations(iface.ref).ImplementedBy = append(implementations(iface.ref).ImplementedBy, &implementedBy)
+			}
+		}
+	}
+
+	for _, impls := range s.implements {
+		sortTypeRefs(impls.Implements)
+		sortTypeRefs(impls.ImplementedBy)
+	}
+}
+
+// interfaceMethods return the signatures of the methods of iface by method key
+func interfaceMethods(iface *types.Interface) map[string]string {
+	methods := map[string]string{}
+	for i := 0; i < iface.NumMethods(); i++ {
+		fn := iface.Method(i)
+		methods[methodKey(fn)] = typeKey(fn.Type())
+	}
+	return methods
+}
+
+// methodSetMethods return the signatures of the methods of set by method key
+func methodSetMethods(set *types.MethodSet) map[string]string {
+	methods := map[string]string{}
+	for i := 0; i < set.Len(); i++ {
+		fn := set.At(i).Obj()
+		methods[methodKey(fn)] = typeKey(fn.Type())
+	}
+	return methods
+}
+
+// implementsMethods reports whether the methods include every interface
+// method with the same signature
+func implementsMethods(methods, iface map[string]string) bool {
+	for key, signature := range iface {
+		if methods[key] != signature {
+			return false
+		}
+	}
+	return true
+}
+
+// methodKey return the name of the method fn, qualified by the import path
+// of its package if it is unexported
+func methodKey(fn types.Object) string {
+	if fn.Exported() || fn.Pkg() == nil {
+		return fn.Name()
+	}
+	return fn.Pkg().Path() + "." + fn.Name()
+}
+
+// typeKey return the type string of typ qualified by import paths, without
+// the names of the parameters and results of the func types, so that the
+// identical types of distinct loads have the same key
+func typeKey(typ types.Type) string {
+
+	qualifier := func(pkg *types.Package) string { return pkg.Path() }
+
+	tuple := func(t *types.Tuple, variadic bool) string {
+		var list []string
+		for i := 0; i < t.Len(); i++ {
+			if slice, ok := t.At(i).Type().(*types.Slice); ok && variadic && i == t.Len()-1 {
+				list = append(list, "..."+typeKey(slice.Elem()))
+				continue
+			}
+			list = append(list, typeKey(t.At(i).Type()))
+		}
+		return "(" + strings.Join(list, ", ") + ")"
+	}
+
+	switch typ := types.Unalias(typ).(type) {
+	case *types.Signature:
+		return "func" + tuple(typ.Params(), typ.Variadic()) + tuple(typ.Results(), false)
+	case *types.Pointer:
+		return "*" + typeKey(typ.Elem())
+	case *types.Slice:
+		return "[]" + typeKey(typ.Elem())
+	case *types.Array:
+		return fmt.Sprintf("[%d]%s", typ.Len(), typeKey(typ.Elem()))
+	case *types.Map:
+		return "map[" + typeKey(typ.Key()) + "]" + typeKey(typ.Elem())
+	case *types.Chan:
+		switch typ.Dir() {
+		case types.SendOnly:
+			return "chan<- " + typeKey(typ.Elem())
+		case types.RecvOnly:
+			return "<-chan " + typeKey(typ.Elem())
+		}
+		return "chan " + typeKey(typ.Elem())
+	}
+	return types.TypeString(types.Unalias(typ), qualifier)
+}
+
+// sortTypeRefs sorts refs by import path and name
+func sortTypeRefs(refs []*TypeRef) {
+	sort.Slice(refs, func(i, j int) bool {
+		if refs[i].ImportPath != refs[j].ImportPath {
+			return refs[i].ImportPath < refs[j].ImportPath
+		}
+		return refs[i].Name < refs[j].Name
+	})
+}
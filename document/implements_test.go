@@ -0,0 +1,92 @@
+package document_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miclle/gsd/document"
+)
+
+func TestImplementations(t *testing.T) {
+	assert := assert.New(t)
+
+	root := writeModule(t, map[string]string{
+		"go.mod": "module example.com/m\n\ngo 1.18\n",
+		"a/a.go": `// Package a declares the shapes.
+package a
+
+// Shape is a shape.
+type Shape interface {
+	Area() float64
+}
+
+// Square is a square.
+type Square struct{ Side float64 }
+
+// Area returns the area.
+func (s Square) Area() float64 { return s.Side * s.Side }
+
+// String returns the name.
+func (s *Square) String() string { return "square" }
+
+// Number is a constraint, implemented by nothing.
+type Number interface{ ~int | ~float64 }
+
+// Any is implemented by anything.
+type Any interface{}
+`,
+		"b/b.go": `// Package b declares the circles.
+package b
+
+// Circle is a circle.
+type Circle struct{ R float64 }
+
+// Area returns the area.
+func (c *Circle) Area() float64 { return 3 * c.R * c.R }
+
+// Error returns the message.
+func (c Circle) Error() string { return "circle" }
+`,
+	})
+
+	corpus, err := document.NewCorpus(&document.Config{Path: root})
+	assert.Nil(err)
+	assert.Nil(corpus.ParsePackages())
+
+	snapshot := corpus.Snapshot()
+
+	shape := snapshot.Implementations("example.com/m/a", "Shape")
+	if assert.NotNil(shape) {
+		assert.Empty(shape.Implements)
+		assert.Equal([]*document.TypeRef{
+			{ImportPath: "example.com/m/a", PackageName: "a", Name: "Square"},
+			{ImportPath: "example.com/m/b", PackageName: "b", Name: "Circle", Pointer: true},
+		}, shape.ImplementedBy)
+	}
+
+	circle := snapshot.Implementations("example.com/m/b", "Circle")
+	if assert.NotNil(circle) {
+		assert.Equal([]*document.TypeRef{
+			{ImportPath: "builtin", Name: "error"},
+			{ImportPath: "example.com/m/a", PackageName: "a", Name: "Shape", Pointer: true},
+		}, circle.Implements)
+	}
+
+	assert.Nil(snapshot.Implementations("example.com/m/a", "Number"))
+	assert.Nil(snapshot.Implementations("example.com/m/a", "Any"))
+
+	mux := corpus.ServeMux()
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/example.com/m/a/Square.html", nil))
+	body := recorder.Body.String()
+	assert.Contains(body, `<li><code>Square</code> implements <a href="/example.com/m/a/Shape.html">Shape</a></li>`)
+	assert.Contains(body, `<li><code>*Square</code> implements <a href="https://pkg.go.dev/fmt#Stringer">fmt.Stringer</a></li>`)
+
+	recorder = httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/example.com/m/a/Shape.html", nil))
+	assert.Contains(recorder.Body.String(), `<li><a href="/example.com/m/b/Circle.html"><code>*b.Circle</code></a></li>`)
+}
@@ -11,6 +11,8 @@ import (
 	"go/ast"
 	"go/doc"
 	"go/token"
+	"go/types"
+	"html"
 	"io"
 	"strconv"
 )
@@ -21,8 +23,18 @@ import (
 // to the respective declaration, if possible. Comments are
 // formatted the same way as with FormatText.
 //
-func LinkifyText(w io.Writer, text []byte, n ast.Node) {
-	links := linksFor(n)
+// If pkg carries type information, identifiers are resolved
+// with it instead of the syntax-only guesses.
+//
+// The link URLs are built by url from the package path, empty
+// for the identifiers of pkg, and the identifier name, empty for
+// the packages.
+//
+// The optional highlights and selection are formatted as with
+// FormatText.
+//
+func LinkifyText(w io.Writer, text []byte, n ast.Node, pkg *Package, url func(path, name string) string, selections ...Selection) {
+	links := linksFor(n, pkg)
 
 	i := 0     // links index
 	prev := "" // prev HTML tag
@@ -42,11 +54,11 @@ func LinkifyText(w io.Writer, text []byte, n ast.Node) {
 			switch info := links[i]; {
 			case info.path != "" && info.name == "":
 				// package path
-				fmt.Fprintf(w, `<a href="/pkg/%s/">`, info.path)
+				fmt.Fprintf(w, `<a href="%s">`, html.EscapeString(url(info.path, "")))
 				prev = "a"
 			case info.path != "" && info.name != "":
 				// qualified identifier
-				fmt.Fprintf(w, `<a href="/pkg/%s/#%s">`, info.path, info.name)
+				fmt.Fprintf(w, `<a href="%s">`, html.EscapeString(url(info.path, info.name)))
 				prev = "a"
 			case info.path == "" && info.name != "":
 				// local identifier
@@ -54,7 +66,7 @@ func LinkifyText(w io.Writer, text []byte, n ast.Node) {
 					fmt.Fprintf(w, `<span id="%s">`, info.name)
 					prev = "span"
 				} else if ast.IsExported(info.name) {
-					fmt.Fprintf(w, `<a href="#%s">`, info.name)
+					fmt.Fprintf(w, `<a href="%s">`, html.EscapeString(url("", info.name)))
 					prev = "a"
 				}
 			}
@@ -64,7 +76,7 @@ func LinkifyText(w io.Writer, text []byte, n ast.Node) {
 
 	idents := tokenSelection(text, token.IDENT)
 	comments := tokenSelection(text, token.COMMENT)
-	FormatSelections(w, text, linkWriter, idents, selectionTag, comments)
+	FormatSelections(w, text, linkWriter, idents, selectionTag, append([]Selection{comments}, selections...)...)
 }
 
 // A link describes the (HTML) link information for an identifier.
@@ -77,8 +89,17 @@ type link struct {
 
 // linksFor returns the list of links for the identifiers used
 // by node in the same order as they appear in the source.
+// The type information of pkg is used if available, pkg may be nil.
 //
-func linksFor(node ast.Node) (links []link) {
+func linksFor(node ast.Node, pkg *Package) (links []link) {
+	var (
+		info *types.Info
+		self *types.Package
+	)
+	if pkg != nil {
+		info, self = pkg.TypesInfo, pkg.TypesPackage
+	}
+
 	// linkMap tracks link information for each ast.Ident node. Entries may
 	// be created out of source order (for example, when we visit a parent
 	// definition node). These links are appended to the returned slice when
@@ -147,6 +168,8 @@ func linksFor(node ast.Node) (links []link) {
 				prefix = typ.Name + "."
 			case *ast.SelectorExpr:
 				if x, _ := typ.X.(*ast.Ident); x != nil {
+					prefix = typ.Sel.Name + "."
+
 					// Create links only if x is a qualified identifier.
 					if obj := x.Obj; obj != nil && obj.Kind == ast.Pkg {
 						if spec, _ := obj.Decl.(*ast.ImportSpec); spec != nil {
@@ -170,13 +193,27 @@ func linksFor(node ast.Node) (links []link) {
 						// if this is a struct literal or a map literal without type
 						// information. We assume struct literal.
 						name := prefix + k.Name
-						linkMap[k] = link{path: fieldPath, name: name}
+						l := link{path: fieldPath, name: name}
+
+						// The type information resolves the ambiguity: the keys
+						// of map literals link to their objects, the fields to
+						// the package of their struct type.
+						if obj := typesObject(info, k); obj != nil {
+							if v, _ := obj.(*types.Var); v == nil || !v.IsField() {
+								l = objectLink(obj, self)
+							} else if v.Pkg() != nil && (self == nil || v.Pkg().Path() != self.Path()) {
+								l.path = v.Pkg().Path()
+							}
+						}
+						linkMap[k] = l
 					}
 				}
 			}
 		case *ast.Ident:
 			if l, ok := linkMap[n]; ok {
 				links = append(links, l)
+			} else if obj := typesObject(info, n); obj != nil {
+				links = append(links, objectLink(obj, self))
 			} else {
 				l := link{name: n.Name}
 				if n.Obj == nil && doc.IsPredeclared(n.Name) {
@@ -189,3 +226,12 @@ func linksFor(node ast.Node) (links []link) {
 	})
 	return
 }
+
+// typesObject returns the object the identifier n uses, or nil if
+// there is no type information for it.
+func typesObject(info *types.Info, n *ast.Ident) types.Object {
+	if info == nil {
+		return nil
+	}
+	return info.Uses[n]
+}
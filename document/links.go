@@ -0,0 +1,215 @@
+// This file implements the dead link checker. The documents are crawled
+// in process through an http.Handler, the live ServeMux or the exported
+// files, following the internal links from the package pages.
+
+package document
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/miclle/gsd/lazyregexp"
+)
+
+// BrokenLink is a link of a document to a missing page or anchor
+type BrokenLink struct {
+	Page   string `json:"page"`   // URL of the linking page
+	Href   string `json:"href"`   // link as written in the page
+	Status string `json:"status"` // "404 Not Found", or "missing anchor"
+}
+
+var (
+	linkRx   = lazyregexp.New(`\s(?:href|src)="([^"]*)"`)
+	anchorRx = lazyregexp.New(`\s(?:id|name)="([^"]*)"`)
+)
+
+// crawledPage is a response of the crawled handler
+type crawledPage struct {
+	status  int
+	html    bool
+	anchors map[string]bool
+	links   []string // hrefs of an HTML page
+}
+
+// ExportHandler return a handler serving the exported documents of the
+// output directory like a static file host, at the path of the base URL.
+// The directories are served by their index.html file, never listed.
+func (c *Corpus) ExportHandler() http.Handler {
+
+	files := http.FileServer(http.Dir(c.Output))
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		name := filepath.Join(c.Output, filepath.FromSlash(req.URL.Path))
+		if info, err := os.Stat(name); err == nil && info.IsDir() && !fileExists(filepath.Join(name, "index.html")) {
+			http.NotFound(w, req)
+			return
+		}
+		files.ServeHTTP(w, req)
+	})
+
+	if path := c.basePath(); path != "" {
+		return http.StripPrefix(path, handler)
+	}
+	return handler
+}
+
+// CheckLinks crawls the documents served by handler from the root and
+// package pages, and return the internal links to missing pages or
+// anchors, sorted by page. The links outside of the base URL are not
+// followed.
+func (c *Corpus) CheckLinks(handler http.Handler) ([]*BrokenLink, error) {
+
+	base, err := url.Parse(c.BaseURL + "/")
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		pages  = map[string]*crawledPage{} // by URL without fragment
+		queue  []*url.URL
+		broken = []*BrokenLink{}
+	)
+
+	// internal return the URL of href in the documents, nil if it is external
+	internal := func(page *url.URL, href string) *url.URL {
+		ref, err := url.Parse(href)
+		if err != nil || ref.Scheme != "" && ref.Scheme != "http" && ref.Scheme != "https" {
+			return nil
+		}
+		u := page.ResolveReference(ref)
+		if u.Host != base.Host || !strings.HasPrefix(u.Path, base.Path) {
+			return nil
+		}
+		return u
+	}
+
+	var fetch func(u *url.URL) *crawledPage
+	fetch = func(u *url.URL) *crawledPage {
+		key := *u
+		key.Fragment = ""
+
+		if page, exists := pages[key.String()]; exists {
+			return page
+		}
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, key.RequestURI(), nil))
+
+		page := &crawledPage{status: recorder.Code, anchors: map[string]bool{}}
+		pages[key.String()] = page
+
+		// the redirections of the internal pages are followed, the
+		// redirection loops are reported by their status
+		if location := recorder.Header().Get("Location"); location != "" && page.status/100 == 3 {
+			if target := internal(&key, location); target != nil {
+				target.Fragment = ""
+				*page = *fetch(target)
+			}
+			return page
+		}
+
+		if page.status != http.StatusOK || !strings.HasPrefix(recorder.Header().Get("Content-Type"), "text/html") {
+			return page
+		}
+
+		page.html = true
+		body := recorder.Body.String()
+		for _, match := range anchorRx.FindAllStringSubmatch(body, -1) {
+			page.anchors[html.UnescapeString(match[1])] = true
+		}
+		for _, match := range linkRx.FindAllStringSubmatch(body, -1) {
+			page.links = append(page.links, html.UnescapeString(match[1]))
+		}
+
+		queue = append(queue, &key)
+		return page
+	}
+
+	// the root and the package pages
+	seeds := []string{c.absURL("/")}
+	snapshot := c.Snapshot()
+	for importPath := range snapshot.Packages {
+		seeds = append(seeds, c.absURL("/"+importPath+"/"))
+	}
+	sort.Strings(seeds)
+
+	for _, seed := range seeds {
+		u := internal(base, seed)
+		if u == nil {
+			continue
+		}
+		if page := fetch(u); page.status != http.StatusOK {
+			broken = append(broken, &BrokenLink{Href: seed, Status: statusText(page.status)})
+		}
+	}
+
+	for len(queue) > 0 {
+		source := queue[0]
+		queue = queue[1:]
+
+		checked := map[string]bool{}
+		for _, href := range pages[source.String()].links {
+			if href == "" || href == "#" || checked[href] {
+				continue
+			}
+			checked[href] = true
+
+			target := internal(source, href)
+			if target == nil {
+				continue
+			}
+
+			page := fetch(target)
+			switch {
+			case page.status != http.StatusOK:
+				broken = append(broken, &BrokenLink{Page: source.String(), Href: href, Status: statusText(page.status)})
+			case target.Fragment != "" && page.html && !page.anchors[target.Fragment]:
+				broken = append(broken, &BrokenLink{Page: source.String(), Href: href, Status: "missing anchor"})
+			}
+		}
+	}
+
+	sort.SliceStable(broken, func(i, j int) bool {
+		return broken[i].Page < broken[j].Page
+	})
+
+	return broken, nil
+}
+
+func statusText(code int) string {
+	return fmt.Sprintf("%d %s", code, http.StatusText(code))
+}
+
+// WriteBrokenLinks writes the broken links grouped by page
+func WriteBrokenLinks(w io.Writer, links []*BrokenLink) error {
+
+	var buf bytes.Buffer
+	printf := func(format string, args ...interface{}) {
+		fmt.Fprintf(&buf, format, args...)
+	}
+
+	page := "\x00"
+	for _, link := range links {
+		if link.Page != page {
+			page = link.Page
+			if page == "" {
+				printf("(start pages)\n")
+			} else {
+				printf("%s\n", page)
+			}
+		}
+		printf("\t%s (%s)\n", link.Href, link.Status)
+	}
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
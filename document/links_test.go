@@ -0,0 +1,76 @@
+package document_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miclle/gsd/document"
+)
+
+func TestCheckLinks(t *testing.T) {
+	assert := assert.New(t)
+
+	root := writeModule(t, map[string]string{
+		"go.mod":    "module example.com/m\n\ngo 1.16\n",
+		"README.md": "# Demo\n\n## Usage\n\nSee [usage](#usage), [the guide](docs/guide.md) and [nowhere](#nowhere).\n",
+		"a/a.go":    "// Package a is documented.\npackage a\n\n// T is a type.\ntype T struct{}\n\n// Len returns 0.\nfunc (T) Len() int { return 0 }\n",
+	})
+
+	brokenLinks := func(links []*document.BrokenLink) (result []string) {
+		for _, link := range links {
+			result = append(result, link.Page+" "+link.Href+" "+link.Status)
+		}
+		return
+	}
+
+	// live documents server
+	{
+		corpus, err := document.NewCorpus(&document.Config{Path: root})
+		assert.Nil(err)
+		assert.Nil(corpus.ParsePackages())
+
+		mux := corpus.ServeMux()
+		for path, code := range map[string]int{
+			"/example.com/m/a/T.html":       http.StatusOK,
+			"/example.com/m/a/T.Len.html":   http.StatusOK,
+			"/example.com/m/a/U.html":       http.StatusNotFound,
+			"/example.com/m/a/T.Close.html": http.StatusNotFound,
+			"/docs/guide.md":                http.StatusNotFound,
+		} {
+			recorder := httptest.NewRecorder()
+			mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+			assert.Equal(code, recorder.Code, path)
+		}
+
+		links, err := corpus.CheckLinks(mux)
+		assert.Nil(err)
+		assert.Equal([]string{
+			"/ docs/guide.md 404 Not Found",
+			"/ #nowhere missing anchor",
+		}, brokenLinks(links))
+	}
+
+	// exported documents, at the path of the base URL
+	{
+		output := t.TempDir()
+
+		corpus, err := document.NewCorpus(&document.Config{Path: root, Output: output, BaseURL: "https://example.com/docs/"})
+		assert.Nil(err)
+		assert.Nil(corpus.Export())
+
+		links, err := corpus.CheckLinks(corpus.ExportHandler())
+		assert.Nil(err)
+		assert.Equal([]string{
+			"https://example.com/docs/ docs/guide.md 404 Not Found",
+			"https://example.com/docs/ #nowhere missing anchor",
+		}, brokenLinks(links))
+
+		var buf bytes.Buffer
+		assert.Nil(document.WriteBrokenLinks(&buf, links))
+		assert.Contains(buf.String(), "https://example.com/docs/\n\tdocs/guide.md (404 Not Found)\n")
+	}
+}
@@ -0,0 +1,600 @@
+// This file implements the doc comment linter. The doc comments of the
+// exported identifiers are checked against the godoc conventions and the
+// "@gsd:" annotation markers, the findings are reported at the position of
+// the offending comment line.
+
+package document
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/miclle/gsd/lazyregexp"
+)
+
+// LintIssue is a finding of the doc comment linter
+type LintIssue struct {
+	File    string `json:"file"`
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+	Rule    string `json:"rule"` // name, deprecated, marker, markdown or link
+	Message string `json:"message"`
+}
+
+// String return the issue as "file:line:column: message (rule)"
+func (issue *LintIssue) String() string {
+	return fmt.Sprintf("%s:%d:%d: %s (%s)", issue.File, issue.Line, issue.Column, issue.Message, issue.Rule)
+}
+
+// LintSnapshot checks the doc comments of the snapshot packages, the issues
+// are sorted by position. Unexported identifiers are checked only if private
+// is true. The packages with errors are not checked, their errors are joined
+// in the returned error.
+func LintSnapshot(snapshot *Snapshot, private bool) ([]*LintIssue, error) {
+
+	var importPaths []string
+	for importPath := range snapshot.Packages {
+		importPaths = append(importPaths, importPath)
+	}
+	sort.Strings(importPaths)
+
+	var (
+		issues = []*LintIssue{}
+		errs   []error
+	)
+	for _, importPath := range importPaths {
+		pkg := snapshot.Packages[importPath]
+		if pkg.Err != nil {
+			errs = append(errs, pkg.Err)
+			continue
+		}
+		if pkg.DocPackage == nil {
+			continue
+		}
+		issues = append(issues, lintPackage(pkg, private)...)
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		a, b := issues[i], issues[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		return a.Line < b.Line || a.Line == b.Line && a.Column < b.Column
+	})
+
+	return issues, errors.Join(errs...)
+}
+
+// WriteLintText writes the issues one per line
+func WriteLintText(w io.Writer, issues []*LintIssue) error {
+	var buf bytes.Buffer
+	for _, issue := range issues {
+		fmt.Fprintln(&buf, issue)
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// WriteLintJSON writes the indented JSON encoding of the issues
+func WriteLintJSON(w io.Writer, issues []*LintIssue) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(issues)
+}
+
+// --------------------------------------------------------------------
+
+// linter checks the doc comments of a package
+type linter struct {
+	pkg      *Package
+	trailing map[*ast.CommentGroup]bool // line comments, they are not doc comments
+	issues   []*LintIssue
+}
+
+// lintPackage return the issues of the doc comments of pkg
+func lintPackage(pkg *Package, private bool) []*LintIssue {
+
+	var (
+		l        = &linter{pkg: pkg, trailing: map[*ast.CommentGroup]bool{}}
+		exported = func(name string) bool { return private || IsExported(name) }
+	)
+
+	// the line comments of the previous line are not doc comments
+	for _, file := range pkg.PAst {
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.Field:
+				l.trailing[n.Comment] = true
+			case *ast.ValueSpec:
+				l.trailing[n.Comment] = true
+			case *ast.TypeSpec:
+				l.trailing[n.Comment] = true
+			case *ast.ImportSpec:
+				l.trailing[n.Comment] = true
+			}
+			return true
+		})
+	}
+
+	// the package clause of every file may carry the package doc
+	for _, filename := range pkg.sortedFilenames() {
+		doc := pkg.PAst[filename].Doc
+		if pkg.Name == "main" {
+			l.lint(doc, "", "")
+		} else {
+			l.lint(doc, "package", pkg.Name)
+		}
+	}
+
+	values := func(decls []*ast.GenDecl) {
+		for _, decl := range decls {
+			kind := decl.Tok.String()
+			if !decl.Lparen.IsValid() {
+				// a single value is documented by the comment of the declaration
+				vs := decl.Specs[0].(*ast.ValueSpec)
+				if name := vs.Names[0].Name; exported(name) {
+					l.lint(l.docComment(decl.Pos()), kind, name)
+				}
+				continue
+			}
+
+			var names []string
+			for _, spec := range decl.Specs {
+				vs := spec.(*ast.ValueSpec)
+				if exported(vs.Names[0].Name) {
+					l.lint(l.docComment(vs.Pos()), kind, vs.Names[0].Name)
+				}
+				for _, ident := range vs.Names {
+					names = append(names, ident.Name)
+				}
+			}
+
+			// the comment of a group is not named after a value
+			for _, name := range names {
+				if exported(name) {
+					l.lint(l.docComment(decl.Pos()), "", "")
+					break
+				}
+			}
+		}
+	}
+
+	funcs := func(funcs []*Func) {
+		for _, fn := range funcs {
+			if !exported(fn.Name) || fn.Decl == nil || fn.Level > 0 {
+				continue
+			}
+			kind := "func"
+			if fn.Recv != "" {
+				kind = "method"
+			}
+			l.lint(l.docComment(fn.Decl.Pos()), kind, fn.Name)
+		}
+	}
+
+	var decls []*ast.GenDecl
+	for _, v := range pkg.Consts {
+		decls = append(decls, v.Decl)
+	}
+	for _, v := range pkg.Vars {
+		decls = append(decls, v.Decl)
+	}
+	values(decls)
+	funcs(pkg.Funcs)
+
+	for _, t := range pkg.Types {
+		if !exported(t.Name) {
+			continue
+		}
+
+		spec := typeSpecOf(t)
+		if spec == nil {
+			continue
+		}
+
+		// the spec is on the line of the declaration if it is not grouped
+		l.lint(l.docComment(spec.Pos()), "type", t.Name)
+
+		decls = decls[:0]
+		for _, v := range t.Consts {
+			decls = append(decls, v.Decl)
+		}
+		for _, v := range t.Vars {
+			decls = append(decls, v.Decl)
+		}
+		values(decls)
+		funcs(t.Funcs)
+		funcs(t.Methods)
+
+		// the fields and interface methods are not named by their comment
+		for _, f := range TypeFields(t) {
+			for _, ident := range f.Field.Names {
+				if exported(ident.Name) {
+					l.lint(l.docComment(f.Field.Pos()), "", "")
+					break
+				}
+			}
+		}
+	}
+
+	return l.issues
+}
+
+// docComment return the comment group ending on the line before the
+// declaration at pos of the package AST
+func (l *linter) docComment(pos token.Pos) *ast.CommentGroup {
+
+	position := l.pkg.FSet.Position(pos)
+
+	file := l.pkg.PAst[position.Filename]
+	if file == nil {
+		return nil
+	}
+
+	// the first comment starting after the line of the declaration
+	i := sort.Search(len(file.Comments), func(i int) bool {
+		return l.pkg.FSet.Position(file.Comments[i].Pos()).Line >= position.Line
+	})
+	if i == 0 {
+		return nil
+	}
+
+	if cg := file.Comments[i-1]; !l.trailing[cg] && l.pkg.FSet.Position(cg.End()).Line == position.Line-1 {
+		return cg
+	}
+	return nil
+}
+
+// report adds an issue at pos of the package AST
+func (l *linter) report(pos token.Pos, rule, format string, args ...interface{}) {
+	position := l.pkg.FSet.Position(pos)
+	l.issues = append(l.issues, &LintIssue{
+		File:    displayPath(position.Filename),
+		Line:    position.Line,
+		Column:  position.Column,
+		Rule:    rule,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// docLine is a line of a doc comment, without the comment markers
+type docLine struct {
+	text string
+	pos  token.Pos // position of the first character of text
+}
+
+// docLines return the text lines of the comment group, the directives
+// such as "//go:generate" are skipped
+func docLines(cg *ast.CommentGroup) (lines []docLine) {
+	for _, c := range cg.List {
+		pos := c.Slash + 2
+		if c.Text[1] == '/' {
+			text := c.Text[2:]
+			if strings.HasPrefix(text, "go:") || strings.HasPrefix(text, "line ") {
+				continue
+			}
+			if strings.HasPrefix(text, " ") {
+				text, pos = text[1:], pos+1
+			}
+			lines = append(lines, docLine{text: text, pos: pos})
+			continue
+		}
+
+		// /* block comment */
+		for _, text := range strings.Split(c.Text[2:len(c.Text)-2], "\n") {
+			lines = append(lines, docLine{text: text, pos: pos})
+			pos += token.Pos(len(text) + 1)
+		}
+	}
+	return
+}
+
+// paragraphs splits the lines at the blank lines
+func paragraphs(lines []docLine) (result [][]docLine) {
+	var current []docLine
+	for _, line := range lines {
+		if strings.TrimSpace(line.text) == "" {
+			if len(current) > 0 {
+				result = append(result, current)
+			}
+			current = nil
+			continue
+		}
+		current = append(current, line)
+	}
+	if len(current) > 0 {
+		result = append(result, current)
+	}
+	return
+}
+
+var (
+	deprecatedRx = lazyregexp.New(`(?i)^\s*@?deprecated\b`)
+	docLinkRx    = lazyregexp.New(`\[(\*?[A-Za-z_]\w*(?:\.[A-Za-z_]\w*){0,2})\]`)
+)
+
+// lint checks the doc comment cg of the identifier name of kind, the
+// comment is not checked to be named after the identifier if kind is empty
+func (l *linter) lint(cg *ast.CommentGroup, kind, name string) {
+
+	if cg == nil {
+		return
+	}
+
+	lines := docLines(cg)
+	blocks := paragraphs(lines)
+	if len(blocks) == 0 {
+		return
+	}
+
+	if kind != "" {
+		l.lintName(blocks[0][0], kind, name)
+	}
+
+	for _, block := range blocks {
+		l.lintDeprecated(block)
+		l.lintMarker(block)
+	}
+
+	l.lintMarkdown(lines)
+}
+
+// lintName checks the comment starts with the identifier name, or
+// "Package name" for the package comment
+func (l *linter) lintName(first docLine, kind, name string) {
+
+	text := first.text
+	if markerRx.MatchString(text) || deprecatedRx.MatchString(text) {
+		return
+	}
+
+	prefix := name
+	if kind == "package" {
+		prefix = "Package " + name
+	}
+
+	valid := []string{prefix}
+	if kind == "type" {
+		valid = append(valid, "A "+name, "An "+name, "The "+name)
+	}
+
+	for _, v := range valid {
+		if text == v || strings.HasPrefix(text, v+" ") {
+			return
+		}
+	}
+
+	if kind == "package" {
+		l.report(first.pos, "name", "package comment should be of the form %q", prefix+" ...")
+		return
+	}
+	l.report(first.pos, "name", "comment on exported %s %s should be of the form %q", kind, name, prefix+" ...")
+}
+
+// lintDeprecated checks the deprecation notices are paragraphs starting
+// with "Deprecated: "
+func (l *linter) lintDeprecated(block []docLine) {
+	for i, line := range block {
+		if !deprecatedRx.MatchString(line.text) {
+			continue
+		}
+		if i == 0 && strings.HasPrefix(line.text, "Deprecated: ") {
+			continue
+		}
+		l.report(line.pos, "deprecated", `deprecation notice should be a paragraph starting with "Deprecated: "`)
+	}
+}
+
+// lintMarker checks the "@gsd:" marker of the paragraph is known
+func (l *linter) lintMarker(block []docLine) {
+
+	var text []string
+	for _, line := range block {
+		text = append(text, line.text)
+	}
+
+	_, marker, match := Annotation(strings.Join(text, "\n"))
+	if !match || knownMarkers[marker] {
+		return
+	}
+
+	if marker == "" {
+		l.report(block[0].pos, "marker", "missing marker name after \"@gsd:\"")
+		return
+	}
+
+	var known []string
+	for m := range knownMarkers {
+		known = append(known, m)
+	}
+	sort.Strings(known)
+
+	l.report(block[0].pos, "marker", "unknown marker \"@gsd:%s\", expected one of %s", marker, strings.Join(known, ", "))
+}
+
+// lintMarkdown checks the code fences are closed, the backquotes of the
+// paragraphs are balanced and the doc links resolve
+func (l *linter) lintMarkdown(lines []docLine) {
+
+	var (
+		fence     *docLine // opening code fence
+		backquote *docLine // line of the last unbalanced backquote
+		offset    int      // offset of the last unbalanced backquote
+		count     int      // backquotes of the paragraph
+	)
+
+	endParagraph := func() {
+		if count%2 == 1 {
+			l.report(backquote.pos+token.Pos(offset), "markdown", "unbalanced backquote")
+		}
+		count = 0
+	}
+
+	for i := range lines {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line.text)
+
+		if strings.HasPrefix(trimmed, "```") {
+			endParagraph()
+			if fence == nil {
+				fence = &lines[i]
+			} else {
+				fence = nil
+			}
+			continue
+		}
+
+		// code fences and indented code blocks are not Markdown
+		if fence != nil || strings.HasPrefix(line.text, " ") || strings.HasPrefix(line.text, "\t") {
+			continue
+		}
+
+		if trimmed == "" {
+			endParagraph()
+			continue
+		}
+
+		for j := 0; j < len(line.text); j++ {
+			if line.text[j] == '`' {
+				count++
+				backquote, offset = &lines[i], j
+			}
+		}
+
+		l.lintLinks(line)
+	}
+
+	endParagraph()
+
+	if fence != nil {
+		l.report(fence.pos, "markdown", "unclosed code fence")
+	}
+}
+
+// lintLinks checks the "[Name]", "[T.Name]" and "[pkg.Name]" doc links of
+// line resolve. Only the links naming an exported identifier are checked,
+// the other bracketed words are plain text.
+func (l *linter) lintLinks(line docLine) {
+
+	if l.pkg.TypesPackage == nil {
+		return
+	}
+
+	text := stripCodeSpans(line.text)
+
+	for _, loc := range docLinkRx.FindAllStringSubmatchIndex(text, -1) {
+		start, end := loc[0], loc[1]
+
+		// "a[i]" indexes, "[text](url)" and "[text][ref]" Markdown links
+		if start > 0 && isWordByte(text[start-1]) || start > 0 && text[start-1] == ']' {
+			continue
+		}
+		if end < len(text) && (text[end] == '(' || text[end] == '[' || text[end] == ':') {
+			continue
+		}
+
+		link := text[loc[2]:loc[3]]
+		if !hasExportedPart(link) {
+			continue
+		}
+
+		if !l.resolves(strings.TrimPrefix(link, "*")) {
+			l.report(line.pos+token.Pos(start), "link", "doc link [%s] does not resolve", link)
+		}
+	}
+}
+
+// resolves reports whether the doc link names an identifier of the
+// package, the universe or an imported package. The links qualified by an
+// unknown package are assumed to resolve.
+func (l *linter) resolves(link string) bool {
+
+	var (
+		pkg   = l.pkg.TypesPackage
+		parts = strings.Split(link, ".")
+	)
+
+	lookup := func(scope *types.Scope, parts []string) bool {
+		obj := scope.Lookup(parts[0])
+		if obj == nil {
+			return false
+		}
+		if len(parts) == 1 {
+			return true
+		}
+		if len(parts) > 2 {
+			return false
+		}
+		if _, ok := obj.(*types.TypeName); !ok {
+			return false
+		}
+		member, _, _ := types.LookupFieldOrMethod(obj.Type(), true, obj.Pkg(), parts[1])
+		return member != nil
+	}
+
+	if lookup(pkg.Scope(), parts) {
+		return true
+	}
+	if len(parts) == 1 {
+		return types.Universe.Lookup(parts[0]) != nil || importedPackage(pkg, parts[0]) != nil
+	}
+
+	// a type of the package without the member
+	if pkg.Scope().Lookup(parts[0]) != nil {
+		return false
+	}
+
+	imported := importedPackage(pkg, parts[0])
+	if imported == nil {
+		return true
+	}
+	return lookup(imported.Scope(), parts[1:])
+}
+
+// importedPackage return the package imported by pkg named name
+func importedPackage(pkg *types.Package, name string) *types.Package {
+	for _, imported := range pkg.Imports() {
+		if imported.Name() == name {
+			return imported
+		}
+	}
+	return nil
+}
+
+// stripCodeSpans replaces the `code spans` of text by spaces, keeping the offsets
+func stripCodeSpans(text string) string {
+	b := []byte(text)
+	in := false
+	for i := range b {
+		if b[i] == '`' {
+			in = !in
+			continue
+		}
+		if in {
+			b[i] = ' '
+		}
+	}
+	return string(b)
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+// hasExportedPart reports whether a part of the dotted name is exported
+func hasExportedPart(name string) bool {
+	for _, part := range strings.Split(strings.TrimPrefix(name, "*"), ".") {
+		if IsExported(part) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+package document_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miclle/gsd/document"
+)
+
+func TestLint(t *testing.T) {
+	assert := assert.New(t)
+
+	root := writeModule(t, map[string]string{
+		"go.mod": "module example.com/m\n\ngo 1.16\n",
+		"a/a.go": `// This package does things.
+package a
+
+import "io"
+
+// Returns a thing.
+func Run() {}
+
+// Stop stops.
+//
+// DEPRECATED: use Run.
+func Stop() {}
+
+// T is a T, see [Missing], [io.Reader], [io.Nope], [T.Name], [T.Nope], [Run] and a[i].
+//
+// @gsd:summry nothing
+//
+// Use ` + "`code" + ` here.
+type T struct {
+	// Name is a name.
+	Name string
+}
+
+// A Reader reads.
+//
+// ` + "```go" + `
+// x := 1
+type Reader io.Reader
+
+// Sizes.
+const (
+	Small = 1 // Small size.
+	Large = 2
+)
+
+// Old is old.
+//
+// Deprecated: use New.
+func Old() {}
+`,
+		"b/b.go": "// B is broken.\npackage b\n\nfunc B() {\n",
+	})
+
+	corpus, err := document.NewCorpus(&document.Config{Path: root})
+	assert.Nil(err)
+	assert.Nil(corpus.ParsePackages())
+
+	// the broken package is reported, not checked
+	issues, err := document.LintSnapshot(corpus.Snapshot(), false)
+	if assert.NotNil(err) {
+		assert.Contains(err.Error(), "example.com/m/b")
+	}
+
+	var buf bytes.Buffer
+	assert.Nil(document.WriteLintText(&buf, issues))
+
+	file := filepath.Join(root, "a", "a.go")
+	assert.Equal(file+`:1:4: package comment should be of the form "Package a ..." (name)
+`+file+`:6:4: comment on exported func Run should be of the form "Run ..." (name)
+`+file+`:11:4: deprecation notice should be a paragraph starting with "Deprecated: " (deprecated)
+`+file+`:14:18: doc link [Missing] does not resolve (link)
+`+file+`:14:42: doc link [io.Nope] does not resolve (link)
+`+file+`:14:63: doc link [T.Nope] does not resolve (link)
+`+file+`:16:4: unknown marker "@gsd:summry", expected one of caution, ignore, important, note, summary, tip, warning (marker)
+`+file+`:18:8: unbalanced backquote (markdown)
+`+file+`:26:4: unclosed code fence (markdown)
+`, buf.String())
+}
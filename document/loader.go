@@ -0,0 +1,182 @@
+// This file implements the corpus package loader. Packages are loaded
+// through golang.org/x/tools/go/packages, which runs the go command to
+// select the files matching the current build constraints and type-checks
+// them, so every package carries its syntax together with types.Info.
+
+package document
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log"
+	"sort"
+	"sync"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// loadMode is the go/packages load mode of the corpus: the syntax and full
+// type information of the matched packages, type-checked from source
+// together with their dependencies.
+const loadMode = packages.NeedName |
+	packages.NeedFiles |
+	packages.NeedImports |
+	packages.NeedDeps |
+	packages.NeedModule |
+	packages.NeedSyntax |
+	packages.NeedTypes |
+	packages.NeedTypesSizes |
+	packages.NeedTypesInfo
+
+// loadPackages loads the packages matching patterns, relative to the corpus path
+func (c *Corpus) loadPackages(patterns ...string) ([]*Package, error) {
+
+	var (
+		mu      sync.Mutex
+		sources = map[string][]byte{} // sources of the parsed files by filename
+	)
+
+	config := &packages.Config{
+		Mode: loadMode,
+		Dir:  c.Path,
+		// the sources are kept for the source pages, which are rendered
+		// with the loaded AST even if the files changed since
+		ParseFile: func(fset *token.FileSet, filename string, src []byte) (*ast.File, error) {
+			mu.Lock()
+			sources[filename] = src
+			mu.Unlock()
+			return parser.ParseFile(fset, filename, src, parser.AllErrors|parser.ParseComments)
+		},
+	}
+
+	pkgs, err := packages.Load(config, patterns...)
+	if err != nil {
+		return nil, fmt.Errorf("load packages: %w", err)
+	}
+
+	var result []*Package
+
+	for _, lpkg := range pkgs {
+		if lpkg.PkgPath == "" {
+			// the pattern did not match a package at all
+			return nil, fmt.Errorf("load package %s: %v", lpkg.ID, lpkg.Errors)
+		}
+
+		if !c.excludeMatcher.Match(normalize(lpkg.Dir, true)) {
+			continue
+		}
+
+		var errs []error
+		for _, e := range lpkg.Errors {
+			// type errors leave partial type information behind which is still
+			// good enough for the documents, anything else breaks the package
+			if e.Kind != packages.TypeError {
+				errs = append(errs, e)
+				continue
+			}
+			log.Printf("type check package %s: %v", lpkg.PkgPath, e)
+		}
+
+		pkg := newPackageWithLoaded(lpkg, sources)
+		if len(errs) > 0 {
+			pkg.Err = fmt.Errorf("load package %s: %w", lpkg.PkgPath, errors.Join(errs...))
+		}
+
+		result = append(result, pkg)
+	}
+
+	return result, nil
+}
+
+// newPackageWithLoaded return package with packages.Package, the sources
+// are the loaded files by filename
+func newPackageWithLoaded(lpkg *packages.Package, sources map[string][]byte) *Package {
+
+	p := &Package{
+		Dir:          lpkg.Dir,
+		Name:         lpkg.Name,
+		ImportPath:   lpkg.PkgPath,
+		Module:       newModuleWithLoaded(lpkg.Module),
+		Filenames:    lpkg.GoFiles,
+		FSet:         lpkg.Fset,
+		TypesPackage: lpkg.Types,
+		TypesInfo:    lpkg.TypesInfo,
+		IsMain:       lpkg.Name == "main",
+	}
+
+	for path := range lpkg.Imports {
+		p.Imports = append(p.Imports, path)
+	}
+	sort.Strings(p.Imports)
+
+	if len(lpkg.Syntax) > 0 {
+		p.PAst = map[string]*ast.File{}
+		p.Sources = map[string][]byte{}
+		for _, file := range lpkg.Syntax {
+			filename := lpkg.Fset.File(file.Pos()).Name()
+			p.PAst[filename] = file
+			p.Sources[filename] = sources[filename]
+		}
+	}
+
+	return p
+}
+
+// newModuleWithLoaded return module with packages.Module
+func newModuleWithLoaded(m *packages.Module) *Module {
+	if m == nil {
+		return nil
+	}
+
+	module := &Module{
+		Path:      m.Path,
+		Version:   m.Version,
+		Replace:   newModuleWithLoaded(m.Replace),
+		Time:      m.Time,
+		Main:      m.Main,
+		Indirect:  m.Indirect,
+		Dir:       m.Dir,
+		GoMod:     m.GoMod,
+		GoVersion: m.GoVersion,
+	}
+
+	if m.Error != nil {
+		module.Error = &ModuleError{Err: m.Error.Err}
+	}
+
+	return module
+}
+
+// --------------------------------------------------------------------
+
+// objectLink returns the link of an identifier resolved to obj, used by
+// the identifier with package self
+func objectLink(obj types.Object, self *types.Package) link {
+
+	switch obj := obj.(type) {
+	case *types.PkgName:
+		return link{path: obj.Imported().Path()}
+	case *types.Builtin:
+		return link{path: builtinPkgPath, name: obj.Name()}
+	}
+
+	if obj.Pkg() == nil {
+		// predeclared types, constants and nil
+		return link{path: builtinPkgPath, name: obj.Name()}
+	}
+
+	// only package level declarations have a document to link to
+	if obj.Parent() != obj.Pkg().Scope() {
+		return link{}
+	}
+
+	if self != nil && obj.Pkg().Path() == self.Path() {
+		return link{name: obj.Name()}
+	}
+
+	return link{path: obj.Pkg().Path(), name: obj.Name()}
+}
@@ -0,0 +1,530 @@
+// This file implements the GitHub-flavored Markdown export. The documents
+// have the structure of the HTML export, a README.md per package and a file
+// per type and func, linked to each other by relative links.
+
+package document
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/doc"
+	"go/printer"
+	"log"
+	"os"
+	pathpkg "path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// MarkdownPackageFilename is the name of the package documents in a
+// Markdown export, in the directory of the package import path
+const MarkdownPackageFilename = "README.md"
+
+// markdownAlerts maps the "@gsd:" markers to the GitHub alerts, the other
+// markers are rendered as titled blockquotes
+var markdownAlerts = map[string]string{
+	"note":      "NOTE",
+	"tip":       "TIP",
+	"important": "IMPORTANT",
+	"warning":   "WARNING",
+	"caution":   "CAUTION",
+}
+
+// exportMarkdown writes the Markdown documents of the packages of snapshot
+// and an index of the packages
+func (c *Corpus) exportMarkdown(snapshot *Snapshot) error {
+
+	var importPaths []string
+	for importPath := range snapshot.Packages {
+		importPaths = append(importPaths, importPath)
+	}
+	sort.Strings(importPaths)
+
+	// packages index
+	{
+		w := &markdownWriter{corpus: c}
+
+		w.printf("# Packages\n\n")
+		w.printf("| Package | Synopsis |\n| --- | --- |\n")
+
+		synopsis := new(doc.Package)
+		for _, importPath := range importPaths {
+			pkg := snapshot.Packages[importPath]
+			w.printf("| [%s](%s) | %s |\n", importPath, importPath+"/"+MarkdownPackageFilename, cell(synopsis.Synopsis(pkg.Doc)))
+		}
+
+		if err := w.writeFile(filepath.Join(c.Output, MarkdownPackageFilename)); err != nil {
+			return err
+		}
+	}
+
+	for _, importPath := range importPaths {
+		if err := c.renderPackageMarkdown(snapshot, snapshot.Packages[importPath]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// renderPackageMarkdown writes the package, types and funcs documents of pkg
+func (c *Corpus) renderPackageMarkdown(snapshot *Snapshot, pkg *Package) error {
+
+	dir := filepath.Join(c.Output, filepath.FromSlash(pkg.ImportPath))
+
+	w := &markdownWriter{corpus: c, pkg: pkg}
+	w.packagePage()
+
+	filename := filepath.Join(dir, MarkdownPackageFilename)
+	log.Printf("write package %s doc: %s\n", pkg.Name, filename)
+
+	if err := w.writeFile(filename); err != nil {
+		return err
+	}
+
+	for _, t := range pkg.Types {
+		if !w.exported(t.Name) {
+			continue
+		}
+
+		w := &markdownWriter{corpus: c, snapshot: snapshot, pkg: pkg}
+		w.typePage(t)
+
+		filename := filepath.Join(dir, t.Name+".md")
+		log.Printf("write type %s doc: %s\n", t.Name, filename)
+
+		if err := w.writeFile(filename); err != nil {
+			return err
+		}
+
+		var funcs []*Func
+		funcs = append(funcs, t.Funcs...)
+		funcs = append(funcs, t.Methods...)
+
+		for _, fn := range funcs {
+			if !w.exported(fn.Name) {
+				continue
+			}
+
+			w := &markdownWriter{corpus: c, pkg: pkg}
+			w.funcPage(t, fn)
+
+			filename := filepath.Join(dir, t.Name+"."+fn.Name+".md")
+			log.Printf("write func %s.%s doc: %s\n", t.Name, fn.Name, filename)
+
+			if err := w.writeFile(filename); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------
+
+// markdownWriter renders a Markdown document of a package
+type markdownWriter struct {
+	corpus   *Corpus
+	snapshot *Snapshot // implements relationships of the type documents
+	pkg      *Package
+	buf      bytes.Buffer
+}
+
+func (w *markdownWriter) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&w.buf, format, args...)
+}
+
+func (w *markdownWriter) exported(name string) bool {
+	return w.corpus.EnablePrivateIndent || IsExported(name)
+}
+
+func (w *markdownWriter) writeFile(filename string) error {
+	if err := os.MkdirAll(filepath.Dir(filename), os.ModePerm); err != nil {
+		return err
+	}
+	return os.WriteFile(filename, w.buf.Bytes(), 0644)
+}
+
+// code writes a Go code block of node
+func (w *markdownWriter) code(node interface{}) {
+	w.printf("```go\n%s\n```\n\n", printNode(w.pkg.FSet, node))
+}
+
+// documentation writes the paragraphs of d, the annotated ones as callouts
+func (w *markdownWriter) documentation(d Documentation) {
+	for _, block := range d.Blocks {
+		text := strings.TrimSpace(block.Text)
+
+		// the ignored paragraphs are hidden, as in the HTML documents
+		if block.Marker == "ignore" {
+			continue
+		}
+
+		if block.Marker == "" {
+			if text != "" {
+				w.printf("%s\n\n", text)
+			}
+			continue
+		}
+
+		if alert, exists := markdownAlerts[block.Marker]; exists {
+			w.printf("> [!%s]\n", alert)
+		} else {
+			w.printf("> **%s**\n>\n", noteTitle(block.Marker))
+		}
+
+		for _, line := range strings.Split(text, "\n") {
+			w.printf("> %s\n", line)
+		}
+		w.printf("\n")
+	}
+}
+
+func (w *markdownWriter) values(title string, values []*doc.Value) {
+
+	var filtered []*doc.Value
+	for _, v := range values {
+		for _, name := range v.Names {
+			if w.exported(name) {
+				filtered = append(filtered, v)
+				break
+			}
+		}
+	}
+
+	if len(filtered) == 0 {
+		return
+	}
+
+	w.printf("## %s\n\n", title)
+
+	for _, v := range filtered {
+		w.documentation(NewDocumentation(v.Doc))
+		w.code(v.Decl)
+	}
+}
+
+func (w *markdownWriter) examples(examples []*doc.Example) {
+
+	for _, eg := range examples {
+		title := "Example"
+		if name := strings.TrimPrefix(eg.Name, "_"); name != "" {
+			title += " " + strings.Replace(name, "_", ".", 1)
+		}
+		if eg.Suffix != "" {
+			title += " (" + eg.Suffix + ")"
+		}
+
+		w.printf("### %s\n\n", title)
+
+		if eg.Doc != "" {
+			w.printf("%s\n\n", strings.TrimSpace(eg.Doc))
+		}
+
+		code := printNode(w.pkg.FSet, &printer.CommentedNode{Node: eg.Code, Comments: eg.Comments})
+
+		// unindent the function body
+		if n := len(code); n >= 2 && code[0] == '{' && code[n-1] == '}' {
+			code = strings.TrimSpace(replaceLeadingIndentation(code[1:n-1], "\t", ""))
+			if loc := exampleOutputRx.FindStringIndex(code); loc != nil {
+				code = strings.TrimSpace(code[:loc[0]])
+			}
+		}
+
+		w.printf("```go\n%s\n```\n\n", code)
+
+		if eg.Output != "" {
+			w.printf("Output:\n\n```\n%s\n```\n\n", strings.TrimSpace(eg.Output))
+		}
+	}
+}
+
+func (w *markdownWriter) packagePage() {
+
+	pkg := w.pkg
+
+	w.printf("# Package %s\n\n", pkg.Name)
+	w.printf("```go\nimport %q\n```\n\n", pkg.ImportPath)
+
+	if pkg.Err != nil {
+		w.printf("> [!CAUTION]\n> The package could not be documented:\n>\n")
+		for _, line := range strings.Split(pkg.Err.Error(), "\n") {
+			w.printf("> %s\n", line)
+		}
+		w.printf("\n")
+	}
+
+	if strings.TrimSpace(pkg.Doc) != "" {
+		w.printf("## Overview\n\n")
+		w.documentation(NewDocumentation(pkg.Doc))
+	}
+
+	w.values("Constants", pkg.Consts)
+	w.values("Variables", pkg.Vars)
+
+	var funcs []*Func
+	for _, fn := range pkg.Funcs {
+		if w.exported(fn.Name) {
+			funcs = append(funcs, fn)
+		}
+	}
+
+	if len(funcs) > 0 {
+		w.printf("## Functions\n\n")
+
+		for _, fn := range funcs {
+			w.printf("### func %s\n\n", fn.Name)
+			w.code(fn.Decl)
+			w.documentation(fn.Documentation)
+			w.examples(fn.Examples)
+		}
+	}
+
+	var types []*Type
+	for _, t := range pkg.Types {
+		if w.exported(t.Name) {
+			types = append(types, t)
+		}
+	}
+
+	if len(types) > 0 {
+		w.printf("## Types\n\n")
+		w.printf("| Type | Description |\n| --- | --- |\n")
+
+		for _, t := range types {
+			w.printf("| [%s](%s.md) | %s |\n", t.Name, t.Name, cell(t.Documentation.Summary.Text))
+		}
+		w.printf("\n")
+	}
+
+	if len(pkg.Examples) > 0 {
+		w.printf("## Examples\n\n")
+		w.examples(pkg.Examples)
+	}
+
+	var markers []string
+	for marker := range pkg.Notes {
+		markers = append(markers, marker)
+	}
+	sort.Strings(markers)
+
+	for _, marker := range markers {
+		w.printf("## %ss\n\n", noteTitle(marker))
+		for _, note := range pkg.Notes[marker] {
+			w.printf("- %s\n", strings.Join(strings.Fields(note.Body), " "))
+		}
+		w.printf("\n")
+	}
+
+	if len(pkg.SubPackages) > 0 {
+		subPackages := append([]*Package{}, pkg.SubPackages...)
+		sort.Slice(subPackages, func(i, j int) bool { return subPackages[i].ImportPath < subPackages[j].ImportPath })
+
+		w.printf("## Subpackages\n\n")
+		w.printf("| Package | Synopsis |\n| --- | --- |\n")
+
+		synopsis := new(doc.Package)
+		for _, sub := range subPackages {
+			rel := strings.TrimPrefix(sub.ImportPath, pkg.ImportPath+"/")
+			w.printf("| [%s](%s) | %s |\n", rel, rel+"/"+MarkdownPackageFilename, cell(synopsis.Synopsis(sub.Doc)))
+		}
+		w.printf("\n")
+	}
+}
+
+func (w *markdownWriter) typePage(t *Type) {
+
+	w.printf("# type %s\n\n", t.Name)
+	w.printf("[package %s](%s)\n\n", w.pkg.Name, MarkdownPackageFilename)
+
+	if w.corpus.EnablePrivateIndent {
+		w.code(t.Decl)
+	} else {
+		w.code(exportedDecl(t.Decl))
+	}
+
+	w.documentation(t.Documentation)
+
+	if t.TypeSpec == StructType {
+		var rows []string
+		for _, f := range t.Fields {
+			var names []string
+			for _, name := range f.JoinNames() {
+				if w.exported(name) {
+					names = append(names, name)
+				}
+			}
+			if len(f.Field.Names) == 0 {
+				if name := embeddedName(f.Field.Type); w.exported(name) {
+					names = append(names, name)
+				}
+			}
+			if len(names) == 0 {
+				continue
+			}
+
+			var text bytes.Buffer
+			if f.Field.Doc != nil {
+				text.WriteString(f.Field.Doc.Text())
+			}
+			if f.Field.Comment != nil {
+				text.WriteString(f.Field.Comment.Text())
+			}
+
+			rows = append(rows, fmt.Sprintf("| %s | `%s` | %s |\n", strings.Join(names, ", "), cell(printNode(w.pkg.FSet, f.Field.Type)), cell(text.String())))
+		}
+
+		if len(rows) > 0 {
+			w.printf("## Fields\n\n")
+			w.printf("| Name | Type | Description |\n| --- | --- | --- |\n")
+			w.printf("%s\n", strings.Join(rows, ""))
+		}
+	}
+
+	w.values("Constants", t.Consts)
+	w.values("Variables", t.Vars)
+
+	w.examples(t.Examples)
+
+	w.funcs("Funcs", t, t.Funcs)
+	w.funcs("Methods", t, t.Methods)
+
+	w.implementations(t)
+}
+
+// implementations writes the interfaces implemented by type t, or the
+// types implementing it
+func (w *markdownWriter) implementations(t *Type) {
+
+	impls := w.snapshot.Implementations(w.pkg.ImportPath, t.Name)
+	if impls == nil {
+		return
+	}
+
+	if len(impls.Implements) > 0 {
+		w.printf("## Implements\n\n")
+		for _, ref := range impls.Implements {
+			recv := t.Name
+			if ref.Pointer {
+				recv = "*" + recv
+			}
+			w.printf("- `%s` implements [%s](%s)\n", recv, ref.Qualified(w.pkg.ImportPath), w.typeLink(ref))
+		}
+		w.printf("\n")
+	}
+
+	if len(impls.ImplementedBy) > 0 {
+		w.printf("## Implemented by\n\n")
+		for _, ref := range impls.ImplementedBy {
+			name := ref.Qualified(w.pkg.ImportPath)
+			if ref.Pointer {
+				name = "*" + name
+			}
+			w.printf("- [`%s`](%s)\n", name, w.typeLink(ref))
+		}
+		w.printf("\n")
+	}
+}
+
+// typeLink return the link of the type document of ref, relative to the
+// package document, or its pkg.go.dev URL out of the corpus
+func (w *markdownWriter) typeLink(ref *TypeRef) string {
+	if _, exists := w.snapshot.Packages[ref.ImportPath]; !exists {
+		return "https://pkg.go.dev/" + ref.ImportPath + "#" + ref.Name
+	}
+	dir, err := filepath.Rel(filepath.FromSlash(w.pkg.ImportPath), filepath.FromSlash(ref.ImportPath))
+	if err != nil {
+		return ref.Name + ".md"
+	}
+	return pathpkg.Join(filepath.ToSlash(dir), ref.Name+".md")
+}
+
+// funcs writes the summaries of the funcs of type t, linked to their documents
+func (w *markdownWriter) funcs(title string, t *Type, funcs []*Func) {
+
+	var filtered []*Func
+	for _, fn := range funcs {
+		if w.exported(fn.Name) {
+			filtered = append(filtered, fn)
+		}
+	}
+
+	if len(filtered) == 0 {
+		return
+	}
+
+	w.printf("## %s\n\n", title)
+
+	for _, fn := range filtered {
+		link := fmt.Sprintf("[%s](%s.%s.md)", fn.Name, t.Name, fn.Name)
+
+		if fn.Recv != "" {
+			w.printf("### func (%s) %s\n\n", fn.Recv, link)
+		} else {
+			w.printf("### func %s\n\n", link)
+		}
+
+		if fn.Decl != nil {
+			w.code(fn.Decl)
+		}
+
+		if text := strings.TrimSpace(fn.Documentation.Summary.Text); text != "" {
+			w.printf("%s\n\n", text)
+		}
+	}
+}
+
+func (w *markdownWriter) funcPage(t *Type, fn *Func) {
+
+	if fn.Recv != "" {
+		w.printf("# func (%s) %s\n\n", fn.Recv, fn.Name)
+	} else {
+		w.printf("# func %s\n\n", fn.Name)
+	}
+
+	w.printf("[package %s](%s) / [%s](%s.md)\n\n", w.pkg.Name, MarkdownPackageFilename, t.Name, t.Name)
+
+	switch {
+	case fn.Decl != nil:
+		w.code(fn.Decl)
+	case fn.FuncType != nil:
+		w.printf("```go\n%s%s\n```\n\n", fn.Name, strings.TrimPrefix(printNode(w.pkg.FSet, fn.FuncType), "func"))
+	}
+
+	w.documentation(fn.Documentation)
+
+	w.fieldList("Parameters", fn.Params)
+	w.fieldList("Results", fn.Results)
+
+	w.examples(fn.Examples)
+}
+
+// fieldList writes a table of the parameters or results in list
+func (w *markdownWriter) fieldList(title string, list *ast.FieldList) {
+
+	if list == nil || len(list.List) == 0 {
+		return
+	}
+
+	w.printf("## %s\n\n", title)
+	w.printf("| Name | Type |\n| --- | --- |\n")
+
+	for _, field := range list.List {
+		var names []string
+		for _, name := range field.Names {
+			names = append(names, name.Name)
+		}
+		w.printf("| %s | `%s` |\n", strings.Join(names, ", "), cell(printNode(w.pkg.FSet, field.Type)))
+	}
+
+	w.printf("\n")
+}
+
+// cell return text as the content of a table cell
+func cell(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	return strings.ReplaceAll(text, "|", `\|`)
+}
@@ -0,0 +1,75 @@
+package document_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miclle/gsd/document"
+)
+
+func TestExportMarkdown(t *testing.T) {
+	assert := assert.New(t)
+
+	root := writeModule(t, map[string]string{
+		"go.mod": "module example.com/m\n\ngo 1.16\n",
+		"a/a.go": `// Package a is an example.
+//
+// @gsd:warning handle with care
+package a
+
+// T is a type.
+//
+// @gsd:todo document T
+//
+// @gsd:ignore secret internal text
+type T struct {
+	// Name of T
+	Name   string
+	hidden int
+}
+
+// Hello says hello.
+func (t *T) Hello(name string) string { return "hello " + name }
+`,
+		"a/b/b.go": "// Package b is a subpackage.\npackage b\n",
+	})
+
+	output := t.TempDir()
+
+	corpus, err := document.NewCorpus(&document.Config{Path: root, Output: output, Format: document.MarkdownFormat})
+	assert.Nil(err)
+	assert.Nil(corpus.Export())
+
+	read := func(name string) string {
+		data, err := os.ReadFile(filepath.Join(output, filepath.FromSlash(name)))
+		assert.Nil(err, name)
+		return string(data)
+	}
+
+	index := read("README.md")
+	assert.Contains(index, "| [example.com/m/a](example.com/m/a/README.md) | Package a is an example. |")
+
+	pkg := read("example.com/m/a/README.md")
+	assert.Contains(pkg, "# Package a\n")
+	assert.Contains(pkg, "> [!WARNING]\n> handle with care\n")
+	assert.Contains(pkg, "| [T](T.md) | T is a type. |")
+	assert.Contains(pkg, "| [b](b/README.md) | Package b is a subpackage. |")
+
+	typ := read("example.com/m/a/T.md")
+	assert.Contains(typ, "[package a](README.md)")
+	assert.Contains(typ, "> **Todo**\n>\n> document T\n")
+	assert.NotContains(typ, "Ignore")
+	assert.NotContains(typ, "secret internal text")
+	assert.Contains(typ, "// contains filtered or unexported fields")
+	assert.NotContains(typ, "hidden")
+	assert.Contains(typ, "| Name | `string` | Name of T |")
+	assert.Contains(typ, "### func (*T) [Hello](T.Hello.md)")
+
+	fn := read("example.com/m/a/T.Hello.md")
+	assert.Contains(fn, "# func (*T) Hello\n")
+	assert.Contains(fn, "```go\nfunc (t *T) Hello(name string) string\n```")
+	assert.Contains(fn, "| name | `string` |")
+}
@@ -2,6 +2,8 @@ package document
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"regexp"
 	"regexp/syntax"
 	"strings"
@@ -10,6 +12,9 @@ import (
 
 // A Matcher decides whether some filename matches its set of patterns.
 type Matcher interface {
+	// Match returns whether a filename matches, the names of directories
+	// end with a slash.
+	Match(name string) bool
 	// ExcludePrefix returns whether all paths with this prefix cannot match.
 	// It is allowed to return false negatives but not false positives.
 	// This is used as an optimization for skipping directory watches with
@@ -18,21 +23,18 @@ type Matcher interface {
 	String() string
 }
 
-// ParseMatchers combines multiple (possibly inverse) regex and glob patterns
-// into a single Matcher.
-func ParseMatchers(inverseRegexes []string) (m Matcher, err error) {
+// ParseMatchers combines gitignore-style glob patterns, relative to the
+// base directory, into a single Matcher. The names matching a pattern are
+// excluded, unless a later pattern starting with "!" includes them again.
+func ParseMatchers(base string, patterns []string) (m Matcher, err error) {
 
-	var matchers multiMatcher
+	matcher := &globMatcher{}
 
-	for _, r := range inverseRegexes {
-		regex, err := regexp.Compile(r)
-		if err != nil {
-			return nil, err
-		}
-		matchers = append(matchers, newRegexMatcher(regex, true))
+	if err = matcher.add(base, patterns); err != nil {
+		return nil, err
 	}
 
-	return matchers, nil
+	return matcher, nil
 }
 
 type regexMatcher struct {
@@ -108,6 +110,10 @@ func (m *regexMatcher) ExcludePrefix(prefix string) bool {
 	return m.canExcludePrefix
 }
 
+func (m *regexMatcher) Match(name string) bool {
+	return m.regex.MatchString(name) != m.inverse
+}
+
 func (m *regexMatcher) String() string {
 	s := "Regex"
 	if m.inverse {
@@ -119,6 +125,15 @@ func (m *regexMatcher) String() string {
 // A multiMatcher returns the logical AND of its sub-matchers.
 type multiMatcher []Matcher
 
+func (m multiMatcher) Match(name string) bool {
+	for _, matcher := range m {
+		if !matcher.Match(name) {
+			return false
+		}
+	}
+	return true
+}
+
 func (m multiMatcher) ExcludePrefix(prefix string) bool {
 	for _, matcher := range m {
 		if matcher.ExcludePrefix(prefix) {
@@ -135,3 +150,213 @@ func (m multiMatcher) String() string {
 	}
 	return strings.Join(s, "\n")
 }
+
+// --------------------------------------------------------------------
+
+// A globMatcher excludes the names matching gitignore-style glob patterns.
+// The last pattern matching a name decides, and a name is excluded if one
+// of its parent directories is, as git does.
+type globMatcher struct {
+	rules   []*globRule
+	negated bool // some rules include names again
+}
+
+// A globRule is a compiled glob pattern
+type globRule struct {
+	base     string         // directory the pattern is relative to
+	pattern  string         // original pattern
+	regex    *regexp.Regexp // matches the slash separated names relative to base
+	contents *regexp.Regexp // matches the directory of a "dir/**" pattern, nil otherwise
+	negate   bool           // pattern starts with "!"
+	dirOnly  bool           // pattern ends with "/"
+}
+
+// add compiles the patterns relative to the base directory, the empty
+// patterns and comments starting with "#" are skipped.
+func (m *globMatcher) add(base string, patterns []string) (err error) {
+
+	if base, err = filepath.Abs(base); err != nil {
+		return err
+	}
+
+	for _, pattern := range patterns {
+		pattern = strings.TrimRight(pattern, " \t\r")
+		if pattern == "" || strings.HasPrefix(pattern, "#") {
+			continue
+		}
+
+		rule := &globRule{base: base, pattern: pattern}
+
+		if strings.HasPrefix(pattern, "!") {
+			rule.negate = true
+			m.negated = true
+			pattern = pattern[1:]
+		}
+
+		if strings.HasSuffix(pattern, "/") {
+			rule.dirOnly = true
+			pattern = strings.TrimRight(pattern, "/")
+		}
+
+		// patterns without a slash match at any depth
+		var prefix string
+		if strings.Contains(pattern, "/") {
+			pattern = strings.TrimPrefix(pattern, "/")
+		} else {
+			prefix = "(.*/)?"
+		}
+
+		if rule.regex, err = regexp.Compile("^" + prefix + globRegex(pattern) + "$"); err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", rule.pattern, err)
+		}
+
+		if dir := strings.TrimSuffix(pattern, "/**"); dir != pattern && !rule.negate {
+			rule.contents = regexp.MustCompile("^" + prefix + globRegex(dir) + "$")
+		}
+
+		m.rules = append(m.rules, rule)
+	}
+
+	return nil
+}
+
+// globRegex translates a glob pattern to a regular expression
+func globRegex(pattern string) string {
+
+	var buf strings.Builder
+
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			switch {
+			case strings.HasPrefix(pattern[i:], "**/"):
+				buf.WriteString("(.*/)?")
+				i += 2
+			case strings.HasPrefix(pattern[i:], "**"):
+				buf.WriteString(".*")
+				i++
+			default:
+				buf.WriteString("[^/]*")
+			}
+		case '?':
+			buf.WriteString("[^/]")
+		case '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end < 0 {
+				buf.WriteString(`\[`)
+				continue
+			}
+			class := pattern[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			buf.WriteString("[" + strings.ReplaceAll(class, `\`, `\\`) + "]")
+			i += end + 1
+		case '\\':
+			if i+1 < len(pattern) {
+				i++
+				buf.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+			}
+		default:
+			buf.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	return buf.String()
+}
+
+// excluded returns whether the rules exclude name, or its contents if
+// contents is true
+func (m *globMatcher) excluded(name string, dir, contents bool) (excluded bool) {
+	for _, rule := range m.rules {
+		rel, ok := rule.rel(name)
+		if !ok || rule.dirOnly && !dir {
+			continue
+		}
+		if rule.regex.MatchString(rel) || contents && rule.contents != nil && rule.contents.MatchString(rel) {
+			excluded = !rule.negate
+		}
+	}
+	return
+}
+
+// rel return name relative to the base directory of the rule
+func (rule *globRule) rel(name string) (string, bool) {
+	rel, err := filepath.Rel(rule.base, name)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return filepath.ToSlash(rel), true
+}
+
+func (m *globMatcher) Match(name string) bool {
+
+	dir := strings.HasSuffix(name, "/")
+	name = strings.TrimSuffix(name, "/")
+
+	// an excluded directory excludes all its contents
+	for parent := filepath.Dir(name); parent != filepath.Dir(parent); parent = filepath.Dir(parent) {
+		if m.excluded(parent, true, false) {
+			return false
+		}
+	}
+
+	return !m.excluded(name, dir, false)
+}
+
+func (m *globMatcher) ExcludePrefix(prefix string) bool {
+	if m.Match(prefix) {
+		// all contents of the directory may be excluded by a "dir/**"
+		// pattern, unless some names are included again
+		return !m.negated && m.excluded(strings.TrimSuffix(prefix, "/"), true, true)
+	}
+	return true
+}
+
+func (m *globMatcher) String() string {
+	var s []string
+	for _, rule := range m.rules {
+		s = append(s, fmt.Sprintf("Glob match: %q in %s", rule.pattern, rule.base))
+	}
+	return strings.Join(s, "\n")
+}
+
+// addGitignore adds the patterns of the .gitignore files in dir and its
+// parent directories up to the root of the git repository, only the file in
+// dir if it is not in a repository
+func (m *globMatcher) addGitignore(dir string) (err error) {
+
+	if dir, err = filepath.Abs(dir); err != nil {
+		return err
+	}
+
+	dirs := []string{dir}
+	for parent := dir; ; {
+		if _, err := os.Stat(filepath.Join(parent, ".git")); err == nil {
+			break
+		}
+
+		if filepath.Dir(parent) == parent {
+			dirs = []string{dir}
+			break
+		}
+
+		parent = filepath.Dir(parent)
+		dirs = append([]string{parent}, dirs...)
+	}
+
+	for _, dir := range dirs {
+		data, err := os.ReadFile(filepath.Join(dir, ".gitignore"))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err = m.add(dir, strings.Split(string(data), "\n")); err != nil {
+			return fmt.Errorf("%s: %w", filepath.Join(dir, ".gitignore"), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package document_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miclle/gsd/document"
+)
+
+func TestParseMatchers(t *testing.T) {
+	assert := assert.New(t)
+
+	matcher, err := document.ParseMatchers("/root", []string{
+		"**/testdata/**",
+		"internal/legacy/*",
+		"!internal/legacy/keep",
+		"*.pb.go",
+		"build/",
+		"/vendor",
+	})
+	assert.Nil(err)
+
+	for name, match := range map[string]bool{
+		"/root/a/a.go":                      true,
+		"/root/a/testdata/":                 true,
+		"/root/a/testdata/x.go":             false,
+		"/root/testdata/x/y.go":             false,
+		"/root/internal/legacy/":            true,
+		"/root/internal/legacy/old/":        false,
+		"/root/internal/legacy/old/a.go":    false,
+		"/root/internal/legacy/keep/":       true,
+		"/root/internal/legacy/keep/k.go":   true,
+		"/root/internal/legacy/a.go":        false,
+		"/root/a/b/api.pb.go":               false,
+		"/root/a/build/":                    false,
+		"/root/a/build/main.go":             false,
+		"/root/a/build":                     true, // a file
+		"/root/vendor/":                     false,
+		"/root/a/vendor/":                   true,
+		"/other/internal/legacy/old/a.go":   true,
+		"/root/internal/legacy/keep/x/y.go": true,
+	} {
+		assert.Equal(match, matcher.Match(name), name)
+	}
+
+	assert.True(matcher.ExcludePrefix("/root/vendor/"))
+	assert.True(matcher.ExcludePrefix("/root/internal/legacy/old/"))
+	assert.False(matcher.ExcludePrefix("/root/internal/legacy/"))
+	assert.False(matcher.ExcludePrefix("/root/a/"))
+
+	_, err = document.ParseMatchers("/root", []string{"a/[b"})
+	assert.Nil(err)
+}
+
+func TestExcludePackages(t *testing.T) {
+	assert := assert.New(t)
+
+	root := writeModule(t, map[string]string{
+		"go.mod":                       "module example.com/m\n\ngo 1.16\n",
+		".gitignore":                   "# generated\ngen/\n",
+		"a/a.go":                       "package a\n",
+		"gen/gen.go":                   "package gen\n",
+		"internal/legacy/old/old.go":   "package old\n",
+		"internal/legacy/keep/keep.go": "package keep\n",
+	})
+
+	corpus, err := document.NewCorpus(&document.Config{
+		Path:         root,
+		Excludes:     []string{"internal/legacy/*", "!internal/legacy/keep"},
+		UseGitignore: true,
+	})
+	assert.Nil(err)
+	assert.Nil(corpus.ParsePackages())
+
+	var importPaths []string
+	for importPath := range corpus.Snapshot().Packages {
+		importPaths = append(importPaths, importPath)
+	}
+	assert.ElementsMatch([]string{"example.com/m/a", "example.com/m/internal/legacy/keep"}, importPaths)
+}
@@ -2,11 +2,16 @@ package document
 
 import (
 	"bytes"
+	"fmt"
 	"go/ast"
+	"go/build"
 	"go/doc"
 	"go/parser"
 	"go/token"
-	"strings"
+	"go/types"
+	"log"
+	"path/filepath"
+	"sort"
 	"time"
 )
 
@@ -130,7 +135,16 @@ type Package struct {
 	FSet       *token.FileSet       // nil if no package document
 	DocPackage *doc.Package         // nil if no package document
 	PAst       map[string]*ast.File // nil if no AST with package exports
+	Sources    map[string][]byte    // sources of the PAst files by filename, as loaded
 	IsMain     bool                 // true for package main
+
+	// type information
+	TypesPackage *types.Package // nil if the package was not type-checked
+	TypesInfo    *types.Info    // nil if the package was not type-checked
+
+	// test API
+	Tests   bool       // document the declarations of the test files
+	TestAPI []*Package // declarations of the package test files and of the external test package
 }
 
 // IsEmpty return package is empty
@@ -145,32 +159,53 @@ type Packages []*Package
 
 // TODO Packages impl sorting func
 
+// analyze the package, keeping the error in Err. A panic of the
+// analysis is an error of the package too.
+func (p *Package) analyze() {
+
+	defer func() {
+		if r := recover(); r != nil {
+			p.Err = fmt.Errorf("analyze package %s: %v", p.ImportPath, r)
+		}
+	}()
+
+	if err := p.Analyze(); err != nil {
+		p.Err = fmt.Errorf("analyze package %s: %w", p.ImportPath, err)
+	}
+}
+
 // Analyze the package
 func (p *Package) Analyze() (err error) {
 
-	p.FSet = token.NewFileSet() // positions are relative to fset
+	if p.FSet == nil {
+		p.FSet = token.NewFileSet() // positions are relative to fset
+	}
 
-	pkgs, err := parser.ParseDir(p.FSet, p.Dir, nil, parser.ParseComments)
-	if err != nil {
-		return
+	var files []*ast.File
+	for _, filename := range p.sortedFilenames() {
+		files = append(files, p.PAst[filename])
 	}
 
-	var astPackage *ast.Package
-	for name, apkg := range pkgs {
-		if strings.HasSuffix(name, "_test") { // skip test package
-			continue
-		}
-		astPackage = apkg
+	// test files only contribute examples, and the test API. A broken test
+	// file leaves them out, the package is documented anyway.
+	testFiles, testErr := p.parseTestFiles()
+	if testErr != nil {
+		log.Printf("parse test files of package %s: %v", p.ImportPath, testErr)
+		testFiles = nil
 	}
 
-	d := doc.New(astPackage, p.ImportPath, doc.AllDecls)
+	// the AST is kept whole, with the function bodies and comments, for
+	// the source pages, the call graph and the linter
+	d, err := doc.NewFromFiles(p.FSet, append(files, testFiles...), p.ImportPath, doc.AllDecls|doc.PreserveAST)
+	if err != nil {
+		return
+	}
 
 	p.DocPackage = d
 
 	p.Doc = d.Doc
 	p.Name = d.Name
 	p.ImportPath = d.ImportPath
-	p.Imports = d.Imports
 	p.Filenames = d.Filenames
 	p.Notes = d.Notes
 	p.Consts = d.Consts
@@ -179,17 +214,112 @@ func (p *Package) Analyze() (err error) {
 
 	// set package types
 	for _, t := range d.Types {
-		p.Types = append(p.Types, NewTypeWithDoc(t))
+		_t := NewTypeWithDoc(t)
+		p.resolveType(_t)
+		p.Types = append(p.Types, _t)
 	}
 
 	// set package funcs
 	for _, fn := range d.Funcs {
-		p.Funcs = append(p.Funcs, NewFuncWithDoc(fn))
+		_fn := NewFuncWithDoc(fn)
+		p.resolveFunc(_fn)
+		p.Funcs = append(p.Funcs, _fn)
+	}
+
+	if p.Tests {
+		p.analyzeTests(testFiles)
 	}
 
 	return
 }
 
+// sortedFilenames return the filenames of the package AST in order
+func (p *Package) sortedFilenames() (filenames []string) {
+	for filename := range p.PAst {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+	return
+}
+
+// parseTestFiles parse the _test.go files of the package directory which
+// match the build constraints
+func (p *Package) parseTestFiles() (files []*ast.File, err error) {
+
+	bpkg, err := build.ImportDir(p.Dir, build.ImportComment)
+	if err != nil {
+		if _, ok := err.(*build.NoGoError); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var filenames []string
+	filenames = append(filenames, bpkg.TestGoFiles...)
+	filenames = append(filenames, bpkg.XTestGoFiles...)
+
+	for _, name := range filenames {
+		file, err := parser.ParseFile(p.FSet, filepath.Join(p.Dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+
+	return
+}
+
+// resolveType set the type information of type t and its fields and funcs
+func (p *Package) resolveType(t *Type) {
+
+	if p.TypesPackage == nil || p.TypesInfo == nil {
+		return
+	}
+
+	t.Object, _ = p.TypesPackage.Scope().Lookup(t.Name).(*types.TypeName)
+
+	for _, f := range t.Fields {
+		for _, name := range f.Names {
+			obj := p.TypesInfo.Defs[name]
+			if obj == nil {
+				obj = p.TypesInfo.Uses[name]
+			}
+			if obj != nil {
+				f.Objects = append(f.Objects, obj)
+			}
+		}
+	}
+
+	for _, fn := range t.Funcs {
+		p.resolveFunc(fn)
+	}
+
+	for _, fn := range t.Methods {
+		p.resolveFunc(fn)
+	}
+}
+
+// resolveFunc set the type information of func fn
+func (p *Package) resolveFunc(fn *Func) {
+
+	if p.TypesInfo == nil {
+		return
+	}
+
+	var ident *ast.Ident
+
+	switch {
+	case fn.Decl != nil:
+		ident = fn.Decl.Name
+	case fn.Field != nil && len(fn.Field.Names) > 0:
+		ident = fn.Field.Names[0]
+	default:
+		return
+	}
+
+	fn.Object, _ = p.TypesInfo.Defs[ident].(*types.Func)
+}
+
 // --------------------------------------------------------------------
 
 // TypeFields get type fields
@@ -218,13 +348,14 @@ func TypeFields(t *Type) (fields []*Field) {
 
 		// interface type methods
 		if str, ok := typeSpec.Type.(*ast.InterfaceType); ok {
-			for _, field := range str.Methods.List {
-				if ident, ok := field.Type.(*ast.Ident); ok && ident.Obj != nil {
+			for _, f := range str.Methods.List {
+				// name embedded interfaces on a copy, the AST is shared by concurrent requests
+				if ident, ok := f.Type.(*ast.Ident); ok && ident.Obj != nil {
+					field := *f
 					field.Names = []*ast.Ident{ident}
+					f = &field
 				}
-			}
 
-			for _, f := range str.Methods.List {
 				fields = append(fields, &Field{
 					Field: f,
 					Type:  t,
@@ -276,6 +407,8 @@ type Type struct {
 	Fields []*Field
 
 	TypeSpec TypeSpec // type spec
+
+	Object *types.TypeName // resolved type object, nil if not type-checked
 }
 
 // NewTypeWithDoc return type with doc.Type
@@ -309,8 +442,11 @@ func NewTypeWithDoc(t *doc.Type) *Type {
 				// interface funcs
 				if fn, ok := field.Type.(*ast.FuncType); ok {
 					var f = &Func{
-						Doc:  field.Doc.Text(),
-						Name: field.Names[0].Name,
+						Doc:   field.Doc.Text(),
+						Name:  field.Names[0].Name,
+						Field: field,
+
+						FuncType: fn,
 
 						Params:  fn.Params,
 						Results: fn.Results,
@@ -362,6 +498,8 @@ type Func struct {
 	Results *ast.FieldList // (outgoing) results; or nil
 
 	Documentation Documentation
+
+	Object *types.Func // resolved func object, nil if not type-checked
 }
 
 // NewFuncWithDoc return func with doc.Func
@@ -390,6 +528,8 @@ func NewFuncWithDoc(f *doc.Func) *Func {
 type Field struct {
 	*ast.Field
 	Type *Type
+
+	Objects []types.Object // resolved objects of the field names, nil if not type-checked
 }
 
 // JoinNames return names array
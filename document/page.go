@@ -9,6 +9,7 @@ import (
 	"go/format"
 	"go/printer"
 	"go/token"
+	"go/types"
 	"html/template"
 	"io"
 	"log"
@@ -43,14 +44,21 @@ const (
 	TypePage PageType = "type"
 	// FuncPage func page type
 	FuncPage PageType = "func"
+	// SearchPage search results page type
+	SearchPage PageType = "search"
+	// SourcePage source file page type
+	SourcePage PageType = "source"
+	// GraphPage import graph page type
+	GraphPage PageType = "graph"
 )
 
 // Page generates output from a corpus.
 type Page struct {
-	Corpus  *Corpus
-	Package *Package
-	Type    *Type
-	Func    *Func
+	Corpus   *Corpus
+	Snapshot *Snapshot // corpus state the page is rendered from
+	Package  *Package
+	Type     *Type
+	Func     *Func
 
 	PageType PageType
 
@@ -61,15 +69,42 @@ type Page struct {
 	FuncHTML    *template.Template
 	FieldsHTML  *template.Template
 	ExampleHTML *template.Template
+	SearchHTML  *template.Template
+	SourceHTML  *template.Template
+	GraphHTML   *template.Template
+
+	ReferencesHTML *template.Template
 
 	Title string
 
+	// search page
+	Query         string          // search query
+	SearchResults []*SearchResult // ranked search results
+
+	// source page
+	SourcePath string // source file path, "import/path/file.go"
+	Source     string // formatted source code
+	Highlight  string // highlighted text, the "h" query parameter
+
+	// import graph page
+	Graph    *ImportGraph
+	GraphSVG string // graph rendered as SVG
+
 	// TabWidth optionally specifies the tab width.
 	TabWidth int
 
 	ShowPlayground bool
 	DeclLinks      bool
 
+	// Static reports whether the page is exported as a static file,
+	// which can't be served any dynamic content such as search.
+	Static bool
+
+	// Bundle reports whether the page is rendered into the single-file
+	// bundle, which links the documents by in-page anchors and inlines
+	// the static assets.
+	Bundle bool
+
 	Sidebar []byte // Sidebar content
 	Body    []byte // Main content
 
@@ -84,6 +119,7 @@ func NewPage(c *Corpus) *Page {
 	}
 	page := &Page{
 		Corpus:    c,
+		Snapshot:  c.Snapshot(),
 		TabWidth:  4,
 		DeclLinks: true,
 	}
@@ -93,28 +129,43 @@ func NewPage(c *Corpus) *Page {
 	return page
 }
 
-func (page *Page) readTemplate(name string) *template.Template {
+// readTemplate parses the template name with the partials defining the
+// templates it shares with the other pages
+func (page *Page) readTemplate(name string, partials ...string) *template.Template {
 
-	data, exists := static.Files[name]
-	if !exists {
-		log.Panicf("file not found: %s", name)
+	read := func(name string) string {
+		data, exists := static.Files[name]
+		if !exists {
+			log.Panicf("file not found: %s", name)
+		}
+		return data
 	}
 
-	t, err := template.New(name).Funcs(page.FuncMap()).Parse(data)
+	t, err := template.New(name).Funcs(page.FuncMap()).Parse(read(name))
 	if err != nil {
 		panic(err)
 	}
 
+	for _, partial := range partials {
+		if _, err := t.New(partial).Parse(read(partial)); err != nil {
+			panic(err)
+		}
+	}
+
 	return t
 }
 
 func (page *Page) readTemplates() {
 	page.LayoutHTML = page.readTemplate("layout.html")
 	page.SidebarHTML = page.readTemplate("sidebar.html")
-	page.PackageHTML = page.readTemplate("package.html")
+	page.PackageHTML = page.readTemplate("package.html", "calls.html")
 	page.TypeHTML = page.readTemplate("type.html")
-	page.FuncHTML = page.readTemplate("func.html")
+	page.FuncHTML = page.readTemplate("func.html", "calls.html")
 	page.FieldsHTML = page.readTemplate("fields.html")
+	page.SearchHTML = page.readTemplate("search.html")
+	page.SourceHTML = page.readTemplate("source.html")
+	page.GraphHTML = page.readTemplate("graph.html")
+	page.ReferencesHTML = page.readTemplate("references.html")
 }
 
 // FuncMap defines template functions used in godoc templates.
@@ -128,7 +179,7 @@ func (page *Page) initFuncMap() {
 		"repeat": strings.Repeat,
 		"join":   strings.Join,
 
-		"since":     page.Corpus.pkgAPIInfo.sinceVersionFunc,
+		"since":     page.sinceFunc,
 		"unescaped": unescaped,
 		"srcID":     srcIDFunc,
 
@@ -148,12 +199,20 @@ func (page *Page) initFuncMap() {
 
 		// support for URL attributes
 		"pkgLink":       pkgLinkFunc,
-		"srcLink":       srcLinkFunc,
-		"posLink_url":   newPosLinkURLFunc(srcPosLinkFunc),
-		"docLink":       docLinkFunc,
-		"queryLink":     queryLinkFunc,
-		"srcBreadcrumb": srcBreadcrumbFunc,
-		"srcToPkgLink":  srcToPkgLinkFunc,
+		"srcLink":       page.srcLinkFunc,
+		"posLink_url":   newPosLinkURLFunc(page.srcPosLinkFunc),
+		"docLink":       page.docLinkFunc,
+		"queryLink":     page.queryLinkFunc,
+		"srcBreadcrumb": page.srcBreadcrumbFunc,
+		"srcToPkgLink":  page.srcToPkgLinkFunc,
+		"abs_url":       page.Corpus.absURL,
+		"static_url":    page.staticURL,
+		"package_url":   page.packageURL,
+		"type_url":      page.typeURL,
+		"func_url":      page.funcURL,
+		"static_file":   staticFileFunc,
+		"search_index":  page.searchIndexFunc,
+		"anchor":        anchorFunc,
 
 		// formatting of Examples
 		"example_html":   page.exampleHTMLFunc,
@@ -163,6 +222,18 @@ func (page *Page) initFuncMap() {
 		//
 		"type_fields": TypeFields,
 
+		// implements relationships of the types
+		"implementations": page.implementationsFunc,
+
+		// declaration and use sites of the identifiers
+		"references_html": page.referencesHTMLFunc,
+
+		// static call graph of the funcs
+		"calls": page.callsFunc,
+
+		// imports between the packages
+		"import_graph": page.importGraphFunc,
+
 		// formatting of Notes
 		"noteTitle": noteTitle,
 
@@ -173,8 +244,6 @@ func (page *Page) initFuncMap() {
 	}
 }
 
-var sidebar []byte
-
 // Render package page
 func (page *Page) Render(writer io.Writer, t PageType) (err error) {
 
@@ -182,14 +251,10 @@ func (page *Page) Render(writer io.Writer, t PageType) (err error) {
 		panic("page corpuus, package is nil")
 	}
 
-	if len(sidebar) == 0 {
-		if sidebar, err = applyTemplate(page.SidebarHTML, "sidebar", page); err != nil {
-			return err
-		}
+	if page.Sidebar, err = page.sidebar(); err != nil {
+		return err
 	}
 
-	page.Sidebar = sidebar
-
 	switch t {
 	case PackagePage:
 		if page.Body, err = applyTemplate(page.PackageHTML, "package", page); err != nil {
@@ -205,6 +270,21 @@ func (page *Page) Render(writer io.Writer, t PageType) (err error) {
 		if page.Body, err = applyTemplate(page.FuncHTML, "func", page); err != nil {
 			return err
 		}
+
+	case SearchPage:
+		if page.Body, err = applyTemplate(page.SearchHTML, "search", page); err != nil {
+			return err
+		}
+
+	case SourcePage:
+		if page.Body, err = applyTemplate(page.SourceHTML, "source", page); err != nil {
+			return err
+		}
+
+	case GraphPage:
+		if page.Body, err = applyTemplate(page.GraphHTML, "graph", page); err != nil {
+			return err
+		}
 	}
 
 	var buf bytes.Buffer
@@ -221,14 +301,10 @@ func (page *Page) Render(writer io.Writer, t PageType) (err error) {
 // RenderBody package page
 func (page *Page) RenderBody(writer io.Writer, body []byte) (err error) {
 
-	if len(sidebar) == 0 {
-		if sidebar, err = applyTemplate(page.SidebarHTML, "sidebar", page); err != nil {
-			return err
-		}
+	if page.Sidebar, err = page.sidebar(); err != nil {
+		return err
 	}
 
-	page.Sidebar = sidebar
-
 	page.Body = body
 
 	var buf bytes.Buffer
@@ -242,6 +318,15 @@ func (page *Page) RenderBody(writer io.Writer, body []byte) (err error) {
 	return err
 }
 
+// sidebar return the rendered sidebar, the sidebar of the snapshot is
+// shared by all pages except the bundle, which links by anchors.
+func (page *Page) sidebar() ([]byte, error) {
+	if page.Bundle {
+		return applyTemplate(page.SidebarHTML, "sidebar", page)
+	}
+	return page.Snapshot.Sidebar(page)
+}
+
 func applyTemplate(t *template.Template, name string, data interface{}) ([]byte, error) {
 	var buf bytes.Buffer
 	if err := t.Execute(&buf, data); err != nil {
@@ -262,6 +347,199 @@ func srcIDFunc(s string) string {
 	return s
 }
 
+// sinceFunc return the version adding an identifier, see sinceVersionFunc
+func (page *Page) sinceFunc(kind, receiver, name, pkg string) string {
+	return page.Corpus.pkgAPIInfo.sinceVersionFunc(kind, receiver, name, pkg)
+}
+
+// packageURL return the URL of the package document
+func (page *Page) packageURL(importPath string) string {
+	return page.documentURL("/"+importPath, "")
+}
+
+// typeURL return the URL of the type document
+func (page *Page) typeURL(importPath, typeName string) string {
+	return page.documentURL("/"+importPath+"/"+typeName, ".html")
+}
+
+// funcURL return the URL of the func or method document, the funcs
+// without type are documented by the package document.
+func (page *Page) funcURL(importPath, typeName, name string) string {
+	if typeName == "" {
+		if page.Bundle {
+			return page.documentURL("/"+importPath+"/"+name, "")
+		}
+		return page.packageURL(importPath) + "#" + name
+	}
+	return page.typeURL(importPath, typeName+"."+name)
+}
+
+// documentURL return the URL of the document at path, an anchor of the
+// bundle section or the page path with extension.
+func (page *Page) documentURL(path, extension string) string {
+	if page.Bundle {
+		return "#" + path
+	}
+	return page.Corpus.absURL(path + extension)
+}
+
+// staticURL return the URL of a static asset
+func (page *Page) staticURL(name string) string {
+	return page.Corpus.absURL("/_static/" + name)
+}
+
+// identURL return the document URL of the identifier name declared by the
+// package path, the package of the current document if path is empty, or
+// of the package if name is empty. The packages missing in the corpus are
+// linked to pkg.go.dev.
+func (page *Page) identURL(pkg *Package, path, name string) string {
+
+	if path == "" && pkg != nil {
+		path = pkg.ImportPath
+	}
+
+	target, exists := page.Snapshot.Packages[path]
+	if !exists {
+		url := "https://pkg.go.dev/" + path
+		if name != "" {
+			url += "#" + name
+		}
+		return url
+	}
+
+	if name == "" {
+		return page.packageURL(path)
+	}
+
+	// the fields of composite literals are named "Type.Field"
+	typeName, fieldName, isField := strings.Cut(name, ".")
+
+	for _, t := range target.Types {
+		if t.Name == typeName {
+			if isField && !page.Bundle && page.hasFieldAnchor(t, fieldName) {
+				return page.typeURL(path, typeName) + "#" + name
+			}
+			return page.typeURL(path, typeName)
+		}
+
+		for _, fn := range t.Funcs {
+			if fn.Name == name {
+				return page.funcURL(path, t.Name, name)
+			}
+		}
+
+		// the typed constants and variables are shown by the type page
+		for _, values := range [][]*doc.Value{t.Consts, t.Vars} {
+			for _, value := range values {
+				for _, valueName := range value.Names {
+					if valueName != name {
+						continue
+					}
+					if page.Bundle {
+						return page.typeURL(path, t.Name)
+					}
+					return page.typeURL(path, t.Name) + "#" + name
+				}
+			}
+		}
+	}
+
+	for _, fn := range target.Funcs {
+		if fn.Name == name {
+			return page.funcURL(path, "", name)
+		}
+	}
+
+	if page.Bundle {
+		return page.packageURL(path)
+	}
+	return page.packageURL(path) + "#" + name
+}
+
+// implementationsFunc return the "implements" relationships of the type
+// name of the package importPath
+func (page *Page) implementationsFunc(importPath, name string) *Implementations {
+	return page.Snapshot.Implementations(importPath, name)
+}
+
+// referencesFunc return the sites of the identifier of the resolved type
+// or func object grouped by kind, nil if it was not type-checked
+func (page *Page) referencesFunc(obj interface{}) []*SpotGroup {
+	switch obj := obj.(type) {
+	case *types.TypeName:
+		if obj != nil {
+			return page.Snapshot.objectReferences(obj)
+		}
+	case *types.Func:
+		if obj != nil {
+			return page.Snapshot.objectReferences(obj)
+		}
+	}
+	return nil
+}
+
+// funcCalls are the calls of a func for the "calls" template, the package
+// funcs are sections of the package page and the methods have their pages
+type funcCalls struct {
+	ImportPath string // package of the func, the names of the others are qualified
+	Type       string // type of the func, empty for the package funcs
+	Calls      []*CallRef
+	CalledBy   []*CallRef
+}
+
+// callsFunc return the calls of the func name of the type typeName of the
+// package importPath, typeName is empty for the package funcs
+func (page *Page) callsFunc(importPath, typeName, name string) *funcCalls {
+	calls := page.Snapshot.Calls(importPath, typeName, name)
+	if calls == nil {
+		return nil
+	}
+	return &funcCalls{importPath, typeName, calls.Calls, calls.CalledBy}
+}
+
+// importGraphFunc return the import graph of the packages
+func (page *Page) importGraphFunc() *ImportGraph {
+	return page.Snapshot.ImportGraph()
+}
+
+// referencesHTMLFunc renders the "Uses" panel of the identifier name of the
+// resolved type or func object, the occurrences of name highlighted by
+// the links to the source lines
+func (page *Page) referencesHTMLFunc(name string, obj interface{}) template.HTML {
+
+	groups := page.referencesFunc(obj)
+	if groups == nil {
+		return ""
+	}
+
+	data, err := applyTemplate(page.ReferencesHTML, "references", struct {
+		Name   string
+		Groups []*SpotGroup
+	}{name, groups})
+	if err != nil {
+		log.Printf("%s.Execute: %s", "references", err)
+		return ""
+	}
+
+	return template.HTML(string(data))
+}
+
+// hasFieldAnchor reports whether the fields table of the type page has
+// the anchor of the named field, the embedded fields have none
+func (page *Page) hasFieldAnchor(t *Type, name string) bool {
+	if !page.Corpus.EnablePrivateIndent && !IsExported(name) {
+		return false
+	}
+	for _, field := range t.Fields {
+		for _, ident := range field.Names {
+			if ident.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // --------------------------------------------------------------------
 
 func inc(a, b int) int      { return a + b }
@@ -306,12 +584,17 @@ func (page *Page) nodeFunc(pkg *Package, node interface{}) string {
 }
 
 func (page *Page) nodeHTMLFunc(pkg *Package, node interface{}, linkify bool) string {
+	// the links follow the identifiers of the printed node
+	node = declNode(node)
+
 	var buf1 bytes.Buffer
 	page.writeNode(&buf1, pkg, pkg.FSet, node)
 
 	var buf2 bytes.Buffer
 	if n, _ := node.(ast.Node); n != nil && linkify && page.DeclLinks {
-		LinkifyText(&buf2, buf1.Bytes(), n)
+		LinkifyText(&buf2, buf1.Bytes(), n, pkg, func(path, name string) string {
+			return page.identURL(pkg, path, name)
+		})
 		if st, name := isStructTypeDecl(n); st != nil {
 			addStructFieldIDAttributes(&buf2, name, st)
 		}
@@ -477,7 +760,7 @@ func (page *Page) fieldsHTMLFunc(pkg *Package, list *ast.FieldList) template.HTM
 		fieldsPage.Fields = append(fieldsPage.Fields, f)
 
 		var (
-			links      = linksFor(field.Type)
+			links      = linksFor(field.Type, nil)
 			path, name string
 		)
 
@@ -502,7 +785,7 @@ func (page *Page) fieldsHTMLFunc(pkg *Package, list *ast.FieldList) template.HTM
 		}
 
 		// other package
-		for _, pkg := range page.Corpus.Packages {
+		for _, pkg := range page.Snapshot.Packages {
 			if strings.HasSuffix(pkg.ImportPath, path) {
 				for _, t := range pkg.Types {
 					if t.Name == name {
@@ -590,18 +873,19 @@ func pkgLinkFunc(path string) string {
 
 // srcToPkgLinkFunc builds an <a> tag linking to the package
 // documentation of relpath.
-func srcToPkgLinkFunc(relpath string) string {
+func (page *Page) srcToPkgLinkFunc(relpath string) string {
 	relpath = pkgLinkFunc(relpath)
 	relpath = pathpkg.Dir(relpath)
 	if relpath == "pkg" {
-		return `<a href="/pkg">Index</a>`
+		return fmt.Sprintf(`<a href="%s">Index</a>`, page.Corpus.absURL("/"))
 	}
-	return fmt.Sprintf(`<a href="/%s">%s</a>`, relpath, relpath[len("pkg/"):])
+	relpath = relpath[len("pkg/"):]
+	return fmt.Sprintf(`<a href="%s">%s</a>`, page.packageURL(relpath), relpath)
 }
 
 // srcBreadcrumbFun converts each segment of relpath to a HTML <a>.
 // Each segment links to its corresponding src directories.
-func srcBreadcrumbFunc(relpath string) string {
+func (page *Page) srcBreadcrumbFunc(relpath string) string {
 	segments := strings.Split(relpath, "/")
 	var buf bytes.Buffer
 	var selectedSegment string
@@ -618,8 +902,8 @@ func srcBreadcrumbFunc(relpath string) string {
 	}
 
 	for i := range segments[:selectedIndex] {
-		buf.WriteString(fmt.Sprintf(`<a href="/%s">%s</a>/`,
-			strings.Join(segments[:i+1], "/"),
+		buf.WriteString(fmt.Sprintf(`<a href="%s">%s</a>/`,
+			page.Corpus.absURL("/"+strings.Join(segments[:i+1], "/")),
 			segments[i],
 		))
 	}
@@ -637,6 +921,8 @@ func newPosLinkURLFunc(srcPosLinkFunc func(s string, line, low, high int) string
 
 		switch n := n.(type) {
 		case ast.Node:
+			// the selection ends with the declaration, before the body
+			n = declNode(n).(ast.Node)
 			pos = n.Pos()
 			end = n.End()
 		case *doc.Note:
@@ -652,7 +938,9 @@ func newPosLinkURLFunc(srcPosLinkFunc func(s string, line, low, high int) string
 
 		if pos.IsValid() {
 			p := pkg.FSet.Position(pos)
-			relpath = p.Filename
+			if relpath = sourcePath(pkg, p.Filename); relpath == "" {
+				relpath = p.Filename
+			}
 			line = p.Line
 			low = p.Offset
 		}
@@ -664,8 +952,11 @@ func newPosLinkURLFunc(srcPosLinkFunc func(s string, line, low, high int) string
 	}
 }
 
-func srcPosLinkFunc(s string, line, low, high int) string {
-	s = srcLinkFunc(s)
+func (page *Page) srcPosLinkFunc(s string, line, low, high int) string {
+	s = page.srcLinkFunc(s)
+	if s == "" {
+		return ""
+	}
 	var buf bytes.Buffer
 	template.HTMLEscape(&buf, []byte(s))
 	// selection ranges are of form "s=low:high"
@@ -686,12 +977,20 @@ func srcPosLinkFunc(s string, line, low, high int) string {
 	return buf.String()
 }
 
-func srcLinkFunc(s string) string {
+// srcLinkFunc returns the URL of the source page of the file s, a path such
+// as "import/path/file.go", empty for the bundle which has no source pages.
+func (page *Page) srcLinkFunc(s string) string {
+	if s == "" || page.Bundle {
+		return ""
+	}
 	s = pathpkg.Clean("/" + s)
 	if !strings.HasPrefix(s, "/src/") {
 		s = "/src" + s
 	}
-	return s
+	if !strings.HasSuffix(s, ".html") {
+		s += ".html"
+	}
+	return page.Corpus.absURL(s)
 }
 
 // queryLinkFunc returns a URL for a line in a source file with a highlighted
@@ -699,16 +998,20 @@ func srcLinkFunc(s string) string {
 // s is expected to be a path to a source file.
 // query is expected to be a string that has already been appropriately escaped
 // for use in a URL query.
-func queryLinkFunc(s, query string, line int) string {
-	url := pathpkg.Clean("/"+s) + "?h=" + query
+func (page *Page) queryLinkFunc(s, query string, line int) string {
+	url := page.srcLinkFunc(s)
+	if url == "" {
+		return ""
+	}
+	url += "?h=" + query
 	if line > 0 {
 		url += "#L" + strconv.Itoa(line)
 	}
 	return url
 }
 
-func docLinkFunc(s string, ident string) string {
-	return pathpkg.Clean("/pkg/"+s) + "/#" + ident
+func (page *Page) docLinkFunc(s string, ident string) string {
+	return page.identURL(nil, pathpkg.Clean(s), ident)
 }
 
 func (page *Page) exampleHTMLFunc(pkg *Package, funcName string) string {
@@ -932,8 +1235,8 @@ func replaceLeadingIndentation(body, oldIndent, newIndent string) string {
 // writeNode writes the AST node x to w.
 //
 // The provided fset must be non-nil. The pageInfo is optional. If
-// present, the pageInfo is used to add comments to struct fields to
-// say which version of Go introduced them.
+// present, the pageInfo is used to add comments to struct fields,
+// constants and variables to say which version introduced them.
 func (page *Page) writeNode(w io.Writer, pkg *Package, fset *token.FileSet, x interface{}) {
 	// convert trailing tabs into spaces using a tconv filter
 	// to ensure a good outcome in most browsers (there may still
@@ -944,23 +1247,38 @@ func (page *Page) writeNode(w io.Writer, pkg *Package, fset *token.FileSet, x in
 	//           with an another printer mode (which is more efficiently
 	//           implemented in the printer than here with another layer)
 
+	x = declNode(x)
+
 	var pkgName, structName string
 	var apiInfo pkgAPIVersions
 
+	// identSince maps the declared fields or values to their versions
+	var identSince map[string]string
+	var outerSince string
+
 	gd, ok := x.(*ast.GenDecl)
-	if ok && pkg != nil && pkg.DocPackage != nil && page.Corpus != nil && gd.Tok == token.TYPE && len(gd.Specs) != 0 {
+	if ok && pkg != nil && pkg.DocPackage != nil && page.Corpus != nil && len(gd.Specs) != 0 {
 		pkgName = pkg.DocPackage.ImportPath
-		if ts, ok := gd.Specs[0].(*ast.TypeSpec); ok {
-			if _, ok := ts.Type.(*ast.StructType); ok {
-				structName = ts.Name.Name
+		apiInfo = page.Corpus.pkgAPIInfo[pkgName]
+		switch gd.Tok {
+		case token.TYPE:
+			if ts, ok := gd.Specs[0].(*ast.TypeSpec); ok {
+				if _, ok := ts.Type.(*ast.StructType); ok {
+					structName = ts.Name.Name
+					identSince = apiInfo.fieldSince[structName]
+					outerSince = apiInfo.typeSince[structName]
+				}
 			}
+		case token.CONST:
+			identSince = apiInfo.constSince
+		case token.VAR:
+			identSince = apiInfo.varSince
 		}
-		apiInfo = page.Corpus.pkgAPIInfo[pkgName]
 	}
 
 	var out = w
 	var buf bytes.Buffer
-	if structName != "" {
+	if len(identSince) != 0 {
 		out = &buf
 	}
 
@@ -974,21 +1292,21 @@ func (page *Page) writeNode(w io.Writer, pkg *Package, fset *token.FileSet, x in
 		log.Print(err)
 	}
 
-	// Add comments to struct fields saying which Go version introduced them.
-	if structName != "" {
-		fieldSince := apiInfo.fieldSince[structName]
-		typeSince := apiInfo.typeSince[structName]
-		// Add/rewrite comments on struct fields to note which Go version added them.
+	// Add comments to struct fields, constants and variables saying which
+	// version introduced them.
+	if len(identSince) != 0 {
+		// Add/rewrite comments on the declaration lines to note which version added them.
 		var buf2 bytes.Buffer
-		buf2.Grow(buf.Len() + len(" // Added in Go 1.n")*10)
+		buf2.Grow(buf.Len() + len(" // Added in go1.n")*10)
 		bs := bufio.NewScanner(&buf)
 		for bs.Scan() {
 			line := bs.Bytes()
-			field := firstIdent(line)
+			// a single constant or variable is declared on the keyword line
+			ident := firstIdent(bytes.TrimPrefix(bytes.TrimPrefix(line, constPrefix), varPrefix))
 			var since string
-			if field != "" {
-				since = fieldSince[field]
-				if since != "" && since == typeSince {
+			if ident != "" {
+				since = identSince[ident]
+				if since != "" && since == outerSince {
 					// Don't highlight field versions if they were the same as the struct itself.
 					since = ""
 				}
@@ -999,10 +1317,10 @@ func (page *Page) writeNode(w io.Writer, pkg *Package, fset *token.FileSet, x in
 				if bytes.Contains(line, slashSlash) {
 					line = bytes.TrimRight(line, " \t.")
 					buf2.Write(line)
-					buf2.WriteString("; added in Go ")
+					buf2.WriteString("; added in ")
 				} else {
 					buf2.Write(line)
-					buf2.WriteString(" // Go ")
+					buf2.WriteString(" // ")
 				}
 				buf2.WriteString(since)
 			}
@@ -1012,7 +1330,11 @@ func (page *Page) writeNode(w io.Writer, pkg *Package, fset *token.FileSet, x in
 	}
 }
 
-var slashSlash = []byte("//")
+var (
+	slashSlash  = []byte("//")
+	constPrefix = []byte("const ")
+	varPrefix   = []byte("var ")
+)
 
 // WriteNode writes x to w.
 // TODO(bgarcia) Is this method needed? It's just a wrapper for p.writeNode.
@@ -0,0 +1,92 @@
+package document_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miclle/gsd/document"
+)
+
+func TestBaseURL(t *testing.T) {
+	assert := assert.New(t)
+
+	root := writeModule(t, map[string]string{
+		"go.mod": "module example.com/m\n\ngo 1.16\n",
+		"a/a.go": `// Package a is an example.
+package a
+
+import "io"
+
+// T is a type.
+type T struct{ W io.Writer }
+
+// New returns a T.
+func New() *T { return &T{} }
+
+// Use uses a T.
+func Use(t *T) {}
+`,
+	})
+
+	output := t.TempDir()
+
+	corpus, err := document.NewCorpus(&document.Config{
+		Path:    root,
+		Output:  output,
+		BaseURL: "https://intranet/docs/myservice/",
+	})
+	assert.Nil(err)
+	assert.Nil(corpus.Export())
+
+	data, err := os.ReadFile(filepath.Join(output, "example.com/m/a/index.html"))
+	assert.Nil(err)
+	page := string(data)
+
+	assert.NotContains(page, `"/_static/`)
+	assert.Contains(page, `href="https://intranet/docs/myservice/_static/style.css"`)
+	assert.Contains(page, `action="https://intranet/docs/myservice/search"`)
+	assert.Contains(page, `href="https://intranet/docs/myservice/example.com/m/a/T.html"`)
+	assert.NotContains(page, `href="/pkg/`)
+
+	data, err = os.ReadFile(filepath.Join(output, "example.com/m/a/T.html"))
+	assert.Nil(err)
+	assert.Contains(string(data), `href="https://pkg.go.dev/io#Writer"`)
+
+	data, err = os.ReadFile(filepath.Join(output, "_static", document.StaticIndexFilename))
+	assert.Nil(err)
+	assert.Contains(string(data), `"https://intranet/docs/myservice/example.com/m/a/T.html"`)
+
+	// the webserver serves under the path of the base URL
+	server := httptest.NewServer(corpus.ServeMux())
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/docs/myservice/example.com/m/a/T.html")
+	assert.Nil(err)
+	resp.Body.Close()
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = http.Get(server.URL + "/docs/myservice/_static/style.css")
+	assert.Nil(err)
+	resp.Body.Close()
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	// the search page form and results are under the base URL too
+	resp, err = http.Get(server.URL + "/docs/myservice/search?q=New")
+	assert.Nil(err)
+	data, err = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	assert.Contains(string(data), `<form class="search-form" action="https://intranet/docs/myservice/search" method="GET">`)
+	assert.Contains(string(data), `href="https://intranet/docs/myservice/example.com/m/a/T.New.html"`)
+	assert.NotContains(string(data), `action="/search"`)
+
+	_, err = document.NewCorpus(&document.Config{Path: root, BaseURL: "https://intranet/docs?q=1"})
+	assert.NotNil(err)
+}
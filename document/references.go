@@ -0,0 +1,281 @@
+// This file implements the identifier index, the declaration and use sites
+// of the identifiers across the corpus. The sites are collected from the
+// type information of the packages. The package level identifiers are
+// indexed, with the methods and fields of the named types.
+
+package document
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/types"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Spot is a declaration or use site of an identifier
+type Spot struct {
+	File string   // source path, "import/path/file.go"
+	Info SpotInfo // kind and line of the site
+}
+
+// Line return the line of the site in the file
+func (s *Spot) Line() int {
+	return s.Info.Lori()
+}
+
+// MarshalJSON encodes the spot with its line
+func (s *Spot) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		File string `json:"file"`
+		Line int    `json:"line"`
+	}{s.File, s.Line()})
+}
+
+// SpotGroup are the sites of an identifier of a kind
+type SpotGroup struct {
+	Kind  SpotKind
+	Spots []*Spot // sorted by file and line
+}
+
+// MarshalJSON encodes the group with the name of its kind
+func (g *SpotGroup) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Kind  string  `json:"kind"`
+		Spots []*Spot `json:"spots"`
+	}{g.Kind.Name(), g.Spots})
+}
+
+// References return the sites of the identifier name of the package
+// importPath grouped by kind, the package itself if name is empty, such
+// as "T", "T.Method" or "Func". Only the exported identifiers are indexed,
+// unless the unexported ones are documented.
+func (s *Snapshot) References(importPath, name string) []*SpotGroup {
+	s.referencesOnce.Do(s.indexReferences)
+
+	key := importPath
+	if name != "" {
+		key += "." + name
+	}
+	return s.references[key]
+}
+
+// objectReferences return the sites of the identifier of obj grouped by kind
+func (s *Snapshot) objectReferences(obj types.Object) []*SpotGroup {
+	s.referencesOnce.Do(s.indexReferences)
+	return s.references[s.objectKey(obj)]
+}
+
+// indexReferences collects the sites of the identifiers of every package
+func (s *Snapshot) indexReferences() {
+
+	spots := map[string][]*Spot{}
+
+	var importPaths []string
+	for importPath := range s.Packages {
+		importPaths = append(importPaths, importPath)
+	}
+	sort.Strings(importPaths)
+
+	for _, importPath := range importPaths {
+		pkg := s.Packages[importPath]
+
+		add := func(key string, kind SpotKind, node ast.Node) {
+			position := pkg.FSet.Position(node.Pos())
+			if file := sourcePath(pkg, position.Filename); file != "" {
+				spots[key] = append(spots[key], &Spot{File: file, Info: makeSpotInfo(kind, position.Line, false)})
+			}
+		}
+
+		for _, filename := range pkg.sortedFilenames() {
+			file := pkg.PAst[filename]
+			add(pkg.ImportPath, PackageClause, file.Name)
+
+			for _, spec := range file.Imports {
+				if path, err := strconv.Unquote(spec.Path.Value); err == nil {
+					add(path, ImportDecl, spec)
+				}
+			}
+		}
+
+		if pkg.TypesInfo == nil {
+			continue
+		}
+
+		for ident, obj := range pkg.TypesInfo.Defs {
+			if key := s.objectKey(obj); key != "" {
+				add(key, declKind(obj), ident)
+			}
+		}
+
+		for ident, obj := range pkg.TypesInfo.Uses {
+			if key := s.objectKey(obj); key != "" {
+				add(key, Use, ident)
+			}
+		}
+	}
+
+	s.references = map[string][]*SpotGroup{}
+
+	for key, list := range spots {
+		sort.Slice(list, func(i, j int) bool {
+			if list[i].File != list[j].File {
+				return list[i].File < list[j].File
+			}
+			return list[i].Line() < list[j].Line()
+		})
+
+		var groups [nKinds]*SpotGroup
+		for _, spot := range list {
+			kind := spot.Info.Kind()
+			if groups[kind] == nil {
+				groups[kind] = &SpotGroup{Kind: kind}
+			}
+			groups[kind].Spots = append(groups[kind].Spots, spot)
+		}
+
+		for _, group := range groups {
+			if group != nil {
+				s.references[key] = append(s.references[key], group)
+			}
+		}
+	}
+}
+
+// fieldKeys return the keys of the fields of the package level struct
+// types of pkg by field object, a field object does not know the type
+// declaring it. The keys are kept by package object, a reparse loads the
+// changed packages apart from the others and every load has its own
+// objects.
+func (s *Snapshot) fieldKeys(pkg *types.Package) map[*types.Var]string {
+
+	s.fieldsMu.Lock()
+	defer s.fieldsMu.Unlock()
+
+	if keys, exists := s.fields[pkg]; exists {
+		return keys
+	}
+
+	keys := map[*types.Var]string{}
+
+	scope := pkg.Scope()
+	for _, name := range scope.Names() {
+		tn, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || tn.IsAlias() || !s.private && !tn.Exported() {
+			continue
+		}
+
+		st, ok := tn.Type().Underlying().(*types.Struct)
+		if !ok {
+			continue
+		}
+		for i := 0; i < st.NumFields(); i++ {
+			field := st.Field(i)
+			keys[field] = pkg.Path() + "." + tn.Name() + "." + field.Name()
+		}
+	}
+
+	if s.fields == nil {
+		s.fields = map[*types.Package]map[*types.Var]string{}
+	}
+	s.fields[pkg] = keys
+
+	return keys
+}
+
+// objectKey return the key of obj in the identifier index, such as
+// "import/path.T.Method" or "import/path.T.Field", empty for the objects
+// which are not indexed: the local and unexported ones, and the fields and
+// methods of unnamed types
+func (s *Snapshot) objectKey(obj types.Object) string {
+
+	if obj == nil || obj.Pkg() == nil || !s.private && !obj.Exported() {
+		return ""
+	}
+
+	switch obj := obj.(type) {
+	case *types.Func:
+		// the methods of the instances are their generic methods
+		obj = obj.Origin()
+
+		recv := obj.Type().(*types.Signature).Recv()
+		if recv == nil {
+			break
+		}
+
+		typ := recv.Type()
+		if pointer, ok := typ.(*types.Pointer); ok {
+			typ = pointer.Elem()
+		}
+		named, ok := typ.(*types.Named)
+		if !ok || named.Obj().Parent() != obj.Pkg().Scope() {
+			return ""
+		}
+		return obj.Pkg().Path() + "." + named.Obj().Name() + "." + obj.Name()
+
+	case *types.Var:
+		if obj.IsField() {
+			// the fields of the instances are their generic fields
+			return s.fieldKeys(obj.Pkg())[obj.Origin()]
+		}
+		if obj.Parent() != obj.Pkg().Scope() {
+			return ""
+		}
+
+	case *types.Const, *types.TypeName:
+		if obj.Parent() != obj.Pkg().Scope() {
+			return ""
+		}
+
+	default:
+		return ""
+	}
+
+	return obj.Pkg().Path() + "." + obj.Name()
+}
+
+// declKind return the spot kind of the declaration of obj
+func declKind(obj types.Object) SpotKind {
+	switch obj := obj.(type) {
+	case *types.Const:
+		return ConstDecl
+	case *types.TypeName:
+		return TypeDecl
+	case *types.Var:
+		return VarDecl
+	case *types.Func:
+		if obj.Type().(*types.Signature).Recv() != nil {
+			return MethodDecl
+		}
+		return FuncDecl
+	}
+	return Use
+}
+
+// ReferencesHandler serve the sites of an identifier as JSON, the "pkg"
+// parameter is the import path of its package, "name" its name, such as
+// "T" or "T.Method", empty for the package itself
+func (c *Corpus) ReferencesHandler(w http.ResponseWriter, req *http.Request) {
+
+	// logging
+	log.Printf("%s %s\n", req.RemoteAddr, req.URL)
+
+	var (
+		importPath = strings.TrimSpace(req.FormValue("pkg"))
+		name       = strings.TrimSpace(req.FormValue("name"))
+		groups     = c.Snapshot().References(importPath, name)
+	)
+
+	if importPath == "" || groups == nil {
+		http.NotFound(w, req)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if err := json.NewEncoder(w).Encode(groups); err != nil {
+		log.Println("references encode error", err.Error())
+	}
+}
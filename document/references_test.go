@@ -0,0 +1,108 @@
+package document_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miclle/gsd/document"
+)
+
+func TestReferences(t *testing.T) {
+	assert := assert.New(t)
+
+	root := writeModule(t, map[string]string{
+		"go.mod": "module example.com/m\n\ngo 1.18\n",
+		"a/a.go": `// Package a declares the shapes.
+package a
+
+// Shape is a shape.
+type Shape interface {
+	Area() float64
+}
+
+// Square is a square.
+type Square struct{ Side float64 }
+
+// Area returns the area.
+func (s Square) Area() float64 { return s.Side * s.Side }
+`,
+		"b/b.go": `// Package b sums the shapes.
+package b
+
+import "example.com/m/a"
+
+// Total sums the areas.
+func Total(shapes ...a.Shape) (total float64) {
+	for _, s := range shapes {
+		total += s.Area()
+	}
+	return total + a.Square{Side: 1}.Area()
+}
+`,
+	})
+
+	corpus, err := document.NewCorpus(&document.Config{Path: root})
+	assert.Nil(err)
+	assert.Nil(corpus.ParsePackages())
+
+	summary := func(groups []*document.SpotGroup) (result []string) {
+		for _, group := range groups {
+			for _, spot := range group.Spots {
+				result = append(result, group.Kind.Name()+" "+spot.File+":"+strconv.Itoa(spot.Line()))
+			}
+		}
+		return
+	}
+
+	snapshot := corpus.Snapshot()
+
+	assert.Equal([]string{
+		"Types example.com/m/a/a.go:5",
+		"Uses example.com/m/b/b.go:7",
+	}, summary(snapshot.References("example.com/m/a", "Shape")))
+
+	assert.Equal([]string{
+		"Methods example.com/m/a/a.go:13",
+		"Uses example.com/m/b/b.go:11",
+	}, summary(snapshot.References("example.com/m/a", "Square.Area")))
+
+	assert.Equal([]string{
+		"Packages example.com/m/a/a.go:2",
+		"Imports example.com/m/b/b.go:4",
+	}, summary(snapshot.References("example.com/m/a", "")))
+
+	// the fields are indexed with their type
+	assert.Equal([]string{
+		"Variables example.com/m/a/a.go:10",
+		"Uses example.com/m/a/a.go:13",
+		"Uses example.com/m/a/a.go:13",
+		"Uses example.com/m/b/b.go:11",
+	}, summary(snapshot.References("example.com/m/a", "Square.Side")))
+
+	// local identifiers are not indexed
+	assert.Nil(snapshot.References("example.com/m/b", "total"))
+
+	mux := corpus.ServeMux()
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/_references?pkg=example.com/m/a&name=Shape.Area", nil))
+	assert.Equal(http.StatusOK, recorder.Code)
+	assert.JSONEq(`[
+		{"kind": "Methods", "spots": [{"file": "example.com/m/a/a.go", "line": 6}]},
+		{"kind": "Uses", "spots": [{"file": "example.com/m/b/b.go", "line": 9}]}
+	]`, recorder.Body.String())
+
+	recorder = httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/_references?pkg=example.com/m/a&name=Circle", nil))
+	assert.Equal(http.StatusNotFound, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/example.com/m/a/Square.Area.html", nil))
+	body := recorder.Body.String()
+	assert.Contains(body, `<h2 id="uses">Uses</h2>`)
+	assert.Contains(body, `<li><a href="/src/example.com/m/b/b.go.html?h=Area#L11">example.com/m/b/b.go:11</a></li>`)
+}
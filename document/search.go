@@ -0,0 +1,363 @@
+// This file implements the corpus search index. The index is built from
+// the analyzed packages whenever the corpus is parsed and answers queries
+// for package names, identifiers and documentation text.
+
+package document
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/doc"
+	"html/template"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// SearchKind is the kind of an indexed item
+type SearchKind string
+
+const (
+	// SearchPackage package search kind
+	SearchPackage SearchKind = "package"
+	// SearchType type search kind
+	SearchType SearchKind = "type"
+	// SearchFunc func search kind
+	SearchFunc SearchKind = "func"
+	// SearchMethod method search kind
+	SearchMethod SearchKind = "method"
+	// SearchField struct field search kind
+	SearchField SearchKind = "field"
+	// SearchConst constant search kind
+	SearchConst SearchKind = "const"
+	// SearchVar variable search kind
+	SearchVar SearchKind = "var"
+)
+
+// kindWeights ranks equally matched items by kind
+var kindWeights = map[SearchKind]int{
+	SearchPackage: 6,
+	SearchType:    5,
+	SearchFunc:    4,
+	SearchMethod:  3,
+	SearchConst:   2,
+	SearchVar:     2,
+	SearchField:   1,
+}
+
+// SearchItem is an indexed package or identifier
+type SearchItem struct {
+	Kind       SearchKind `json:"kind"`
+	Name       string     `json:"name"`        // qualified name, e.g. "Corpus.Export"
+	ImportPath string     `json:"import_path"` // import path of the declaring package
+	URL        string     `json:"url"`         // document page URL
+	Doc        string     `json:"doc"`         // documentation text
+}
+
+// SearchResult is a ranked search item
+type SearchResult struct {
+	*SearchItem
+
+	Score   int           `json:"score"`
+	Snippet template.HTML `json:"snippet"` // documentation excerpt with highlighted matches
+}
+
+// SearchIndex holds all the searchable items of a corpus
+type SearchIndex struct {
+	Items []*SearchItem
+}
+
+// NewSearchIndex return a search index of packages.
+// Unexported identifiers are indexed only if private is true.
+func NewSearchIndex(packages map[string]*Package, private bool) *SearchIndex {
+
+	index := &SearchIndex{}
+
+	add := func(kind SearchKind, name, importPath, url, doc string) {
+		if !private {
+			for _, segment := range strings.Split(name, ".") {
+				if !IsExported(segment) {
+					return
+				}
+			}
+		}
+		index.Items = append(index.Items, &SearchItem{
+			Kind:       kind,
+			Name:       name,
+			ImportPath: importPath,
+			URL:        url,
+			Doc:        strings.TrimSpace(doc),
+		})
+	}
+
+	addValues := func(kind SearchKind, pkg *Package, values []*doc.Value) {
+		for _, v := range values {
+			for _, name := range v.Names {
+				add(kind, name, pkg.ImportPath, "/"+pkg.ImportPath+"#"+name, v.Doc)
+			}
+		}
+	}
+
+	var importPaths []string
+	for importPath := range packages {
+		importPaths = append(importPaths, importPath)
+	}
+	sort.Strings(importPaths)
+
+	for _, importPath := range importPaths {
+		pkg := packages[importPath]
+		if pkg.DocPackage == nil {
+			continue
+		}
+
+		pkgURL := "/" + importPath
+
+		index.Items = append(index.Items, &SearchItem{
+			Kind:       SearchPackage,
+			Name:       pkg.Name,
+			ImportPath: importPath,
+			URL:        pkgURL,
+			Doc:        strings.TrimSpace(pkg.Doc),
+		})
+
+		addValues(SearchConst, pkg, pkg.Consts)
+		addValues(SearchVar, pkg, pkg.Vars)
+
+		for _, fn := range pkg.Funcs {
+			add(SearchFunc, fn.Name, importPath, pkgURL+"#"+fn.Name, fn.Doc)
+		}
+
+		for _, t := range pkg.Types {
+			typeURL := pkgURL + "/" + t.Name + ".html"
+
+			add(SearchType, t.Name, importPath, typeURL, t.Doc)
+
+			addValues(SearchConst, pkg, t.Consts)
+			addValues(SearchVar, pkg, t.Vars)
+
+			for _, fn := range t.Funcs {
+				kind := SearchFunc
+				if fn.Decl == nil { // interface method
+					kind = SearchMethod
+				}
+				add(kind, t.Name+"."+fn.Name, importPath, pkgURL+"/"+t.Name+"."+fn.Name+".html", fn.Doc)
+			}
+
+			for _, fn := range t.Methods {
+				add(SearchMethod, t.Name+"."+fn.Name, importPath, pkgURL+"/"+t.Name+"."+fn.Name+".html", fn.Doc)
+			}
+
+			if t.TypeSpec != StructType {
+				continue
+			}
+
+			for _, f := range t.Fields {
+				var buf bytes.Buffer
+				if f.Doc != nil {
+					buf.WriteString(f.Doc.Text())
+				}
+				if f.Comment != nil {
+					buf.WriteString(f.Comment.Text())
+				}
+				for _, name := range f.JoinNames() {
+					add(SearchField, t.Name+"."+name, importPath, typeURL, buf.String())
+				}
+			}
+		}
+	}
+
+	return index
+}
+
+// Search return at most limit items matching query, best matches first.
+// Every whitespace separated term of the query must match the name,
+// import path or documentation of an item.
+func (index *SearchIndex) Search(query string, limit int) (results []*SearchResult) {
+
+	terms := strings.Fields(strings.ToLower(query))
+	if index == nil || len(terms) == 0 {
+		return
+	}
+
+	for _, item := range index.Items {
+		if score := item.score(terms); score > 0 {
+			results = append(results, &SearchResult{
+				SearchItem: item,
+				Score:      score,
+			})
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if len(a.Name) != len(b.Name) {
+			return len(a.Name) < len(b.Name)
+		}
+		return a.ImportPath+a.Name < b.ImportPath+b.Name
+	})
+
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+
+	pattern := highlightPattern(terms)
+	for _, result := range results {
+		result.Snippet = snippet(result.Doc, terms, pattern)
+	}
+
+	return
+}
+
+// withURLs return a copy of the index with the item URLs mapped by url
+func (index *SearchIndex) withURLs(url func(item *SearchItem) string) *SearchIndex {
+
+	copied := &SearchIndex{}
+	if index == nil {
+		return copied
+	}
+
+	for _, item := range index.Items {
+		c := *item
+		c.URL = url(item)
+		copied.Items = append(copied.Items, &c)
+	}
+
+	return copied
+}
+
+// score return the relevance of item for terms, zero if any term does not match
+func (item *SearchItem) score(terms []string) (score int) {
+
+	var (
+		name       = strings.ToLower(item.Name)
+		simpleName = name[strings.LastIndex(name, ".")+1:]
+		importPath = strings.ToLower(item.ImportPath)
+		doc        = strings.ToLower(item.Doc)
+	)
+
+	for _, term := range terms {
+		switch {
+		case simpleName == term || name == term:
+			score += 100
+		case strings.HasPrefix(simpleName, term):
+			score += 60
+		case strings.Contains(name, term):
+			score += 40
+		case strings.Contains(importPath, term):
+			score += 20
+		case strings.Contains(doc, term):
+			score += 10
+		default:
+			return 0
+		}
+	}
+
+	return score + kindWeights[item.Kind]
+}
+
+// highlightPattern return a case-insensitive regular expression matching any of terms
+func highlightPattern(terms []string) string {
+	var quoted []string
+	for _, term := range terms {
+		quoted = append(quoted, regexp.QuoteMeta(term))
+	}
+	return "(?i)" + strings.Join(quoted, "|")
+}
+
+// snippetSize is the maximum length in bytes of a search result snippet
+const snippetSize = 200
+
+// snippet return an HTML excerpt of text around the first matching term,
+// matches are wrapped in highlight spans.
+func snippet(text string, terms []string, pattern string) template.HTML {
+
+	text = strings.Join(strings.Fields(text), " ")
+	if text == "" {
+		return ""
+	}
+
+	var (
+		lower = strings.ToLower(text)
+		start = 0
+	)
+
+	for _, term := range terms {
+		if i := strings.Index(lower, term); i >= 0 {
+			start = i
+			break
+		}
+	}
+
+	// keep some leading context, starting at a word boundary
+	if start -= snippetSize / 4; start <= 0 {
+		start = 0
+	} else if sp := strings.IndexByte(text[start:], ' '); sp >= 0 {
+		start += sp + 1
+	}
+
+	end := start + snippetSize
+	if end >= len(text) {
+		end = len(text)
+	} else if sp := strings.LastIndexByte(text[start:end], ' '); sp > 0 {
+		end = start + sp
+	}
+
+	// never split a multi-byte character
+	for start > 0 && !utf8.RuneStart(text[start]) {
+		start--
+	}
+	for end < len(text) && !utf8.RuneStart(text[end]) {
+		end--
+	}
+
+	var buf bytes.Buffer
+	if start > 0 {
+		buf.WriteString("… ")
+	}
+	FormatText(&buf, []byte(text[start:end]), -1, false, pattern, nil)
+	if end < len(text) {
+		buf.WriteString(" …")
+	}
+
+	return template.HTML(buf.String())
+}
+
+// --------------------------------------------------------------------
+
+// StaticIndexFilename is the name of the client-side search index in the
+// static assets of an exported corpus
+const StaticIndexFilename = "search-index.json"
+
+// staticIndex is the compact client-side search index. Every item is an
+// array of kind, name, import path, URL and documentation synopsis.
+type staticIndex struct {
+	Version int         `json:"version"`
+	Items   [][5]string `json:"items"`
+}
+
+// WriteStatic writes the compact JSON search index used by the browser
+// when the documents are served without a webserver.
+func (index *SearchIndex) WriteStatic(w io.Writer) error {
+
+	var (
+		synopsis = new(doc.Package)
+		static   = staticIndex{Version: 1, Items: [][5]string{}}
+	)
+
+	for _, item := range index.Items {
+		static.Items = append(static.Items, [5]string{
+			string(item.Kind),
+			item.Name,
+			item.ImportPath,
+			item.URL,
+			synopsis.Synopsis(item.Doc),
+		})
+	}
+
+	return json.NewEncoder(w).Encode(static)
+}
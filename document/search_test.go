@@ -0,0 +1,40 @@
+package document_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miclle/gsd/document"
+)
+
+func TestSearchIndex(t *testing.T) {
+	assert := assert.New(t)
+
+	corpus, err := document.NewCorpus(&document.Config{Path: "."})
+	assert.Nil(err)
+
+	err = corpus.ParsePackages()
+	assert.Nil(err)
+
+	index := document.NewSearchIndex(corpus.Snapshot().Packages, false)
+	assert.NotEmpty(index.Items)
+
+	results := index.Search("corpus", 10)
+	assert.NotEmpty(results)
+	assert.Equal("Corpus", results[0].Name)
+	assert.Equal(document.SearchType, results[0].Kind)
+	assert.Equal("/github.com/miclle/gsd/document/Corpus.html", results[0].URL)
+
+	results = index.Search("corpus export", 10)
+	assert.NotEmpty(results)
+	assert.Equal("Corpus.Export", results[0].Name)
+
+	for _, item := range index.Items {
+		if item.Kind != document.SearchPackage {
+			assert.True(document.IsExported(item.Name), item.Name)
+		}
+	}
+
+	assert.Empty(index.Search("no-such-identifier", 10))
+}
@@ -0,0 +1,112 @@
+package document
+
+import (
+	"go/types"
+	"strings"
+	"sync"
+)
+
+// A Snapshot is a consistent state of the parsed corpus. A snapshot is
+// never modified once it is published, a reparse builds a new one which
+// replaces it atomically, so request handlers take one snapshot and use it
+// for the whole request.
+type Snapshot struct {
+	// Packages is all packages cache
+	Packages map[string]*Package
+
+	// Tree is packages tree struct
+	// - a
+	// 	- a-a
+	// - b
+	//
+	Tree Packages
+
+	// SearchIndex is the search index of all packages
+	SearchIndex *SearchIndex
+
+	sidebarOnce sync.Once
+	sidebar     []byte // rendered sidebar, shared by all pages
+	sidebarErr  error
+
+	private        bool // unexported identifiers are indexed
+	implementsOnce sync.Once
+	implements     map[string]*Implementations // by "import/path.Type"
+	referencesOnce sync.Once
+	references     map[string][]*SpotGroup // by "import/path.Name"
+	fieldsMu       sync.Mutex
+	fields         map[*types.Package]map[*types.Var]string // field keys by package and field object
+	graphOnce      sync.Once
+	graph          *ImportGraph
+	callsOnce      sync.Once
+	calls          map[string]*Calls // by "import/path.Type.Func"
+}
+
+// emptySnapshot is the snapshot of a corpus which was never parsed
+var emptySnapshot = &Snapshot{Packages: map[string]*Package{}}
+
+// newSnapshot return a snapshot of packages, linking them into a tree.
+// Unexported identifiers are indexed only if private is true.
+func newSnapshot(pkgs []*Package, private bool) *Snapshot {
+
+	snapshot := &Snapshot{
+		Packages: map[string]*Package{},
+		Tree:     Packages{},
+		private:  private,
+	}
+
+	// the packages are shared with the previous snapshots by a reparse,
+	// link the copies so the published tree is never modified
+	for _, pkg := range pkgs {
+		copied := *pkg
+		copied.ParentImportPath = ""
+		copied.Parent = nil
+		copied.SubPackages = nil
+		snapshot.Packages[pkg.ImportPath] = &copied
+	}
+
+	// parse packages tree
+	for _, pkg := range snapshot.Packages {
+		if pkg.Module != nil && pkg.Module.Path == pkg.ImportPath {
+			continue
+		}
+
+		var (
+			seps       []string
+			parentPath = pkg.ImportPath
+		)
+
+		if pkg.Module != nil {
+			seps = strings.Split(strings.TrimPrefix(pkg.ImportPath, pkg.Module.Path+"/"), "/")
+		} else {
+			seps = strings.Split(pkg.ImportPath, "/")
+		}
+
+		for i := len(seps); i > 0; i-- {
+			parentPath = strings.TrimSuffix(parentPath, "/"+seps[i-1])
+			if parentPkg, exists := snapshot.Packages[parentPath]; exists {
+				pkg.ParentImportPath = parentPkg.ParentImportPath
+				pkg.Parent = parentPkg
+				parentPkg.SubPackages = append(parentPkg.SubPackages, pkg)
+				break
+			}
+		}
+	}
+
+	for _, pkg := range snapshot.Packages {
+		if pkg.Parent == nil {
+			snapshot.Tree = append(snapshot.Tree, pkg)
+		}
+	}
+
+	snapshot.SearchIndex = NewSearchIndex(snapshot.Packages, private)
+
+	return snapshot
+}
+
+// Sidebar return the sidebar of the snapshot rendered by page
+func (s *Snapshot) Sidebar(page *Page) ([]byte, error) {
+	s.sidebarOnce.Do(func() {
+		s.sidebar, s.sidebarErr = applyTemplate(page.SidebarHTML, "sidebar", page)
+	})
+	return s.sidebar, s.sidebarErr
+}
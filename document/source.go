@@ -0,0 +1,135 @@
+// This file implements the source file pages. The files are rendered from
+// the sources and the AST of the package load, their identifiers linked to
+// the documents with the type information of the package.
+
+package document
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	pathpkg "path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// sourcePath return the path of the source file filename of pkg, such as
+// "import/path/file.go", empty if the file is not in the package directory
+func sourcePath(pkg *Package, filename string) string {
+	if pkg == nil || pkg.PAst[filename] == nil {
+		return ""
+	}
+	return pkg.ImportPath + "/" + filepath.Base(filename)
+}
+
+// sourceFile return the package and the filename of the source path
+func (s *Snapshot) sourceFile(path string) (*Package, string) {
+
+	importPath, name := pathpkg.Split(path)
+
+	pkg := s.Packages[strings.TrimSuffix(importPath, "/")]
+	if pkg == nil {
+		return nil, ""
+	}
+
+	for filename := range pkg.PAst {
+		if filepath.Base(filename) == name {
+			return pkg, filename
+		}
+	}
+
+	return nil, ""
+}
+
+// renderSource renders the source page of the file filename of the page
+// package. The occurrences of highlight and the selection are marked.
+func (page *Page) renderSource(w *bytes.Buffer, filename, highlight string, selection Selection) error {
+
+	src := page.Package.Sources[filename]
+	if src == nil {
+		return fmt.Errorf("no source of file %s", filename)
+	}
+
+	var pattern string
+	if highlight != "" {
+		pattern = regexp.QuoteMeta(highlight)
+	}
+
+	var code bytes.Buffer
+
+	if file := page.Package.PAst[filename]; file != nil && page.DeclLinks {
+		var highlights Selection
+		if pattern != "" {
+			highlights = regexpSelection(src, pattern)
+		}
+
+		LinkifyText(&code, src, file, page.Package, func(path, name string) string {
+			return page.identURL(page.Package, path, name)
+		}, highlights, selection)
+	} else {
+		FormatText(&code, src, -1, true, pattern, selection)
+	}
+
+	page.SourcePath = sourcePath(page.Package, filename)
+	page.Title = filepath.Base(filename)
+	page.Highlight = highlight
+	page.Source = string(lineAnchors(code.Bytes()))
+
+	return page.Render(w, SourcePage)
+}
+
+// lineAnchors return the formatted source with a line number span before
+// every line, the span ids "L1", "L2", ... are the anchors of the lines
+func lineAnchors(code []byte) []byte {
+
+	var (
+		buf  bytes.Buffer
+		line = 1
+	)
+
+	for len(code) > 0 {
+		fmt.Fprintf(&buf, `<span id="L%d" class="ln">%6d</span>`, line, line)
+		line++
+
+		i := bytes.IndexByte(code, '\n')
+		if i < 0 {
+			buf.Write(code)
+			break
+		}
+		buf.Write(code[:i+1])
+		code = code[i+1:]
+	}
+
+	return buf.Bytes()
+}
+
+// SourceHandler serve the source file pages, "/src/import/path/file.go.html".
+// The "h" query parameter is highlighted, the "s=low:high" offsets selected.
+func (c *Corpus) SourceHandler(w http.ResponseWriter, req *http.Request) {
+
+	path := strings.TrimPrefix(req.URL.Path, "/src/")
+	if !strings.HasSuffix(path, ".html") {
+		http.NotFound(w, req)
+		return
+	}
+
+	page := NewPage(c)
+
+	pkg, filename := page.Snapshot.sourceFile(strings.TrimSuffix(path, ".html"))
+	if pkg == nil {
+		http.NotFound(w, req)
+		return
+	}
+	page.Package = pkg
+
+	var buf bytes.Buffer
+	if err := page.renderSource(&buf, filename, req.FormValue("h"), RangeSelection(req.FormValue("s"))); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(buf.Bytes())
+}
@@ -0,0 +1,91 @@
+package document_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miclle/gsd/document"
+)
+
+func TestSource(t *testing.T) {
+	assert := assert.New(t)
+
+	root := writeModule(t, map[string]string{
+		"go.mod": "module example.com/m\n\ngo 1.16\n",
+		"a/a.go": `// Package a is documented.
+package a
+
+// T is a type.
+type T struct{}
+
+// New returns a T.
+func New() *T {
+	t := &T{}
+	return t
+}
+`,
+	})
+
+	// live documents server
+	{
+		corpus, err := document.NewCorpus(&document.Config{Path: root})
+		assert.Nil(err)
+		assert.Nil(corpus.ParsePackages())
+
+		mux := corpus.ServeMux()
+
+		recorder := httptest.NewRecorder()
+		mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/example.com/m/a/T.New.html", nil))
+		assert.Contains(recorder.Body.String(), `<a href="/src/example.com/m/a/a.go.html?s=92:105#L1">New</a>`)
+
+		recorder = httptest.NewRecorder()
+		mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/src/example.com/m/a/a.go.html?h=return", nil))
+		assert.Equal(http.StatusOK, recorder.Code)
+
+		body := recorder.Body.String()
+		assert.Contains(body, `<p class="source-package">Package <a href="/example.com/m/a">example.com/m/a</a></p>`)
+		assert.Contains(body, `<span id="L1" class="ln">     1</span><span class="comment">// Package a is documented.</span>`)
+		assert.Contains(body, `<span id="L9" class="ln">     9</span>	t := &amp;<a href="/example.com/m/a/T.html">T</a>{}`)
+		assert.Contains(body, `<span class="highlight">return</span> t`)
+
+		for _, path := range []string{"/src/example.com/m/a/b.go.html", "/src/example.com/m/a/a.go", "/src/example.com/m/b/a.go.html"} {
+			recorder = httptest.NewRecorder()
+			mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+			assert.Equal(http.StatusNotFound, recorder.Code, path)
+		}
+
+		// the page shows the source of the parsed package until a reparse
+		writeFiles(t, root, map[string]string{
+			"a/a.go": "package a\n\n// T is a type.\ntype T struct{ Changed int }\n",
+		})
+
+		recorder = httptest.NewRecorder()
+		mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/src/example.com/m/a/a.go.html", nil))
+		body = recorder.Body.String()
+		assert.Contains(body, `<span id="L9" class="ln">     9</span>	t := &amp;<a href="/example.com/m/a/T.html">T</a>{}`)
+		assert.NotContains(body, "Changed")
+	}
+
+	// exported documents
+	{
+		writeFiles(t, root, map[string]string{
+			"a/a.go": "// Package a is documented.\npackage a\n\n// T is a type.\ntype T struct{}\n\n// New returns a T.\nfunc New() *T {\n\tt := &T{}\n\treturn t\n}\n",
+		})
+
+		output := t.TempDir()
+
+		corpus, err := document.NewCorpus(&document.Config{Path: root, Output: output})
+		assert.Nil(err)
+		assert.Nil(corpus.Export())
+
+		data, err := os.ReadFile(filepath.Join(output, "src", "example.com", "m", "a", "a.go.html"))
+		assert.Nil(err)
+		assert.Contains(string(data), `<pre class="source" data-highlight-query>`)
+		assert.Contains(string(data), `<span id="L8" class="ln">     8</span>func New() *<a href="/example.com/m/a/T.html">T</a> {`)
+	}
+}
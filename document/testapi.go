@@ -0,0 +1,139 @@
+// This file implements the test API of the packages, the declarations of
+// their _test.go files, such as the shared test helpers and fixtures, and of
+// their external test packages. go/doc only keeps the examples of the test
+// files, the test API is documented by its own pass over them.
+
+package document
+
+import (
+	"go/ast"
+	"go/doc"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// analyzeTests documents the declarations of the test files of the package
+// in TestAPI, the package test files first, the external test package next.
+// The testFiles are the parsed _test.go files of both.
+func (p *Package) analyzeTests(testFiles []*ast.File) {
+
+	var (
+		files          = map[string]*ast.File{} // package test files, and package files
+		xtestFiles     = map[string]*ast.File{} // external test package files
+		testFilenames  []string
+		xtestFilenames []string
+	)
+
+	for _, file := range testFiles {
+		filename := p.FSet.File(file.Pos()).Name()
+		if strings.HasSuffix(file.Name.Name, "_test") {
+			xtestFiles[filename] = file
+			xtestFilenames = append(xtestFilenames, filename)
+		} else {
+			files[filename] = file
+			testFilenames = append(testFilenames, filename)
+		}
+	}
+
+	p.TestAPI = nil
+
+	// the package files are documented with the test files, go/doc drops the
+	// test methods and funcs of the package types otherwise
+	if len(testFilenames) > 0 {
+		for filename, file := range p.PAst {
+			files[filename] = file
+		}
+
+		d := doc.New(&ast.Package{Name: p.Name, Files: files}, p.ImportPath, doc.AllDecls|doc.PreserveAST)
+		if test := p.testPackage(d, p.ImportPath, testFilenames); test != nil {
+			p.TestAPI = append(p.TestAPI, test)
+		}
+	}
+
+	if len(xtestFilenames) > 0 {
+		d := doc.New(&ast.Package{Name: p.Name + "_test", Files: xtestFiles}, p.ImportPath+"_test", doc.AllDecls|doc.PreserveAST)
+		if test := p.testPackage(d, p.ImportPath+"_test", xtestFilenames); test != nil {
+			p.TestAPI = append(p.TestAPI, test)
+		}
+	}
+}
+
+// testPackage return the package of the declarations of d in the test
+// files testFilenames, the tests, benchmarks, fuzz tests and examples
+// excluded, nil if there are none. The test values, funcs and methods of
+// the types declared by the package files are listed with the package
+// values and funcs.
+func (p *Package) testPackage(d *doc.Package, importPath string, testFilenames []string) *Package {
+
+	testFiles := map[string]bool{}
+	for _, filename := range testFilenames {
+		testFiles[filename] = true
+	}
+
+	inTest := func(node ast.Node) bool {
+		return testFiles[p.FSet.Position(node.Pos()).Filename]
+	}
+
+	values := func(list []*doc.Value) (result []*doc.Value) {
+		for _, value := range list {
+			if inTest(value.Decl) {
+				result = append(result, value)
+			}
+		}
+		return
+	}
+
+	funcs := func(list []*doc.Func) (result []*Func) {
+		for _, fn := range list {
+			if inTest(fn.Decl) && fn.Level == 0 && (fn.Recv != "" || !isTestFunc(fn.Name)) {
+				result = append(result, NewFuncWithDoc(fn))
+			}
+		}
+		return
+	}
+
+	test := &Package{
+		Dir:        p.Dir,
+		ImportPath: importPath,
+		Name:       d.Name,
+		Doc:        d.Doc,
+		Filenames:  testFilenames,
+		FSet:       p.FSet,
+		Consts:     values(d.Consts),
+		Vars:       values(d.Vars),
+		Funcs:      funcs(d.Funcs),
+	}
+
+	for _, t := range d.Types {
+		if inTest(t.Decl) {
+			test.Types = append(test.Types, NewTypeWithDoc(t))
+			continue
+		}
+
+		test.Consts = append(test.Consts, values(t.Consts)...)
+		test.Vars = append(test.Vars, values(t.Vars)...)
+		test.Funcs = append(test.Funcs, funcs(t.Funcs)...)
+		test.Funcs = append(test.Funcs, funcs(t.Methods)...)
+	}
+
+	if len(test.Consts) == 0 && len(test.Vars) == 0 && len(test.Funcs) == 0 && len(test.Types) == 0 {
+		return nil
+	}
+
+	return test
+}
+
+// isTestFunc reports whether name is the name of a func run by go test,
+// a test, benchmark, fuzz test or example, or TestMain
+func isTestFunc(name string) bool {
+	for _, prefix := range []string{"Test", "Benchmark", "Fuzz", "Example"} {
+		if rest, ok := strings.CutPrefix(name, prefix); ok {
+			r, _ := utf8.DecodeRuneInString(rest)
+			if rest == "" || !unicode.IsLower(r) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+package document_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miclle/gsd/document"
+)
+
+func TestTestAPI(t *testing.T) {
+	assert := assert.New(t)
+
+	root := writeModule(t, map[string]string{
+		"go.mod": "module example.com/m\n\ngo 1.18\n",
+		"a/a.go": "// Package a declares the squares.\npackage a\n\n// Square is a square.\ntype Square struct{ Side float64 }\n",
+		"a/helpers_test.go": `package a
+
+import "testing"
+
+// UnitSquare is the fixture square.
+var UnitSquare = Square{Side: 1}
+
+// NewTestSquare returns a square for the tests.
+func NewTestSquare(t *testing.T, side float64) *Square { return &Square{Side: side} }
+
+// Perimeter returns the perimeter.
+func (s Square) Perimeter() float64 { return 4 * s.Side }
+
+// Fake is a fake square.
+type Fake struct{ Side float64 }
+
+func TestSquare(t *testing.T) {}
+
+func TestMain(m *testing.M) {}
+`,
+		"a/a_ext_test.go": `package a_test
+
+import "testing"
+
+// Check checks the squares.
+func Check(t *testing.T) {}
+
+func ExampleSquare() {}
+`,
+	})
+
+	// opt-in
+	{
+		corpus, err := document.NewCorpus(&document.Config{Path: root})
+		assert.Nil(err)
+		assert.Nil(corpus.ParsePackages())
+		assert.Empty(corpus.Snapshot().Packages["example.com/m/a"].TestAPI)
+	}
+
+	corpus, err := document.NewCorpus(&document.Config{Path: root, Tests: true})
+	assert.Nil(err)
+	assert.Nil(corpus.ParsePackages())
+
+	pkg := corpus.Snapshot().Packages["example.com/m/a"]
+	if !assert.Len(pkg.TestAPI, 2) {
+		return
+	}
+
+	funcNames := func(test *document.Package) (names []string) {
+		for _, fn := range test.Funcs {
+			names = append(names, fn.Recv+" "+fn.Name)
+		}
+		return
+	}
+
+	// the test identifiers are not in the package API
+	assert.Empty(pkg.Vars)
+	assert.Len(pkg.Types, 1)
+	assert.Empty(pkg.Types[0].Methods)
+
+	test := pkg.TestAPI[0]
+	assert.Equal("a", test.Name)
+	assert.Equal("example.com/m/a", test.ImportPath)
+	assert.Len(test.Vars, 1)
+	assert.Equal([]string{" NewTestSquare", "Square Perimeter"}, funcNames(test))
+	if assert.Len(test.Types, 1) {
+		assert.Equal("Fake", test.Types[0].Name)
+	}
+
+	xtest := pkg.TestAPI[1]
+	assert.Equal("a_test", xtest.Name)
+	assert.Equal("example.com/m/a_test", xtest.ImportPath)
+	assert.Equal([]string{" Check"}, funcNames(xtest))
+
+	recorder := httptest.NewRecorder()
+	corpus.ServeMux().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/example.com/m/a", nil))
+	body := recorder.Body.String()
+	assert.Contains(body, `<h2 id="pkg-test-api">Test API</h2>`)
+	assert.Contains(body, `<h3 id="pkg-test-api-a_test">package a_test</h3>`)
+	assert.Contains(body, `<h4>func (Square) Perimeter</h4>`)
+	assert.Contains(body, `<pre>func Check(t *testing.T)</pre>`)
+	assert.NotContains(body, "func TestSquare")
+}
@@ -0,0 +1,33 @@
+package document_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeModule writes files to a new temporary directory and return the
+// directory, the files are keyed by their slash-separated relative paths
+func writeModule(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	root := t.TempDir()
+	writeFiles(t, root, files)
+	return root
+}
+
+// writeFiles writes files to the directory root, creating their parent
+// directories, the files are keyed by their slash-separated relative paths
+func writeFiles(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		filename := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
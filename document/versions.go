@@ -5,44 +5,56 @@ package document
 
 import (
 	"bufio"
+	"go/ast"
 	"go/build"
+	"go/parser"
+	"go/token"
 	"log"
 	"os"
+	pathpkg "path"
 	"path/filepath"
+	"sort"
 	"strings"
 	"unicode"
+
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/semver"
 )
 
 // apiVersions is a map of packages to information about those packages'
 // symbols and when they were added to Go.
 //
 // Only things added after Go1 are tracked. Version strings are of the
-// form "1.1", "1.2", etc.
+// form "go1.1", "go1.2", etc.
 type apiVersions map[string]pkgAPIVersions // keyed by Go package ("net/http")
 
 // pkgAPIVersions contains information about which version of Go added
 // certain package symbols.
 //
 // Only things added after Go1 are tracked. Version strings are of the
-// form "1.1", "1.2", etc.
+// form "go1.1", "go1.2", etc.
 type pkgAPIVersions struct {
-	typeSince   map[string]string            // "Server" -> "1.7"
-	methodSince map[string]map[string]string // "*Server" ->"Shutdown"->1.8
-	funcSince   map[string]string            // "NewServer" -> "1.7"
-	fieldSince  map[string]map[string]string // "ClientTrace" -> "Got1xxResponse" -> "1.11"
+	typeSince   map[string]string            // "Server" -> "go1.7"
+	methodSince map[string]map[string]string // "*Server" ->"Shutdown"->go1.8
+	funcSince   map[string]string            // "NewServer" -> "go1.7"
+	fieldSince  map[string]map[string]string // "ClientTrace" -> "Got1xxResponse" -> "go1.11"
+	constSince  map[string]string            // "MaxInt" -> "go1.17"
+	varSince    map[string]string            // "ErrProcessDone" -> "go1.16"
 }
 
-// sinceVersionFunc returns a string (such as "1.7") specifying which Go
+// sinceVersionFunc returns a string (such as "go1.7") specifying which Go
 // version introduced a symbol, unless it was introduced in Go1, in
 // which case it returns the empty string.
 //
-// The kind is one of "type", "method", or "func".
+// The kind is one of "type", "method", "field", "func", "const" or "var".
 //
 // The receiver is only used for "methods" and specifies the receiver type,
-// such as "*Server".
+// such as "*Server", and for "field" and specifies the struct type.
 //
 // The name is the symbol name ("Server") and the pkg is the package
 // ("net/http").
+//
+// The versions of the corpus module are semver tags such as "v1.4.0".
 func (v apiVersions) sinceVersionFunc(kind, receiver, name, pkg string) string {
 	pv := v[pkg]
 	switch kind {
@@ -51,7 +63,17 @@ func (v apiVersions) sinceVersionFunc(kind, receiver, name, pkg string) string {
 	case "type":
 		return pv.typeSince[name]
 	case "method":
-		return pv.methodSince[receiver][name]
+		if since, ok := pv.methodSince[receiver][name]; ok {
+			return since
+		}
+		// the module methods are recorded by receiver type name
+		return pv.methodSince[strings.TrimPrefix(receiver, "*")][name]
+	case "field":
+		return pv.fieldSince[receiver][name]
+	case "const":
+		return pv.constSince[name]
+	case "var":
+		return pv.varSince[name]
 	}
 	return ""
 }
@@ -60,7 +82,7 @@ func (v apiVersions) sinceVersionFunc(kind, receiver, name, pkg string) string {
 // $GOROOT/api/go.*txt file.
 type versionedRow struct {
 	pkg        string // "net/http"
-	kind       string // "type", "func", "method", "field" TODO: "const", "var"
+	kind       string // "type", "func", "method", "field", "const", "var"
 	recv       string // for methods, the receiver type ("Server", "*Server")
 	name       string // name of type, (struct) field, func, method
 	structName string // for struct fields, the outer struct name
@@ -72,10 +94,11 @@ type versionParser struct {
 }
 
 func (vp *versionParser) parseFile(name string) error {
-	base := filepath.Base(name)
-	ver := strings.TrimPrefix(strings.TrimSuffix(base, ".txt"), "go")
-	if ver == "1" {
-		return nil
+	ver := strings.TrimSuffix(filepath.Base(name), ".txt")
+	if ver == "go1" {
+		// the Go 1 features are recorded unversioned, so that the
+		// platforms supporting them later are not versioned either
+		ver = ""
 	}
 	f, err := os.Open(name)
 	if err != nil {
@@ -89,37 +112,56 @@ func (vp *versionParser) parseFile(name string) error {
 		if !ok {
 			continue
 		}
-		if vp.res == nil {
-			vp.res = make(apiVersions)
+		vp.add(row, ver)
+	}
+	return sc.Err()
+}
+
+// add records that the API feature row appeared in version ver, unless
+// it is already recorded.
+func (vp *versionParser) add(row versionedRow, ver string) {
+	if vp.res == nil {
+		vp.res = make(apiVersions)
+	}
+	pkgi, ok := vp.res[row.pkg]
+	if !ok {
+		pkgi = pkgAPIVersions{
+			typeSince:   make(map[string]string),
+			methodSince: make(map[string]map[string]string),
+			funcSince:   make(map[string]string),
+			fieldSince:  make(map[string]map[string]string),
+			constSince:  make(map[string]string),
+			varSince:    make(map[string]string),
 		}
-		pkgi, ok := vp.res[row.pkg]
-		if !ok {
-			pkgi = pkgAPIVersions{
-				typeSince:   make(map[string]string),
-				methodSince: make(map[string]map[string]string),
-				funcSince:   make(map[string]string),
-				fieldSince:  make(map[string]map[string]string),
-			}
-			vp.res[row.pkg] = pkgi
-		}
-		switch row.kind {
-		case "func":
-			pkgi.funcSince[row.name] = ver
-		case "type":
-			pkgi.typeSince[row.name] = ver
-		case "method":
-			if _, ok := pkgi.methodSince[row.recv]; !ok {
-				pkgi.methodSince[row.recv] = make(map[string]string)
-			}
-			pkgi.methodSince[row.recv][row.name] = ver
-		case "field":
-			if _, ok := pkgi.fieldSince[row.structName]; !ok {
-				pkgi.fieldSince[row.structName] = make(map[string]string)
-			}
-			pkgi.fieldSince[row.structName][row.name] = ver
+		vp.res[row.pkg] = pkgi
+	}
+
+	setOnce := func(m map[string]string, name string) {
+		if _, ok := m[name]; !ok {
+			m[name] = ver
 		}
 	}
-	return sc.Err()
+
+	switch row.kind {
+	case "func":
+		setOnce(pkgi.funcSince, row.name)
+	case "type":
+		setOnce(pkgi.typeSince, row.name)
+	case "const":
+		setOnce(pkgi.constSince, row.name)
+	case "var":
+		setOnce(pkgi.varSince, row.name)
+	case "method":
+		if _, ok := pkgi.methodSince[row.recv]; !ok {
+			pkgi.methodSince[row.recv] = make(map[string]string)
+		}
+		setOnce(pkgi.methodSince[row.recv], row.name)
+	case "field":
+		if _, ok := pkgi.fieldSince[row.structName]; !ok {
+			pkgi.fieldSince[row.structName] = make(map[string]string)
+		}
+		setOnce(pkgi.fieldSince[row.structName], row.name)
+	}
 }
 
 func parseRow(s string) (vr versionedRow, ok bool) {
@@ -133,10 +175,17 @@ func parseRow(s string) (vr versionedRow, ok bool) {
 		return
 	}
 	vr.pkg, rest = rest[:endPkg], rest[endPkg:]
-	if !strings.HasPrefix(rest, ", ") {
+	if strings.HasPrefix(rest, " (") {
 		// If the part after the pkg name isn't ", ", then it's a OS/ARCH-dependent line of the form:
 		//   pkg syscall (darwin-amd64), const ImplementsGetwd = false
-		// We skip those for now.
+		// The feature is recorded for all platforms.
+		end := strings.Index(rest, ")")
+		if end == -1 {
+			return
+		}
+		rest = rest[end+1:]
+	}
+	if !strings.HasPrefix(rest, ", ") {
 		return
 	}
 	rest = rest[len(", "):]
@@ -154,12 +203,28 @@ func parseRow(s string) (vr versionedRow, ok bool) {
 			return vr, true
 		}
 		rest = rest[len("struct, "):]
+		if strings.HasPrefix(rest, "embedded ") {
+			// "type Client struct, embedded *Conn"
+			vr.kind = "field"
+			vr.structName = vr.name
+			vr.name = rest[len("embedded "):]
+			vr.name = vr.name[strings.LastIndexAny(vr.name, "*.")+1:]
+			return vr, true
+		}
 		if i := strings.IndexByte(rest, ' '); i != -1 {
 			vr.kind = "field"
 			vr.structName = vr.name
 			vr.name = rest[:i]
 			return vr, true
 		}
+	case strings.HasPrefix(rest, "const "), strings.HasPrefix(rest, "var "):
+		// "const MaxInt = 9223372036854775807", "const MaxInt ideal-int", "var ErrProcessDone error"
+		sp := strings.IndexByte(rest, ' ')
+		vr.kind, rest = rest[:sp], rest[sp+1:]
+		if i := strings.IndexByte(rest, ' '); i != -1 {
+			vr.name = rest[:i]
+			return vr, true
+		}
 	case strings.HasPrefix(rest, "func "):
 		vr.kind = "func"
 		rest = rest[len("func "):]
@@ -210,6 +275,12 @@ func parsePackageAPIInfo() (apiVersions, error) {
 		return nil, err
 	}
 
+	// the platform specific features may be listed by several files,
+	// parse them in release order to record the first release
+	sort.Slice(files, func(i, j int) bool {
+		return semver.Compare(goSemver(files[i]), goSemver(files[j])) < 0
+	})
+
 	vp := new(versionParser)
 	for _, f := range files {
 		if err := vp.parseFile(f); err != nil {
@@ -218,3 +289,284 @@ func parsePackageAPIInfo() (apiVersions, error) {
 	}
 	return vp.res, nil
 }
+
+// goSemver return the semantic version of a $GOROOT/api/go*.txt file,
+// "v1.21" for "go1.21.txt".
+func goSemver(name string) string {
+	return "v" + strings.TrimPrefix(strings.TrimSuffix(filepath.Base(name), ".txt"), "go")
+}
+
+// --------------------------------------------------------------------
+
+// InitModuleVersions walks the semver tags of the git repository of the
+// corpus module to discover which tag added the exported types, funcs,
+// methods, struct fields, constants and variables of the module.
+func (c *Corpus) InitModuleVersions() {
+	versions, err := moduleVersions(c.Path)
+	if err != nil {
+		log.Printf("error parsing module versions: %v", err)
+		return
+	}
+
+	if c.pkgAPIInfo == nil {
+		c.pkgAPIInfo = make(apiVersions)
+	}
+	for pkg, v := range versions {
+		c.pkgAPIInfo[pkg] = v
+	}
+}
+
+// moduleVersions return the versions of the module API at dir, the first
+// release tag where each exported identifier appeared. Like the Go 1 API
+// of the standard library, the identifiers of the first release are not
+// versioned. The tags of a module in a subdirectory are prefixed by the
+// directory, such as "sub/v1.4.0".
+func moduleVersions(dir string) (apiVersions, error) {
+
+	root, err := findModuleRoot(dir)
+	if err != nil || root == "" {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return nil, err
+	}
+	modulePath := modfile.ModulePath(data)
+
+	top, err := git(root, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return nil, err
+	}
+
+	if resolved, err := filepath.EvalSymlinks(root); err == nil {
+		root = resolved
+	}
+	rel, err := filepath.Rel(top, root)
+	if err != nil {
+		return nil, err
+	}
+	rel = filepath.ToSlash(rel)
+
+	prefix := ""
+	if rel != "." {
+		prefix = rel + "/"
+	}
+
+	tags, err := releaseTags(top, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		vp    = new(versionParser)
+		cache = map[string][]versionedRow{} // rows of the parsed files, by blob hash
+	)
+
+	for i, tag := range tags {
+		files, err := moduleFiles(top, tag, rel)
+		if err != nil {
+			return nil, err
+		}
+
+		var missing []string
+		for _, hash := range files {
+			if _, ok := cache[hash]; !ok {
+				missing = append(missing, hash)
+			}
+		}
+
+		blobs, err := gitBlobs(top, missing)
+		if err != nil {
+			return nil, err
+		}
+
+		ver := strings.TrimPrefix(tag, prefix)
+		if i == 0 {
+			ver = ""
+		}
+
+		for name, hash := range files {
+			rows, ok := cache[hash]
+			if !ok {
+				importPath := modulePath
+				if dir := pathpkg.Dir(name); dir != "." {
+					importPath += "/" + dir
+				}
+				rows = fileRows(importPath, name, blobs[hash])
+				cache[hash] = rows
+			}
+			for _, row := range rows {
+				vp.add(row, ver)
+			}
+		}
+	}
+
+	return vp.res, nil
+}
+
+// findModuleRoot return the directory of the go.mod file of dir, an empty
+// string if there is none.
+func findModuleRoot(dir string) (string, error) {
+
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		if stat, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil && !stat.IsDir() {
+			return dir, nil
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
+
+// releaseTags return the release tags with prefix in semver order,
+// the pre-releases excluded.
+func releaseTags(dir, prefix string) (tags []string, err error) {
+
+	output, err := git(dir, "tag", "--list", prefix+"v*")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tag := range strings.Fields(output) {
+		v := strings.TrimPrefix(tag, prefix)
+		if semver.IsValid(v) && semver.Prerelease(v) == "" {
+			tags = append(tags, tag)
+		}
+	}
+
+	sort.Slice(tags, func(i, j int) bool {
+		return semver.Compare(strings.TrimPrefix(tags[i], prefix), strings.TrimPrefix(tags[j], prefix)) < 0
+	})
+
+	return
+}
+
+// moduleFiles return the blob hashes of the Go source files of the module
+// in the directory rel of the git tree at tag, by module relative name.
+// The test files and the directories ignored by the go command are excluded.
+func moduleFiles(dir, tag, rel string) (map[string]string, error) {
+
+	args := []string{"ls-tree", "-r", "--full-tree", tag}
+	if rel != "." {
+		args = append(args, "--", rel)
+	}
+
+	output, err := git(dir, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string]string{}
+
+	for _, line := range strings.Split(output, "\n") {
+		// "<mode> blob <hash>\t<path>"
+		tab := strings.IndexByte(line, '\t')
+		if tab < 0 {
+			continue
+		}
+		fields := strings.Fields(line[:tab])
+		name := line[tab+1:]
+
+		if len(fields) != 3 || fields[1] != "blob" || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		if rel != "." {
+			name = strings.TrimPrefix(name, rel+"/")
+		}
+
+		ignored := false
+		for _, elem := range strings.Split(pathpkg.Dir(name), "/") {
+			if elem == "testdata" || elem == "vendor" || (strings.HasPrefix(elem, ".") && elem != ".") || strings.HasPrefix(elem, "_") {
+				ignored = true
+				break
+			}
+		}
+		if !ignored {
+			files[name] = fields[2]
+		}
+	}
+
+	return files, nil
+}
+
+// fileRows return the exported API features declared by a Go source file
+func fileRows(importPath, filename string, src []byte) (rows []versionedRow) {
+
+	file, err := parser.ParseFile(token.NewFileSet(), filename, src, parser.SkipObjectResolution)
+	if err != nil {
+		return
+	}
+
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if !ast.IsExported(decl.Name.Name) {
+				continue
+			}
+			if decl.Recv == nil || len(decl.Recv.List) == 0 {
+				rows = append(rows, versionedRow{pkg: importPath, kind: "func", name: decl.Name.Name})
+				continue
+			}
+			if recv := embeddedName(decl.Recv.List[0].Type); ast.IsExported(recv) {
+				rows = append(rows, versionedRow{pkg: importPath, kind: "method", recv: recv, name: decl.Name.Name})
+			}
+
+		case *ast.GenDecl:
+			if decl.Tok == token.CONST || decl.Tok == token.VAR {
+				for _, spec := range decl.Specs {
+					for _, name := range spec.(*ast.ValueSpec).Names {
+						if ast.IsExported(name.Name) {
+							rows = append(rows, versionedRow{pkg: importPath, kind: decl.Tok.String(), name: name.Name})
+						}
+					}
+				}
+				continue
+			}
+			if decl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range decl.Specs {
+				ts := spec.(*ast.TypeSpec)
+				if !ast.IsExported(ts.Name.Name) {
+					continue
+				}
+				rows = append(rows, versionedRow{pkg: importPath, kind: "type", name: ts.Name.Name})
+
+				switch t := ts.Type.(type) {
+				case *ast.StructType:
+					for _, f := range t.Fields.List {
+						names := f.Names
+						if len(names) == 0 {
+							names = []*ast.Ident{ast.NewIdent(embeddedName(f.Type))}
+						}
+						for _, name := range names {
+							if ast.IsExported(name.Name) {
+								rows = append(rows, versionedRow{pkg: importPath, kind: "field", structName: ts.Name.Name, name: name.Name})
+							}
+						}
+					}
+				case *ast.InterfaceType:
+					for _, f := range t.Methods.List {
+						for _, name := range f.Names {
+							if ast.IsExported(name.Name) {
+								rows = append(rows, versionedRow{pkg: importPath, kind: "method", recv: ts.Name.Name, name: name.Name})
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return
+}
@@ -0,0 +1,80 @@
+package document_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/miclle/gsd/document"
+)
+
+func TestModuleVersions(t *testing.T) {
+	assert := assert.New(t)
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not found")
+	}
+
+	root := writeModule(t, map[string]string{
+		"go.mod":     "module example.com/m\n\ngo 1.16\n",
+		"a/a.go":     "package a\n\n// T is a type.\ntype T struct{ Name string }\n",
+		"a/const.go": "package a\n\n// Min is the minimum.\nconst Min = 0\n",
+	})
+
+	release := func(tag string) {
+		for _, args := range [][]string{{"add", "-A"}, {"commit", "--quiet", "-m", tag}, {"tag", tag}} {
+			cmd := exec.Command("git", append([]string{"-c", "user.name=gsd", "-c", "user.email=gsd@example.com"}, args...)...)
+			cmd.Dir = root
+			output, err := cmd.CombinedOutput()
+			assert.Nil(err, string(output))
+		}
+	}
+
+	cmd := exec.Command("git", "init", "--quiet")
+	cmd.Dir = root
+	assert.Nil(cmd.Run())
+
+	release("v1.0.0")
+
+	writeFiles(t, root, map[string]string{
+		"a/a.go":     "package a\n\n// T is a type.\ntype T struct {\n\tName string\n\tSize int\n}\n\n// Len returns the length.\nfunc (t *T) Len() int { return 0 }\n",
+		"a/const.go": "package a\n\n// Limits.\nconst (\n\tMin = 0\n\tMax = 10\n)\n\n// Default is the default T.\nvar Default T\n",
+	})
+	release("v1.4.0")
+
+	writeFiles(t, root, map[string]string{
+		"a/new.go": "package a\n\n// New returns a T.\nfunc New() *T { return nil }\n",
+	})
+	release("v1.5.0-rc.1")
+
+	output := t.TempDir()
+
+	corpus, err := document.NewCorpus(&document.Config{Path: root, Output: output, Versions: true})
+	assert.Nil(err)
+	assert.Nil(corpus.Export())
+
+	read := func(name string) string {
+		data, err := os.ReadFile(filepath.Join(output, filepath.FromSlash(name)))
+		assert.Nil(err, name)
+		return string(data)
+	}
+
+	typ := read("example.com/m/a/T.html")
+	assert.Contains(typ, `<li id="T.Size">Size <span class="badge badge-since" title="Added in v1.4.0">since v1.4.0</span></li>`)
+	assert.Contains(typ, `<li id="T.Name">Name</li>`)
+	assert.NotContains(typ, "since v1.0.0")
+	assert.NotContains(typ, "v1.5.0")
+
+	fn := read("example.com/m/a/T.Len.html")
+	assert.Contains(fn, `since v1.4.0`)
+
+	pkg := read("example.com/m/a/index.html")
+	assert.Regexp(`Max</span> = 10 <span class="comment">// v1.4.0</span>`, pkg)
+	assert.NotRegexp(`Min</span> = 0 <span class="comment">`, pkg)
+
+	// the variables of type T are documented with T
+	assert.Regexp(`Default</span> <a href="[^"]*">T</a> <span class="comment">// v1.4.0</span>`, typ)
+}
@@ -61,18 +61,26 @@ func watch(root string, watcher *fsnotify.Watcher, names chan<- string, excludeM
 				return
 			}
 
-			stat, err := os.Stat(event.Name)
-			if err != nil {
+			if event.Op&chmodMask == 0 {
 				continue
 			}
 
-			path := normalize(event.Name, stat.IsDir())
+			if event.Op&fsnotify.Chmod == fsnotify.Chmod {
+				continue
+			}
 
-			if event.Op&chmodMask == 0 {
+			stat, err := os.Stat(event.Name)
+			if err != nil {
+				// the removed or renamed names are reported too
+				if path := normalize(event.Name, false); event.Op&(fsnotify.Remove|fsnotify.Rename) > 0 && excludeMatcher.Match(path) {
+					names <- path
+				}
 				continue
 			}
 
-			if event.Op&fsnotify.Chmod == fsnotify.Chmod {
+			path := normalize(event.Name, stat.IsDir())
+
+			if !excludeMatcher.Match(path) {
 				continue
 			}
 
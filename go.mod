@@ -1,6 +1,6 @@
 module github.com/miclle/gsd
 
-go 1.15
+go 1.22.0
 
 require (
 	github.com/fsnotify/fsnotify v1.4.9
@@ -9,7 +9,20 @@ require (
 	github.com/spf13/cobra v1.0.0
 	github.com/stretchr/testify v1.6.1
 	github.com/wellington/go-libsass v0.9.2
-	github.com/yuin/goldmark v1.2.1
-	golang.org/x/net v0.0.0-20200904194848-62affa334b73 // indirect
-	golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1
+	github.com/yuin/goldmark v1.4.13
+	golang.org/x/mod v0.23.0
+	golang.org/x/tools v0.30.0
+	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/inconshreveable/mousetrap v1.0.0 // indirect
+	github.com/pkg/errors v0.8.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/spf13/pflag v1.0.3 // indirect
+	github.com/tdewolff/parse/v2 v2.4.3 // indirect
+	golang.org/x/net v0.35.0 // indirect
+	golang.org/x/sync v0.11.0 // indirect
+	golang.org/x/sys v0.30.0 // indirect
 )
@@ -57,6 +57,14 @@ func (r *Regexp) FindAllString(s string, n int) []string {
 	return r.re().FindAllString(s, n)
 }
 
+func (r *Regexp) FindAllStringSubmatch(s string, n int) [][]string {
+	return r.re().FindAllStringSubmatch(s, n)
+}
+
+func (r *Regexp) FindAllStringSubmatchIndex(s string, n int) [][]int {
+	return r.re().FindAllStringSubmatchIndex(s, n)
+}
+
 func (r *Regexp) MatchString(s string) bool {
 	return r.re().MatchString(s)
 }
@@ -2,7 +2,13 @@
 
 function initSidebar() {
   var pathname = window.location.pathname.replace(/\/+$/, "");
+  var hash = window.location.hash;
   var current = $(".sphinxsidebar ul a").filter(function (index, a) {
+    // the links of the single-file bundle are in-page anchors
+    var href = a.getAttribute("href");
+    if (href.charAt(0) === "#") {
+      return hash === href;
+    }
     return pathname === a.pathname;
   });
   current.addClass("current");
@@ -16,10 +22,204 @@ function initSidebar() {
   offset && $sidebar.scrollTop(offset.top - 100);
 }
 
+// initStaticSearch searches the exported search index in the browser,
+// for documents served without the gsd webserver.
+function initStaticSearch() {
+  var $form = $(".search-box[data-search-index]");
+  if ($form.length === 0) {
+    return;
+  }
+
+  var $input = $form.find("input[name=q]");
+  var $dropdown = $form.find(".search-dropdown");
+  var items = null;
+
+  function load(callback) {
+    if (items) {
+      return callback();
+    }
+    var source = $form.data("search-index");
+
+    // the single-file bundle embeds the index in the page
+    if (source.charAt(0) === "#") {
+      items = JSON.parse($(source).text()).items;
+      return callback();
+    }
+
+    $.getJSON(source, function (index) {
+      items = index.items;
+      callback();
+    });
+  }
+
+  // score mirrors the ranking of the webserver search
+  var kindWeights = { package: 6, type: 5, func: 4, method: 3, const: 2, var: 2, field: 1 };
+
+  function score(item, terms) {
+    var name = item[1].toLowerCase();
+    var simpleName = name.substring(name.lastIndexOf(".") + 1);
+    var importPath = item[2].toLowerCase();
+    var doc = item[4].toLowerCase();
+    var total = 0;
+
+    for (var i = 0; i < terms.length; i++) {
+      var term = terms[i];
+      if (simpleName === term || name === term) {
+        total += 100;
+      } else if (simpleName.indexOf(term) === 0) {
+        total += 60;
+      } else if (name.indexOf(term) >= 0) {
+        total += 40;
+      } else if (importPath.indexOf(term) >= 0) {
+        total += 20;
+      } else if (doc.indexOf(term) >= 0) {
+        total += 10;
+      } else {
+        return 0;
+      }
+    }
+    return total + (kindWeights[item[0]] || 0);
+  }
+
+  function search(query) {
+    var terms = query.toLowerCase().split(/\s+/).filter(Boolean);
+    if (terms.length === 0) {
+      return [];
+    }
+
+    var results = [];
+    for (var i = 0; i < items.length; i++) {
+      var s = score(items[i], terms);
+      if (s > 0) {
+        results.push({ score: s, item: items[i] });
+      }
+    }
+
+    results.sort(function (a, b) {
+      return b.score - a.score || a.item[1].length - b.item[1].length;
+    });
+
+    return results.slice(0, 20);
+  }
+
+  function render() {
+    var results = search($input.val());
+
+    $dropdown.empty().toggleClass("show", results.length > 0);
+
+    $.each(results, function (_, result) {
+      var item = result.item;
+      var $a = $("<a>").attr("href", item[3]).attr("title", item[4]);
+      $a.append($("<span>").addClass("search-dropdown-kind").text(item[0]));
+      $a.append($("<span>").text(item[1]));
+      $a.append($("<small>").text(item[2]));
+      $dropdown.append($("<li>").append($a));
+    });
+  }
+
+  $input.on("focus input", function () {
+    load(render);
+  });
+
+  $input.on("blur", function () {
+    setTimeout(function () { $dropdown.removeClass("show"); }, 200);
+  });
+
+  // there is no search page without a webserver, go to the best match
+  $form.on("submit", function (event) {
+    event.preventDefault();
+    var $first = $dropdown.find("a").first();
+    if ($first.length > 0) {
+      window.location.href = $first.attr("href");
+    }
+  });
+}
+
+// initLiveReload reloads the page after the webserver reparsed the
+// package of the page, and shows the reparse errors in a banner.
+function initLiveReload() {
+  var url = $("body").data("live-reload");
+  if (!url || !window.EventSource) {
+    return;
+  }
+
+  var importPath = $("body").data("import-path");
+  var $banner = $("#reload-error");
+  var source = new EventSource(url);
+
+  source.addEventListener("updated", function (e) {
+    var event = JSON.parse(e.data);
+    var packages = event.packages || [];
+
+    $banner.addClass("d-none").text("");
+
+    // pages without package, e.g. readme and search, may show anything
+    if (!importPath || packages.indexOf(importPath) >= 0) {
+      window.location.reload();
+    }
+  });
+
+  source.addEventListener("error", function (e) {
+    // connection errors have no data, EventSource reconnects by itself
+    if (!e.data) {
+      return;
+    }
+    var event = JSON.parse(e.data);
+    $banner.removeClass("d-none").text("Reparse failed: " + event.error);
+  });
+}
+
+// initSourceHighlight highlights the "h" query parameter in the source
+// pages of the exported documents, as the webserver does.
+function initSourceHighlight() {
+  var $source = $("pre.source[data-highlight-query]");
+  var match = /[?&]h=([^&#]*)/.exec(window.location.search);
+  if (!$source.length || !match) {
+    return;
+  }
+
+  var query = decodeURIComponent(match[1].replace(/\+/g, " "));
+  if (!query) {
+    return;
+  }
+
+  var walker = document.createTreeWalker($source[0], NodeFilter.SHOW_TEXT);
+  var nodes = [];
+  while (walker.nextNode()) {
+    if (!$(walker.currentNode.parentNode).hasClass("ln")) {
+      nodes.push(walker.currentNode);
+    }
+  }
+
+  $.each(nodes, function (_, node) {
+    var text = node.nodeValue;
+    var i = text.indexOf(query);
+    if (i < 0) {
+      return;
+    }
+
+    var fragment = document.createDocumentFragment();
+    var last = 0;
+    for (; i >= 0; i = text.indexOf(query, last)) {
+      fragment.appendChild(document.createTextNode(text.slice(last, i)));
+      $("<span class=\"highlight\">").text(query).appendTo(fragment);
+      last = i + query.length;
+    }
+    fragment.appendChild(document.createTextNode(text.slice(last)));
+    node.parentNode.replaceChild(fragment, node);
+  });
+}
+
 (function () {
 
   initSidebar();
 
+  initSourceHighlight();
+
+  initLiveReload();
+
+  initStaticSearch();
+
   // bootstrap
   $('[data-toggle="tooltip"]').tooltip()
 
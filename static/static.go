@@ -13,25 +13,37 @@ var Files = map[string]string{
 
 	"bootstrap.min.js": "/*!\x0a\x20\x20*\x20Bootstrap\x20v4.5.2\x20(https://getbootstrap.com/)\x0a\x20\x20*\x20Copyright\x202011-2020\x20The\x20Bootstrap\x20Authors\x20(https://github.com/twbs/bootstrap/graphs/contributors)\x0a\x20\x20*\x20Licensed\x20under\x20MIT\x20(https://github.com/twbs/bootstrap/blob/main/LICENSE)\x0a\x20\x20*/\x0a!function(t,e){\"object\"==typeof\x20exports&&\"undefined\"!=typeof\x20module?e(exports,require(\"jquery\"),require(\"popper.js\")):\"function\"==typeof\x20define&&define.amd?define([\"exports\",\"jquery\",\"popper.js\"],e):e((t=\"undefined\"!=typeof\x20globalThis?globalThis:t||self).bootstrap={},t.jQuery,t.Popper)}(this,(function(t,e,n){\"use\x20strict\";function\x20i(t,e){for(var\x20n=0;n<e.length;n++){var\x20i=e[n];i.enumerable=i.enumerable||!1,i.configurable=!0,\"value\"in\x20i&&(i.writable=!0),Object.defineProperty(t,i.key,i)}}function\x20o(t,e,n){return\x20e&&i(t.prototype,e),n&&i(t,n),t}function\x20s(){return(s=Object.assign||function(t){for(var\x20e=1;e<arguments.length;e++){var\x20n=arguments[e];for(var\x20i\x20in\x20n)Object.prototype.hasOwnProperty.call(n,i)&&(t[i]=n[i])}return\x20t}).apply(this,arguments)}e=e&&Object.prototype.hasOwnProperty.call(e,\"default\")?e.default:e,n=n&&Object.prototype.hasOwnProperty.call(n,\"default\")?n.default:n;function\x20r(t){var\x20n=this,i=!1;return\x20e(this).one(a.TRANSITION_END,(function(){i=!0})),setTimeout((function(){i||a.triggerTransitionEnd(n)}),t),this}var\x20a={TRANSITION_END:\"bsTransitionEnd\",getUID:function(t){do{t+=~~(1e6*Math.random())}while(document.getElementById(t));return\x20t},getSelectorFromElement:function(t){var\x20e=t.getAttribute(\"data-target\");if(!e||\"#\"===e){var\x20n=t.getAttribute(\"href\");e=n&&\"#\"!==n?n.trim():\"\"}try{return\x20document.querySelector(e)?e:null}catch(t){return\x20null}},getTransitionDurationFromElement:function(t){if(!t)return\x200;var\x20n=e(t).css(\"transition-duration\"),i=e(t).css(\"transition-delay\"),o=parseFloat(n),s=parseFloat(i);return\x20o||s?(n=n.split(\",\")[0],i=i.split(\",\")[0],1e3*(parseFloat(n)+parseFloat(i))):0},reflow:function(t){return\x20t.offsetHeight},triggerTransitionEnd:function(t){e(t).trigger(\"transitionend\")},supportsTransitionEnd:function(){return\x20Boolean(\"transitionend\")},isElement:function(t){return(t[0]||t).nodeType},typeCheckConfig:function(t,e,n){for(var\x20i\x20in\x20n)if(Object.prototype.hasOwnProperty.call(n,i)){var\x20o=n[i],s=e[i],r=s&&a.isElement(s)?\"element\":null===(l=s)||\"undefined\"==typeof\x20l?\"\"+l:{}.toString.call(l).match(/\\s([a-z]+)/i)[1].toLowerCase();if(!new\x20RegExp(o).test(r))throw\x20new\x20Error(t.toUpperCase()+':\x20Option\x20\"'+i+'\"\x20provided\x20type\x20\"'+r+'\"\x20but\x20expected\x20type\x20\"'+o+'\".')}var\x20l},findShadowRoot:function(t){if(!document.documentElement.attachShadow)return\x20null;if(\"function\"==typeof\x20t.getRootNode){var\x20e=t.getRootNode();return\x20e\x20instanceof\x20ShadowRoot?e:null}return\x20t\x20instanceof\x20ShadowRoot?t:t.parentNode?a.findShadowRoot(t.parentNode):null},jQueryDetection:function(){if(\"undefined\"==typeof\x20e)throw\x20new\x20TypeError(\"Bootstrap's\x20JavaScript\x20requires\x20jQuery.\x20jQuery\x20must\x20be\x20included\x20before\x20Bootstrap's\x20JavaScript.\");var\x20t=e.fn.jquery.split(\"\x20\")[0].split(\".\");if(t[0]<2&&t[1]<9||1===t[0]&&9===t[1]&&t[2]<1||t[0]>=4)throw\x20new\x20Error(\"Bootstrap's\x20JavaScript\x20requires\x20at\x20least\x20jQuery\x20v1.9.1\x20but\x20less\x20than\x20v4.0.0\")}};a.jQueryDetection(),e.fn.emulateTransitionEnd=r,e.event.special[a.TRANSITION_END]={bindType:\"transitionend\",delegateType:\"transitionend\",handle:function(t){if(e(t.target).is(this))return\x20t.handleObj.handler.apply(this,arguments)}};var\x20l=\"alert\",c=e.fn[l],h=function(){function\x20t(t){this._element=t}var\x20n=t.prototype;return\x20n.close=function(t){var\x20e=this._element;t&&(e=this._getRootElement(t)),this._triggerCloseEvent(e).isDefaultPrevented()||this._removeElement(e)},n.dispose=function(){e.removeData(this._element,\"bs.alert\"),this._element=null},n._getRootElement=function(t){var\x20n=a.getSelectorFromElement(t),i=!1;return\x20n&&(i=document.querySelector(n)),i||(i=e(t).closest(\".alert\")[0]),i},n._triggerCloseEvent=function(t){var\x20n=e.Event(\"close.bs.alert\");return\x20e(t).trigger(n),n},n._removeElement=function(t){var\x20n=this;if(e(t).removeClass(\"show\"),e(t).hasClass(\"fade\")){var\x20i=a.getTransitionDurationFromElement(t);e(t).one(a.TRANSITION_END,(function(e){return\x20n._destroyElement(t,e)})).emulateTransitionEnd(i)}else\x20this._destroyElement(t)},n._destroyElement=function(t){e(t).detach().trigger(\"closed.bs.alert\").remove()},t._jQueryInterface=function(n){return\x20this.each((function(){var\x20i=e(this),o=i.data(\"bs.alert\");o||(o=new\x20t(this),i.data(\"bs.alert\",o)),\"close\"===n&&o[n](this)}))},t._handleDismiss=function(t){return\x20function(e){e&&e.preventDefault(),t.close(this)}},o(t,null,[{key:\"VERSION\",get:function(){return\"4.5.2\"}}]),t}();e(document).on(\"click.bs.alert.data-api\",'[data-dismiss=\"alert\"]',h._handleDismiss(new\x20h)),e.fn[l]=h._jQueryInterface,e.fn[l].Constructor=h,e.fn[l].noConflict=function(){return\x20e.fn[l]=c,h._jQueryInterface};var\x20u=e.fn.button,d=function(){function\x20t(t){this._element=t}var\x20n=t.prototype;return\x20n.toggle=function(){var\x20t=!0,n=!0,i=e(this._element).closest('[data-toggle=\"buttons\"]')[0];if(i){var\x20o=this._element.querySelector('input:not([type=\"hidden\"])');if(o){if(\"radio\"===o.type)if(o.checked&&this._element.classList.contains(\"active\"))t=!1;else{var\x20s=i.querySelector(\".active\");s&&e(s).removeClass(\"active\")}t&&(\"checkbox\"!==o.type&&\"radio\"!==o.type||(o.checked=!this._element.classList.contains(\"active\")),e(o).trigger(\"change\")),o.focus(),n=!1}}this._element.hasAttribute(\"disabled\")||this._element.classList.contains(\"disabled\")||(n&&this._element.setAttribute(\"aria-pressed\",!this._element.classList.contains(\"active\")),t&&e(this._element).toggleClass(\"active\"))},n.dispose=function(){e.removeData(this._element,\"bs.button\"),this._element=null},t._jQueryInterface=function(n){return\x20this.each((function(){var\x20i=e(this).data(\"bs.button\");i||(i=new\x20t(this),e(this).data(\"bs.button\",i)),\"toggle\"===n&&i[n]()}))},o(t,null,[{key:\"VERSION\",get:function(){return\"4.5.2\"}}]),t}();e(document).on(\"click.bs.button.data-api\",'[data-toggle^=\"button\"]',(function(t){var\x20n=t.target,i=n;if(e(n).hasClass(\"btn\")||(n=e(n).closest(\".btn\")[0]),!n||n.hasAttribute(\"disabled\")||n.classList.contains(\"disabled\"))t.preventDefault();else{var\x20o=n.querySelector('input:not([type=\"hidden\"])');if(o&&(o.hasAttribute(\"disabled\")||o.classList.contains(\"disabled\")))return\x20void\x20t.preventDefault();(\"LABEL\"!==i.tagName||o&&\"checkbox\"!==o.type)&&d._jQueryInterface.call(e(n),\"toggle\")}})).on(\"focus.bs.button.data-api\x20blur.bs.button.data-api\",'[data-toggle^=\"button\"]',(function(t){var\x20n=e(t.target).closest(\".btn\")[0];e(n).toggleClass(\"focus\",/^focus(in)?$/.test(t.type))})),e(window).on(\"load.bs.button.data-api\",(function(){for(var\x20t=[].slice.call(document.querySelectorAll('[data-toggle=\"buttons\"]\x20.btn')),e=0,n=t.length;e<n;e++){var\x20i=t[e],o=i.querySelector('input:not([type=\"hidden\"])');o.checked||o.hasAttribute(\"checked\")?i.classList.add(\"active\"):i.classList.remove(\"active\")}for(var\x20s=0,r=(t=[].slice.call(document.querySelectorAll('[data-toggle=\"button\"]'))).length;s<r;s++){var\x20a=t[s];\"true\"===a.getAttribute(\"aria-pressed\")?a.classList.add(\"active\"):a.classList.remove(\"active\")}})),e.fn.button=d._jQueryInterface,e.fn.button.Constructor=d,e.fn.button.noConflict=function(){return\x20e.fn.button=u,d._jQueryInterface};var\x20f=\"carousel\",g=\".bs.carousel\",m=e.fn[f],p={interval:5e3,keyboard:!0,slide:!1,pause:\"hover\",wrap:!0,touch:!0},_={interval:\"(number|boolean)\",keyboard:\"boolean\",slide:\"(boolean|string)\",pause:\"(string|boolean)\",wrap:\"boolean\",touch:\"boolean\"},v={TOUCH:\"touch\",PEN:\"pen\"},b=function(){function\x20t(t,e){this._items=null,this._interval=null,this._activeElement=null,this._isPaused=!1,this._isSliding=!1,this.touchTimeout=null,this.touchStartX=0,this.touchDeltaX=0,this._config=this._getConfig(e),this._element=t,this._indicatorsElement=this._element.querySelector(\".carousel-indicators\"),this._touchSupported=\"ontouchstart\"in\x20document.documentElement||navigator.maxTouchPoints>0,this._pointerEvent=Boolean(window.PointerEvent||window.MSPointerEvent),this._addEventListeners()}var\x20n=t.prototype;return\x20n.next=function(){this._isSliding||this._slide(\"next\")},n.nextWhenVisible=function(){!document.hidden&&e(this._element).is(\":visible\")&&\"hidden\"!==e(this._element).css(\"visibility\")&&this.next()},n.prev=function(){this._isSliding||this._slide(\"prev\")},n.pause=function(t){t||(this._isPaused=!0),this._element.querySelector(\".carousel-item-next,\x20.carousel-item-prev\")&&(a.triggerTransitionEnd(this._element),this.cycle(!0)),clearInterval(this._interval),this._interval=null},n.cycle=function(t){t||(this._isPaused=!1),this._interval&&(clearInterval(this._interval),this._interval=null),this._config.interval&&!this._isPaused&&(this._interval=setInterval((document.visibilityState?this.nextWhenVisible:this.next).bind(this),this._config.interval))},n.to=function(t){var\x20n=this;this._activeElement=this._element.querySelector(\".active.carousel-item\");var\x20i=this._getItemIndex(this._activeElement);if(!(t>this._items.length-1||t<0))if(this._isSliding)e(this._element).one(\"slid.bs.carousel\",(function(){return\x20n.to(t)}));else{if(i===t)return\x20this.pause(),void\x20this.cycle();var\x20o=t>i?\"next\":\"prev\";this._slide(o,this._items[t])}},n.dispose=function(){e(this._element).off(g),e.removeData(this._element,\"bs.carousel\"),this._items=null,this._config=null,this._element=null,this._interval=null,this._isPaused=null,this._isSliding=null,this._activeElement=null,this._indicatorsElement=null},n._getConfig=function(t){return\x20t=s({},p,t),a.typeCheckConfig(f,t,_),t},n._handleSwipe=function(){var\x20t=Math.abs(this.touchDeltaX);if(!(t<=40)){var\x20e=t/this.touchDeltaX;this.touchDeltaX=0,e>0&&this.prev(),e<0&&this.next()}},n._addEventListeners=function(){var\x20t=this;this._config.keyboard&&e(this._element).on(\"keydown.bs.carousel\",(function(e){return\x20t._keydown(e)})),\"hover\"===this._config.pause&&e(this._element).on(\"mouseenter.bs.carousel\",(function(e){return\x20t.pause(e)})).on(\"mouseleave.bs.carousel\",(function(e){return\x20t.cycle(e)})),this._config.touch&&this._addTouchEventListeners()},n._addTouchEventListeners=function(){var\x20t=this;if(this._touchSupported){var\x20n=function(e){t._pointerEvent&&v[e.originalEvent.pointerType.toUpperCase()]?t.touchStartX=e.originalEvent.clientX:t._pointerEvent||(t.touchStartX=e.originalEvent.touches[0].clientX)},i=function(e){t._pointerEvent&&v[e.originalEvent.pointerType.toUpperCase()]&&(t.touchDeltaX=e.originalEvent.clientX-t.touchStartX),t._handleSwipe(),\"hover\"===t._config.pause&&(t.pause(),t.touchTimeout&&clearTimeout(t.touchTimeout),t.touchTimeout=setTimeout((function(e){return\x20t.cycle(e)}),500+t._config.interval))};e(this._element.querySelectorAll(\".carousel-item\x20img\")).on(\"dragstart.bs.carousel\",(function(t){return\x20t.preventDefault()})),this._pointerEvent?(e(this._element).on(\"pointerdown.bs.carousel\",(function(t){return\x20n(t)})),e(this._element).on(\"pointerup.bs.carousel\",(function(t){return\x20i(t)})),this._element.classList.add(\"pointer-event\")):(e(this._element).on(\"touchstart.bs.carousel\",(function(t){return\x20n(t)})),e(this._element).on(\"touchmove.bs.carousel\",(function(e){return\x20function(e){e.originalEvent.touches&&e.originalEvent.touches.length>1?t.touchDeltaX=0:t.touchDeltaX=e.originalEvent.touches[0].clientX-t.touchStartX}(e)})),e(this._element).on(\"touchend.bs.carousel\",(function(t){return\x20i(t)})))}},n._keydown=function(t){if(!/input|textarea/i.test(t.target.tagName))switch(t.which){case\x2037:t.preventDefault(),this.prev();break;case\x2039:t.preventDefault(),this.next()}},n._getItemIndex=function(t){return\x20this._items=t&&t.parentNode?[].slice.call(t.parentNode.querySelectorAll(\".carousel-item\")):[],this._items.indexOf(t)},n._getItemByDirection=function(t,e){var\x20n=\"next\"===t,i=\"prev\"===t,o=this._getItemIndex(e),s=this._items.length-1;if((i&&0===o||n&&o===s)&&!this._config.wrap)return\x20e;var\x20r=(o+(\"prev\"===t?-1:1))%this._items.length;return-1===r?this._items[this._items.length-1]:this._items[r]},n._triggerSlideEvent=function(t,n){var\x20i=this._getItemIndex(t),o=this._getItemIndex(this._element.querySelector(\".active.carousel-item\")),s=e.Event(\"slide.bs.carousel\",{relatedTarget:t,direction:n,from:o,to:i});return\x20e(this._element).trigger(s),s},n._setActiveIndicatorElement=function(t){if(this._indicatorsElement){var\x20n=[].slice.call(this._indicatorsElement.querySelectorAll(\".active\"));e(n).removeClass(\"active\");var\x20i=this._indicatorsElement.children[this._getItemIndex(t)];i&&e(i).addClass(\"active\")}},n._slide=function(t,n){var\x20i,o,s,r=this,l=this._element.querySelector(\".active.carousel-item\"),c=this._getItemIndex(l),h=n||l&&this._getItemByDirection(t,l),u=this._getItemIndex(h),d=Boolean(this._interval);if(\"next\"===t?(i=\"carousel-item-left\",o=\"carousel-item-next\",s=\"left\"):(i=\"carousel-item-right\",o=\"carousel-item-prev\",s=\"right\"),h&&e(h).hasClass(\"active\"))this._isSliding=!1;else\x20if(!this._triggerSlideEvent(h,s).isDefaultPrevented()&&l&&h){this._isSliding=!0,d&&this.pause(),this._setActiveIndicatorElement(h);var\x20f=e.Event(\"slid.bs.carousel\",{relatedTarget:h,direction:s,from:c,to:u});if(e(this._element).hasClass(\"slide\")){e(h).addClass(o),a.reflow(h),e(l).addClass(i),e(h).addClass(i);var\x20g=parseInt(h.getAttribute(\"data-interval\"),10);g?(this._config.defaultInterval=this._config.defaultInterval||this._config.interval,this._config.interval=g):this._config.interval=this._config.defaultInterval||this._config.interval;var\x20m=a.getTransitionDurationFromElement(l);e(l).one(a.TRANSITION_END,(function(){e(h).removeClass(i+\"\x20\"+o).addClass(\"active\"),e(l).removeClass(\"active\x20\"+o+\"\x20\"+i),r._isSliding=!1,setTimeout((function(){return\x20e(r._element).trigger(f)}),0)})).emulateTransitionEnd(m)}else\x20e(l).removeClass(\"active\"),e(h).addClass(\"active\"),this._isSliding=!1,e(this._element).trigger(f);d&&this.cycle()}},t._jQueryInterface=function(n){return\x20this.each((function(){var\x20i=e(this).data(\"bs.carousel\"),o=s({},p,e(this).data());\"object\"==typeof\x20n&&(o=s({},o,n));var\x20r=\"string\"==typeof\x20n?n:o.slide;if(i||(i=new\x20t(this,o),e(this).data(\"bs.carousel\",i)),\"number\"==typeof\x20n)i.to(n);else\x20if(\"string\"==typeof\x20r){if(\"undefined\"==typeof\x20i[r])throw\x20new\x20TypeError('No\x20method\x20named\x20\"'+r+'\"');i[r]()}else\x20o.interval&&o.ride&&(i.pause(),i.cycle())}))},t._dataApiClickHandler=function(n){var\x20i=a.getSelectorFromElement(this);if(i){var\x20o=e(i)[0];if(o&&e(o).hasClass(\"carousel\")){var\x20r=s({},e(o).data(),e(this).data()),l=this.getAttribute(\"data-slide-to\");l&&(r.interval=!1),t._jQueryInterface.call(e(o),r),l&&e(o).data(\"bs.carousel\").to(l),n.preventDefault()}}},o(t,null,[{key:\"VERSION\",get:function(){return\"4.5.2\"}},{key:\"Default\",get:function(){return\x20p}}]),t}();e(document).on(\"click.bs.carousel.data-api\",\"[data-slide],\x20[data-slide-to]\",b._dataApiClickHandler),e(window).on(\"load.bs.carousel.data-api\",(function(){for(var\x20t=[].slice.call(document.querySelectorAll('[data-ride=\"carousel\"]')),n=0,i=t.length;n<i;n++){var\x20o=e(t[n]);b._jQueryInterface.call(o,o.data())}})),e.fn[f]=b._jQueryInterface,e.fn[f].Constructor=b,e.fn[f].noConflict=function(){return\x20e.fn[f]=m,b._jQueryInterface};var\x20y=\"collapse\",E=e.fn[y],w={toggle:!0,parent:\"\"},T={toggle:\"boolean\",parent:\"(string|element)\"},C=function(){function\x20t(t,e){this._isTransitioning=!1,this._element=t,this._config=this._getConfig(e),this._triggerArray=[].slice.call(document.querySelectorAll('[data-toggle=\"collapse\"][href=\"#'+t.id+'\"],[data-toggle=\"collapse\"][data-target=\"#'+t.id+'\"]'));for(var\x20n=[].slice.call(document.querySelectorAll('[data-toggle=\"collapse\"]')),i=0,o=n.length;i<o;i++){var\x20s=n[i],r=a.getSelectorFromElement(s),l=[].slice.call(document.querySelectorAll(r)).filter((function(e){return\x20e===t}));null!==r&&l.length>0&&(this._selector=r,this._triggerArray.push(s))}this._parent=this._config.parent?this._getParent():null,this._config.parent||this._addAriaAndCollapsedClass(this._element,this._triggerArray),this._config.toggle&&this.toggle()}var\x20n=t.prototype;return\x20n.toggle=function(){e(this._element).hasClass(\"show\")?this.hide():this.show()},n.show=function(){var\x20n,i,o=this;if(!this._isTransitioning&&!e(this._element).hasClass(\"show\")&&(this._parent&&0===(n=[].slice.call(this._parent.querySelectorAll(\".show,\x20.collapsing\")).filter((function(t){return\"string\"==typeof\x20o._config.parent?t.getAttribute(\"data-parent\")===o._config.parent:t.classList.contains(\"collapse\")}))).length&&(n=null),!(n&&(i=e(n).not(this._selector).data(\"bs.collapse\"))&&i._isTransitioning))){var\x20s=e.Event(\"show.bs.collapse\");if(e(this._element).trigger(s),!s.isDefaultPrevented()){n&&(t._jQueryInterface.call(e(n).not(this._selector),\"hide\"),i||e(n).data(\"bs.collapse\",null));var\x20r=this._getDimension();e(this._element).removeClass(\"collapse\").addClass(\"collapsing\"),this._element.style[r]=0,this._triggerArray.length&&e(this._triggerArray).removeClass(\"collapsed\").attr(\"aria-expanded\",!0),this.setTransitioning(!0);var\x20l=\"scroll\"+(r[0].toUpperCase()+r.slice(1)),c=a.getTransitionDurationFromElement(this._element);e(this._element).one(a.TRANSITION_END,(function(){e(o._element).removeClass(\"collapsing\").addClass(\"collapse\x20show\"),o._element.style[r]=\"\",o.setTransitioning(!1),e(o._element).trigger(\"shown.bs.collapse\")})).emulateTransitionEnd(c),this._element.style[r]=this._element[l]+\"px\"}}},n.hide=function(){var\x20t=this;if(!this._isTransitioning&&e(this._element).hasClass(\"show\")){var\x20n=e.Event(\"hide.bs.collapse\");if(e(this._element).trigger(n),!n.isDefaultPrevented()){var\x20i=this._getDimension();this._element.style[i]=this._element.getBoundingClientRect()[i]+\"px\",a.reflow(this._element),e(this._element).addClass(\"collapsing\").removeClass(\"collapse\x20show\");var\x20o=this._triggerArray.length;if(o>0)for(var\x20s=0;s<o;s++){var\x20r=this._triggerArray[s],l=a.getSelectorFromElement(r);if(null!==l)e([].slice.call(document.querySelectorAll(l))).hasClass(\"show\")||e(r).addClass(\"collapsed\").attr(\"aria-expanded\",!1)}this.setTransitioning(!0);this._element.style[i]=\"\";var\x20c=a.getTransitionDurationFromElement(this._element);e(this._element).one(a.TRANSITION_END,(function(){t.setTransitioning(!1),e(t._element).removeClass(\"collapsing\").addClass(\"collapse\").trigger(\"hidden.bs.collapse\")})).emulateTransitionEnd(c)}}},n.setTransitioning=function(t){this._isTransitioning=t},n.dispose=function(){e.removeData(this._element,\"bs.collapse\"),this._config=null,this._parent=null,this._element=null,this._triggerArray=null,this._isTransitioning=null},n._getConfig=function(t){return(t=s({},w,t)).toggle=Boolean(t.toggle),a.typeCheckConfig(y,t,T),t},n._getDimension=function(){return\x20e(this._element).hasClass(\"width\")?\"width\":\"height\"},n._getParent=function(){var\x20n,i=this;a.isElement(this._config.parent)?(n=this._config.parent,\"undefined\"!=typeof\x20this._config.parent.jquery&&(n=this._config.parent[0])):n=document.querySelector(this._config.parent);var\x20o='[data-toggle=\"collapse\"][data-parent=\"'+this._config.parent+'\"]',s=[].slice.call(n.querySelectorAll(o));return\x20e(s).each((function(e,n){i._addAriaAndCollapsedClass(t._getTargetFromElement(n),[n])})),n},n._addAriaAndCollapsedClass=function(t,n){var\x20i=e(t).hasClass(\"show\");n.length&&e(n).toggleClass(\"collapsed\",!i).attr(\"aria-expanded\",i)},t._getTargetFromElement=function(t){var\x20e=a.getSelectorFromElement(t);return\x20e?document.querySelector(e):null},t._jQueryInterface=function(n){return\x20this.each((function(){var\x20i=e(this),o=i.data(\"bs.collapse\"),r=s({},w,i.data(),\"object\"==typeof\x20n&&n?n:{});if(!o&&r.toggle&&\"string\"==typeof\x20n&&/show|hide/.test(n)&&(r.toggle=!1),o||(o=new\x20t(this,r),i.data(\"bs.collapse\",o)),\"string\"==typeof\x20n){if(\"undefined\"==typeof\x20o[n])throw\x20new\x20TypeError('No\x20method\x20named\x20\"'+n+'\"');o[n]()}}))},o(t,null,[{key:\"VERSION\",get:function(){return\"4.5.2\"}},{key:\"Default\",get:function(){return\x20w}}]),t}();e(document).on(\"click.bs.collapse.data-api\",'[data-toggle=\"collapse\"]',(function(t){\"A\"===t.currentTarget.tagName&&t.preventDefault();var\x20n=e(this),i=a.getSelectorFromElement(this),o=[].slice.call(document.querySelectorAll(i));e(o).each((function(){var\x20t=e(this),i=t.data(\"bs.collapse\")?\"toggle\":n.data();C._jQueryInterface.call(t,i)}))})),e.fn[y]=C._jQueryInterface,e.fn[y].Constructor=C,e.fn[y].noConflict=function(){return\x20e.fn[y]=E,C._jQueryInterface};var\x20S=\"dropdown\",k=e.fn[S],D=new\x20RegExp(\"38|40|27\"),N={offset:0,flip:!0,boundary:\"scrollParent\",reference:\"toggle\",display:\"dynamic\",popperConfig:null},A={offset:\"(number|string|function)\",flip:\"boolean\",boundary:\"(string|element)\",reference:\"(string|element)\",display:\"string\",popperConfig:\"(null|object)\"},I=function(){function\x20t(t,e){this._element=t,this._popper=null,this._config=this._getConfig(e),this._menu=this._getMenuElement(),this._inNavbar=this._detectNavbar(),this._addEventListeners()}var\x20i=t.prototype;return\x20i.toggle=function(){if(!this._element.disabled&&!e(this._element).hasClass(\"disabled\")){var\x20n=e(this._menu).hasClass(\"show\");t._clearMenus(),n||this.show(!0)}},i.show=function(i){if(void\x200===i&&(i=!1),!(this._element.disabled||e(this._element).hasClass(\"disabled\")||e(this._menu).hasClass(\"show\"))){var\x20o={relatedTarget:this._element},s=e.Event(\"show.bs.dropdown\",o),r=t._getParentFromElement(this._element);if(e(r).trigger(s),!s.isDefaultPrevented()){if(!this._inNavbar&&i){if(\"undefined\"==typeof\x20n)throw\x20new\x20TypeError(\"Bootstrap's\x20dropdowns\x20require\x20Popper.js\x20(https://popper.js.org/)\");var\x20l=this._element;\"parent\"===this._config.reference?l=r:a.isElement(this._config.reference)&&(l=this._config.reference,\"undefined\"!=typeof\x20this._config.reference.jquery&&(l=this._config.reference[0])),\"scrollParent\"!==this._config.boundary&&e(r).addClass(\"position-static\"),this._popper=new\x20n(l,this._menu,this._getPopperConfig())}\"ontouchstart\"in\x20document.documentElement&&0===e(r).closest(\".navbar-nav\").length&&e(document.body).children().on(\"mouseover\",null,e.noop),this._element.focus(),this._element.setAttribute(\"aria-expanded\",!0),e(this._menu).toggleClass(\"show\"),e(r).toggleClass(\"show\").trigger(e.Event(\"shown.bs.dropdown\",o))}}},i.hide=function(){if(!this._element.disabled&&!e(this._element).hasClass(\"disabled\")&&e(this._menu).hasClass(\"show\")){var\x20n={relatedTarget:this._element},i=e.Event(\"hide.bs.dropdown\",n),o=t._getParentFromElement(this._element);e(o).trigger(i),i.isDefaultPrevented()||(this._popper&&this._popper.destroy(),e(this._menu).toggleClass(\"show\"),e(o).toggleClass(\"show\").trigger(e.Event(\"hidden.bs.dropdown\",n)))}},i.dispose=function(){e.removeData(this._element,\"bs.dropdown\"),e(this._element).off(\".bs.dropdown\"),this._element=null,this._menu=null,null!==this._popper&&(this._popper.destroy(),this._popper=null)},i.update=function(){this._inNavbar=this._detectNavbar(),null!==this._popper&&this._popper.scheduleUpdate()},i._addEventListeners=function(){var\x20t=this;e(this._element).on(\"click.bs.dropdown\",(function(e){e.preventDefault(),e.stopPropagation(),t.toggle()}))},i._getConfig=function(t){return\x20t=s({},this.constructor.Default,e(this._element).data(),t),a.typeCheckConfig(S,t,this.constructor.DefaultType),t},i._getMenuElement=function(){if(!this._menu){var\x20e=t._getParentFromElement(this._element);e&&(this._menu=e.querySelector(\".dropdown-menu\"))}return\x20this._menu},i._getPlacement=function(){var\x20t=e(this._element.parentNode),n=\"bottom-start\";return\x20t.hasClass(\"dropup\")?n=e(this._menu).hasClass(\"dropdown-menu-right\")?\"top-end\":\"top-start\":t.hasClass(\"dropright\")?n=\"right-start\":t.hasClass(\"dropleft\")?n=\"left-start\":e(this._menu).hasClass(\"dropdown-menu-right\")&&(n=\"bottom-end\"),n},i._detectNavbar=function(){return\x20e(this._element).closest(\".navbar\").length>0},i._getOffset=function(){var\x20t=this,e={};return\"function\"==typeof\x20this._config.offset?e.fn=function(e){return\x20e.offsets=s({},e.offsets,t._config.offset(e.offsets,t._element)||{}),e}:e.offset=this._config.offset,e},i._getPopperConfig=function(){var\x20t={placement:this._getPlacement(),modifiers:{offset:this._getOffset(),flip:{enabled:this._config.flip},preventOverflow:{boundariesElement:this._config.boundary}}};return\"static\"===this._config.display&&(t.modifiers.applyStyle={enabled:!1}),s({},t,this._config.popperConfig)},t._jQueryInterface=function(n){return\x20this.each((function(){var\x20i=e(this).data(\"bs.dropdown\");if(i||(i=new\x20t(this,\"object\"==typeof\x20n?n:null),e(this).data(\"bs.dropdown\",i)),\"string\"==typeof\x20n){if(\"undefined\"==typeof\x20i[n])throw\x20new\x20TypeError('No\x20method\x20named\x20\"'+n+'\"');i[n]()}}))},t._clearMenus=function(n){if(!n||3!==n.which&&(\"keyup\"!==n.type||9===n.which))for(var\x20i=[].slice.call(document.querySelectorAll('[data-toggle=\"dropdown\"]')),o=0,s=i.length;o<s;o++){var\x20r=t._getParentFromElement(i[o]),a=e(i[o]).data(\"bs.dropdown\"),l={relatedTarget:i[o]};if(n&&\"click\"===n.type&&(l.clickEvent=n),a){var\x20c=a._menu;if(e(r).hasClass(\"show\")&&!(n&&(\"click\"===n.type&&/input|textarea/i.test(n.target.tagName)||\"keyup\"===n.type&&9===n.which)&&e.contains(r,n.target))){var\x20h=e.Event(\"hide.bs.dropdown\",l);e(r).trigger(h),h.isDefaultPrevented()||(\"ontouchstart\"in\x20document.documentElement&&e(document.body).children().off(\"mouseover\",null,e.noop),i[o].setAttribute(\"aria-expanded\",\"false\"),a._popper&&a._popper.destroy(),e(c).removeClass(\"show\"),e(r).removeClass(\"show\").trigger(e.Event(\"hidden.bs.dropdown\",l)))}}}},t._getParentFromElement=function(t){var\x20e,n=a.getSelectorFromElement(t);return\x20n&&(e=document.querySelector(n)),e||t.parentNode},t._dataApiKeydownHandler=function(n){if(!(/input|textarea/i.test(n.target.tagName)?32===n.which||27!==n.which&&(40!==n.which&&38!==n.which||e(n.target).closest(\".dropdown-menu\").length):!D.test(n.which))&&!this.disabled&&!e(this).hasClass(\"disabled\")){var\x20i=t._getParentFromElement(this),o=e(i).hasClass(\"show\");if(o||27!==n.which){if(n.preventDefault(),n.stopPropagation(),!o||o&&(27===n.which||32===n.which))return\x2027===n.which&&e(i.querySelector('[data-toggle=\"dropdown\"]')).trigger(\"focus\"),void\x20e(this).trigger(\"click\");var\x20s=[].slice.call(i.querySelectorAll(\".dropdown-menu\x20.dropdown-item:not(.disabled):not(:disabled)\")).filter((function(t){return\x20e(t).is(\":visible\")}));if(0!==s.length){var\x20r=s.indexOf(n.target);38===n.which&&r>0&&r--,40===n.which&&r<s.length-1&&r++,r<0&&(r=0),s[r].focus()}}}},o(t,null,[{key:\"VERSION\",get:function(){return\"4.5.2\"}},{key:\"Default\",get:function(){return\x20N}},{key:\"DefaultType\",get:function(){return\x20A}}]),t}();e(document).on(\"keydown.bs.dropdown.data-api\",'[data-toggle=\"dropdown\"]',I._dataApiKeydownHandler).on(\"keydown.bs.dropdown.data-api\",\".dropdown-menu\",I._dataApiKeydownHandler).on(\"click.bs.dropdown.data-api\x20keyup.bs.dropdown.data-api\",I._clearMenus).on(\"click.bs.dropdown.data-api\",'[data-toggle=\"dropdown\"]',(function(t){t.preventDefault(),t.stopPropagation(),I._jQueryInterface.call(e(this),\"toggle\")})).on(\"click.bs.dropdown.data-api\",\".dropdown\x20form\",(function(t){t.stopPropagation()})),e.fn[S]=I._jQueryInterface,e.fn[S].Constructor=I,e.fn[S].noConflict=function(){return\x20e.fn[S]=k,I._jQueryInterface};var\x20O=e.fn.modal,j={backdrop:!0,keyboard:!0,focus:!0,show:!0},x={backdrop:\"(boolean|string)\",keyboard:\"boolean\",focus:\"boolean\",show:\"boolean\"},P=function(){function\x20t(t,e){this._config=this._getConfig(e),this._element=t,this._dialog=t.querySelector(\".modal-dialog\"),this._backdrop=null,this._isShown=!1,this._isBodyOverflowing=!1,this._ignoreBackdropClick=!1,this._isTransitioning=!1,this._scrollbarWidth=0}var\x20n=t.prototype;return\x20n.toggle=function(t){return\x20this._isShown?this.hide():this.show(t)},n.show=function(t){var\x20n=this;if(!this._isShown&&!this._isTransitioning){e(this._element).hasClass(\"fade\")&&(this._isTransitioning=!0);var\x20i=e.Event(\"show.bs.modal\",{relatedTarget:t});e(this._element).trigger(i),this._isShown||i.isDefaultPrevented()||(this._isShown=!0,this._checkScrollbar(),this._setScrollbar(),this._adjustDialog(),this._setEscapeEvent(),this._setResizeEvent(),e(this._element).on(\"click.dismiss.bs.modal\",'[data-dismiss=\"modal\"]',(function(t){return\x20n.hide(t)})),e(this._dialog).on(\"mousedown.dismiss.bs.modal\",(function(){e(n._element).one(\"mouseup.dismiss.bs.modal\",(function(t){e(t.target).is(n._element)&&(n._ignoreBackdropClick=!0)}))})),this._showBackdrop((function(){return\x20n._showElement(t)})))}},n.hide=function(t){var\x20n=this;if(t&&t.preventDefault(),this._isShown&&!this._isTransitioning){var\x20i=e.Event(\"hide.bs.modal\");if(e(this._element).trigger(i),this._isShown&&!i.isDefaultPrevented()){this._isShown=!1;var\x20o=e(this._element).hasClass(\"fade\");if(o&&(this._isTransitioning=!0),this._setEscapeEvent(),this._setResizeEvent(),e(document).off(\"focusin.bs.modal\"),e(this._element).removeClass(\"show\"),e(this._element).off(\"click.dismiss.bs.modal\"),e(this._dialog).off(\"mousedown.dismiss.bs.modal\"),o){var\x20s=a.getTransitionDurationFromElement(this._element);e(this._element).one(a.TRANSITION_END,(function(t){return\x20n._hideModal(t)})).emulateTransitionEnd(s)}else\x20this._hideModal()}}},n.dispose=function(){[window,this._element,this._dialog].forEach((function(t){return\x20e(t).off(\".bs.modal\")})),e(document).off(\"focusin.bs.modal\"),e.removeData(this._element,\"bs.modal\"),this._config=null,this._element=null,this._dialog=null,this._backdrop=null,this._isShown=null,this._isBodyOverflowing=null,this._ignoreBackdropClick=null,this._isTransitioning=null,this._scrollbarWidth=null},n.handleUpdate=function(){this._adjustDialog()},n._getConfig=function(t){return\x20t=s({},j,t),a.typeCheckConfig(\"modal\",t,x),t},n._triggerBackdropTransition=function(){var\x20t=this;if(\"static\"===this._config.backdrop){var\x20n=e.Event(\"hidePrevented.bs.modal\");if(e(this._element).trigger(n),n.defaultPrevented)return;var\x20i=this._element.scrollHeight>document.documentElement.clientHeight;i||(this._element.style.overflowY=\"hidden\"),this._element.classList.add(\"modal-static\");var\x20o=a.getTransitionDurationFromElement(this._dialog);e(this._element).off(a.TRANSITION_END),e(this._element).one(a.TRANSITION_END,(function(){t._element.classList.remove(\"modal-static\"),i||e(t._element).one(a.TRANSITION_END,(function(){t._element.style.overflowY=\"\"})).emulateTransitionEnd(t._element,o)})).emulateTransitionEnd(o),this._element.focus()}else\x20this.hide()},n._showElement=function(t){var\x20n=this,i=e(this._element).hasClass(\"fade\"),o=this._dialog?this._dialog.querySelector(\".modal-body\"):null;this._element.parentNode&&this._element.parentNode.nodeType===Node.ELEMENT_NODE||document.body.appendChild(this._element),this._element.style.display=\"block\",this._element.removeAttribute(\"aria-hidden\"),this._element.setAttribute(\"aria-modal\",!0),this._element.setAttribute(\"role\",\"dialog\"),e(this._dialog).hasClass(\"modal-dialog-scrollable\")&&o?o.scrollTop=0:this._element.scrollTop=0,i&&a.reflow(this._element),e(this._element).addClass(\"show\"),this._config.focus&&this._enforceFocus();var\x20s=e.Event(\"shown.bs.modal\",{relatedTarget:t}),r=function(){n._config.focus&&n._element.focus(),n._isTransitioning=!1,e(n._element).trigger(s)};if(i){var\x20l=a.getTransitionDurationFromElement(this._dialog);e(this._dialog).one(a.TRANSITION_END,r).emulateTransitionEnd(l)}else\x20r()},n._enforceFocus=function(){var\x20t=this;e(document).off(\"focusin.bs.modal\").on(\"focusin.bs.modal\",(function(n){document!==n.target&&t._element!==n.target&&0===e(t._element).has(n.target).length&&t._element.focus()}))},n._setEscapeEvent=function(){var\x20t=this;this._isShown?e(this._element).on(\"keydown.dismiss.bs.modal\",(function(e){t._config.keyboard&&27===e.which?(e.preventDefault(),t.hide()):t._config.keyboard||27!==e.which||t._triggerBackdropTransition()})):this._isShown||e(this._element).off(\"keydown.dismiss.bs.modal\")},n._setResizeEvent=function(){var\x20t=this;this._isShown?e(window).on(\"resize.bs.modal\",(function(e){return\x20t.handleUpdate(e)})):e(window).off(\"resize.bs.modal\")},n._hideModal=function(){var\x20t=this;this._element.style.display=\"none\",this._element.setAttribute(\"aria-hidden\",!0),this._element.removeAttribute(\"aria-modal\"),this._element.removeAttribute(\"role\"),this._isTransitioning=!1,this._showBackdrop((function(){e(document.body).removeClass(\"modal-open\"),t._resetAdjustments(),t._resetScrollbar(),e(t._element).trigger(\"hidden.bs.modal\")}))},n._removeBackdrop=function(){this._backdrop&&(e(this._backdrop).remove(),this._backdrop=null)},n._showBackdrop=function(t){var\x20n=this,i=e(this._element).hasClass(\"fade\")?\"fade\":\"\";if(this._isShown&&this._config.backdrop){if(this._backdrop=document.createElement(\"div\"),this._backdrop.className=\"modal-backdrop\",i&&this._backdrop.classList.add(i),e(this._backdrop).appendTo(document.body),e(this._element).on(\"click.dismiss.bs.modal\",(function(t){n._ignoreBackdropClick?n._ignoreBackdropClick=!1:t.target===t.currentTarget&&n._triggerBackdropTransition()})),i&&a.reflow(this._backdrop),e(this._backdrop).addClass(\"show\"),!t)return;if(!i)return\x20void\x20t();var\x20o=a.getTransitionDurationFromElement(this._backdrop);e(this._backdrop).one(a.TRANSITION_END,t).emulateTransitionEnd(o)}else\x20if(!this._isShown&&this._backdrop){e(this._backdrop).removeClass(\"show\");var\x20s=function(){n._removeBackdrop(),t&&t()};if(e(this._element).hasClass(\"fade\")){var\x20r=a.getTransitionDurationFromElement(this._backdrop);e(this._backdrop).one(a.TRANSITION_END,s).emulateTransitionEnd(r)}else\x20s()}else\x20t&&t()},n._adjustDialog=function(){var\x20t=this._element.scrollHeight>document.documentElement.clientHeight;!this._isBodyOverflowing&&t&&(this._element.style.paddingLeft=this._scrollbarWidth+\"px\"),this._isBodyOverflowing&&!t&&(this._element.style.paddingRight=this._scrollbarWidth+\"px\")},n._resetAdjustments=function(){this._element.style.paddingLeft=\"\",this._element.style.paddingRight=\"\"},n._checkScrollbar=function(){var\x20t=document.body.getBoundingClientRect();this._isBodyOverflowing=Math.round(t.left+t.right)<window.innerWidth,this._scrollbarWidth=this._getScrollbarWidth()},n._setScrollbar=function(){var\x20t=this;if(this._isBodyOverflowing){var\x20n=[].slice.call(document.querySelectorAll(\".fixed-top,\x20.fixed-bottom,\x20.is-fixed,\x20.sticky-top\")),i=[].slice.call(document.querySelectorAll(\".sticky-top\"));e(n).each((function(n,i){var\x20o=i.style.paddingRight,s=e(i).css(\"padding-right\");e(i).data(\"padding-right\",o).css(\"padding-right\",parseFloat(s)+t._scrollbarWidth+\"px\")})),e(i).each((function(n,i){var\x20o=i.style.marginRight,s=e(i).css(\"margin-right\");e(i).data(\"margin-right\",o).css(\"margin-right\",parseFloat(s)-t._scrollbarWidth+\"px\")}));var\x20o=document.body.style.paddingRight,s=e(document.body).css(\"padding-right\");e(document.body).data(\"padding-right\",o).css(\"padding-right\",parseFloat(s)+this._scrollbarWidth+\"px\")}e(document.body).addClass(\"modal-open\")},n._resetScrollbar=function(){var\x20t=[].slice.call(document.querySelectorAll(\".fixed-top,\x20.fixed-bottom,\x20.is-fixed,\x20.sticky-top\"));e(t).each((function(t,n){var\x20i=e(n).data(\"padding-right\");e(n).removeData(\"padding-right\"),n.style.paddingRight=i||\"\"}));var\x20n=[].slice.call(document.querySelectorAll(\".sticky-top\"));e(n).each((function(t,n){var\x20i=e(n).data(\"margin-right\");\"undefined\"!=typeof\x20i&&e(n).css(\"margin-right\",i).removeData(\"margin-right\")}));var\x20i=e(document.body).data(\"padding-right\");e(document.body).removeData(\"padding-right\"),document.body.style.paddingRight=i||\"\"},n._getScrollbarWidth=function(){var\x20t=document.createElement(\"div\");t.className=\"modal-scrollbar-measure\",document.body.appendChild(t);var\x20e=t.getBoundingClientRect().width-t.clientWidth;return\x20document.body.removeChild(t),e},t._jQueryInterface=function(n,i){return\x20this.each((function(){var\x20o=e(this).data(\"bs.modal\"),r=s({},j,e(this).data(),\"object\"==typeof\x20n&&n?n:{});if(o||(o=new\x20t(this,r),e(this).data(\"bs.modal\",o)),\"string\"==typeof\x20n){if(\"undefined\"==typeof\x20o[n])throw\x20new\x20TypeError('No\x20method\x20named\x20\"'+n+'\"');o[n](i)}else\x20r.show&&o.show(i)}))},o(t,null,[{key:\"VERSION\",get:function(){return\"4.5.2\"}},{key:\"Default\",get:function(){return\x20j}}]),t}();e(document).on(\"click.bs.modal.data-api\",'[data-toggle=\"modal\"]',(function(t){var\x20n,i=this,o=a.getSelectorFromElement(this);o&&(n=document.querySelector(o));var\x20r=e(n).data(\"bs.modal\")?\"toggle\":s({},e(n).data(),e(this).data());\"A\"!==this.tagName&&\"AREA\"!==this.tagName||t.preventDefault();var\x20l=e(n).one(\"show.bs.modal\",(function(t){t.isDefaultPrevented()||l.one(\"hidden.bs.modal\",(function(){e(i).is(\":visible\")&&i.focus()}))}));P._jQueryInterface.call(e(n),r,this)})),e.fn.modal=P._jQueryInterface,e.fn.modal.Constructor=P,e.fn.modal.noConflict=function(){return\x20e.fn.modal=O,P._jQueryInterface};var\x20R=[\"background\",\"cite\",\"href\",\"itemtype\",\"longdesc\",\"poster\",\"src\",\"xlink:href\"],L={\"*\":[\"class\",\"dir\",\"id\",\"lang\",\"role\",/^aria-[\\w-]*$/i],a:[\"target\",\"href\",\"title\",\"rel\"],area:[],b:[],br:[],col:[],code:[],div:[],em:[],hr:[],h1:[],h2:[],h3:[],h4:[],h5:[],h6:[],i:[],img:[\"src\",\"srcset\",\"alt\",\"title\",\"width\",\"height\"],li:[],ol:[],p:[],pre:[],s:[],small:[],span:[],sub:[],sup:[],strong:[],u:[],ul:[]},q=/^(?:(?:https?|mailto|ftp|tel|file):|[^#&/:?]*(?:[#/?]|$))/gi,F=/^data:(?:image\\/(?:bmp|gif|jpeg|jpg|png|tiff|webp)|video\\/(?:mpeg|mp4|ogg|webm)|audio\\/(?:mp3|oga|ogg|opus));base64,[\\d+/a-z]+=*$/i;function\x20Q(t,e,n){if(0===t.length)return\x20t;if(n&&\"function\"==typeof\x20n)return\x20n(t);for(var\x20i=(new\x20window.DOMParser).parseFromString(t,\"text/html\"),o=Object.keys(e),s=[].slice.call(i.body.querySelectorAll(\"*\")),r=function(t,n){var\x20i=s[t],r=i.nodeName.toLowerCase();if(-1===o.indexOf(i.nodeName.toLowerCase()))return\x20i.parentNode.removeChild(i),\"continue\";var\x20a=[].slice.call(i.attributes),l=[].concat(e[\"*\"]||[],e[r]||[]);a.forEach((function(t){(function(t,e){var\x20n=t.nodeName.toLowerCase();if(-1!==e.indexOf(n))return-1===R.indexOf(n)||Boolean(t.nodeValue.match(q)||t.nodeValue.match(F));for(var\x20i=e.filter((function(t){return\x20t\x20instanceof\x20RegExp})),o=0,s=i.length;o<s;o++)if(n.match(i[o]))return!0;return!1})(t,l)||i.removeAttribute(t.nodeName)}))},a=0,l=s.length;a<l;a++)r(a);return\x20i.body.innerHTML}var\x20B=\"tooltip\",H=e.fn[B],U=new\x20RegExp(\"(^|\\\\s)bs-tooltip\\\\S+\",\"g\"),M=[\"sanitize\",\"whiteList\",\"sanitizeFn\"],W={animation:\"boolean\",template:\"string\",title:\"(string|element|function)\",trigger:\"string\",delay:\"(number|object)\",html:\"boolean\",selector:\"(string|boolean)\",placement:\"(string|function)\",offset:\"(number|string|function)\",container:\"(string|element|boolean)\",fallbackPlacement:\"(string|array)\",boundary:\"(string|element)\",sanitize:\"boolean\",sanitizeFn:\"(null|function)\",whiteList:\"object\",popperConfig:\"(null|object)\"},V={AUTO:\"auto\",TOP:\"top\",RIGHT:\"right\",BOTTOM:\"bottom\",LEFT:\"left\"},z={animation:!0,template:'<div\x20class=\"tooltip\"\x20role=\"tooltip\"><div\x20class=\"arrow\"></div><div\x20class=\"tooltip-inner\"></div></div>',trigger:\"hover\x20focus\",title:\"\",delay:0,html:!1,selector:!1,placement:\"top\",offset:0,container:!1,fallbackPlacement:\"flip\",boundary:\"scrollParent\",sanitize:!0,sanitizeFn:null,whiteList:L,popperConfig:null},K={HIDE:\"hide.bs.tooltip\",HIDDEN:\"hidden.bs.tooltip\",SHOW:\"show.bs.tooltip\",SHOWN:\"shown.bs.tooltip\",INSERTED:\"inserted.bs.tooltip\",CLICK:\"click.bs.tooltip\",FOCUSIN:\"focusin.bs.tooltip\",FOCUSOUT:\"focusout.bs.tooltip\",MOUSEENTER:\"mouseenter.bs.tooltip\",MOUSELEAVE:\"mouseleave.bs.tooltip\"},X=function(){function\x20t(t,e){if(\"undefined\"==typeof\x20n)throw\x20new\x20TypeError(\"Bootstrap's\x20tooltips\x20require\x20Popper.js\x20(https://popper.js.org/)\");this._isEnabled=!0,this._timeout=0,this._hoverState=\"\",this._activeTrigger={},this._popper=null,this.element=t,this.config=this._getConfig(e),this.tip=null,this._setListeners()}var\x20i=t.prototype;return\x20i.enable=function(){this._isEnabled=!0},i.disable=function(){this._isEnabled=!1},i.toggleEnabled=function(){this._isEnabled=!this._isEnabled},i.toggle=function(t){if(this._isEnabled)if(t){var\x20n=this.constructor.DATA_KEY,i=e(t.currentTarget).data(n);i||(i=new\x20this.constructor(t.currentTarget,this._getDelegateConfig()),e(t.currentTarget).data(n,i)),i._activeTrigger.click=!i._activeTrigger.click,i._isWithActiveTrigger()?i._enter(null,i):i._leave(null,i)}else{if(e(this.getTipElement()).hasClass(\"show\"))return\x20void\x20this._leave(null,this);this._enter(null,this)}},i.dispose=function(){clearTimeout(this._timeout),e.removeData(this.element,this.constructor.DATA_KEY),e(this.element).off(this.constructor.EVENT_KEY),e(this.element).closest(\".modal\").off(\"hide.bs.modal\",this._hideModalHandler),this.tip&&e(this.tip).remove(),this._isEnabled=null,this._timeout=null,this._hoverState=null,this._activeTrigger=null,this._popper&&this._popper.destroy(),this._popper=null,this.element=null,this.config=null,this.tip=null},i.show=function(){var\x20t=this;if(\"none\"===e(this.element).css(\"display\"))throw\x20new\x20Error(\"Please\x20use\x20show\x20on\x20visible\x20elements\");var\x20i=e.Event(this.constructor.Event.SHOW);if(this.isWithContent()&&this._isEnabled){e(this.element).trigger(i);var\x20o=a.findShadowRoot(this.element),s=e.contains(null!==o?o:this.element.ownerDocument.documentElement,this.element);if(i.isDefaultPrevented()||!s)return;var\x20r=this.getTipElement(),l=a.getUID(this.constructor.NAME);r.setAttribute(\"id\",l),this.element.setAttribute(\"aria-describedby\",l),this.setContent(),this.config.animation&&e(r).addClass(\"fade\");var\x20c=\"function\"==typeof\x20this.config.placement?this.config.placement.call(this,r,this.element):this.config.placement,h=this._getAttachment(c);this.addAttachmentClass(h);var\x20u=this._getContainer();e(r).data(this.constructor.DATA_KEY,this),e.contains(this.element.ownerDocument.documentElement,this.tip)||e(r).appendTo(u),e(this.element).trigger(this.constructor.Event.INSERTED),this._popper=new\x20n(this.element,r,this._getPopperConfig(h)),e(r).addClass(\"show\"),\"ontouchstart\"in\x20document.documentElement&&e(document.body).children().on(\"mouseover\",null,e.noop);var\x20d=function(){t.config.animation&&t._fixTransition();var\x20n=t._hoverState;t._hoverState=null,e(t.element).trigger(t.constructor.Event.SHOWN),\"out\"===n&&t._leave(null,t)};if(e(this.tip).hasClass(\"fade\")){var\x20f=a.getTransitionDurationFromElement(this.tip);e(this.tip).one(a.TRANSITION_END,d).emulateTransitionEnd(f)}else\x20d()}},i.hide=function(t){var\x20n=this,i=this.getTipElement(),o=e.Event(this.constructor.Event.HIDE),s=function(){\"show\"!==n._hoverState&&i.parentNode&&i.parentNode.removeChild(i),n._cleanTipClass(),n.element.removeAttribute(\"aria-describedby\"),e(n.element).trigger(n.constructor.Event.HIDDEN),null!==n._popper&&n._popper.destroy(),t&&t()};if(e(this.element).trigger(o),!o.isDefaultPrevented()){if(e(i).removeClass(\"show\"),\"ontouchstart\"in\x20document.documentElement&&e(document.body).children().off(\"mouseover\",null,e.noop),this._activeTrigger.click=!1,this._activeTrigger.focus=!1,this._activeTrigger.hover=!1,e(this.tip).hasClass(\"fade\")){var\x20r=a.getTransitionDurationFromElement(i);e(i).one(a.TRANSITION_END,s).emulateTransitionEnd(r)}else\x20s();this._hoverState=\"\"}},i.update=function(){null!==this._popper&&this._popper.scheduleUpdate()},i.isWithContent=function(){return\x20Boolean(this.getTitle())},i.addAttachmentClass=function(t){e(this.getTipElement()).addClass(\"bs-tooltip-\"+t)},i.getTipElement=function(){return\x20this.tip=this.tip||e(this.config.template)[0],this.tip},i.setContent=function(){var\x20t=this.getTipElement();this.setElementContent(e(t.querySelectorAll(\".tooltip-inner\")),this.getTitle()),e(t).removeClass(\"fade\x20show\")},i.setElementContent=function(t,n){\"object\"!=typeof\x20n||!n.nodeType&&!n.jquery?this.config.html?(this.config.sanitize&&(n=Q(n,this.config.whiteList,this.config.sanitizeFn)),t.html(n)):t.text(n):this.config.html?e(n).parent().is(t)||t.empty().append(n):t.text(e(n).text())},i.getTitle=function(){var\x20t=this.element.getAttribute(\"data-original-title\");return\x20t||(t=\"function\"==typeof\x20this.config.title?this.config.title.call(this.element):this.config.title),t},i._getPopperConfig=function(t){var\x20e=this;return\x20s({},{placement:t,modifiers:{offset:this._getOffset(),flip:{behavior:this.config.fallbackPlacement},arrow:{element:\".arrow\"},preventOverflow:{boundariesElement:this.config.boundary}},onCreate:function(t){t.originalPlacement!==t.placement&&e._handlePopperPlacementChange(t)},onUpdate:function(t){return\x20e._handlePopperPlacementChange(t)}},this.config.popperConfig)},i._getOffset=function(){var\x20t=this,e={};return\"function\"==typeof\x20this.config.offset?e.fn=function(e){return\x20e.offsets=s({},e.offsets,t.config.offset(e.offsets,t.element)||{}),e}:e.offset=this.config.offset,e},i._getContainer=function(){return!1===this.config.container?document.body:a.isElement(this.config.container)?e(this.config.container):e(document).find(this.config.container)},i._getAttachment=function(t){return\x20V[t.toUpperCase()]},i._setListeners=function(){var\x20t=this;this.config.trigger.split(\"\x20\").forEach((function(n){if(\"click\"===n)e(t.element).on(t.constructor.Event.CLICK,t.config.selector,(function(e){return\x20t.toggle(e)}));else\x20if(\"manual\"!==n){var\x20i=\"hover\"===n?t.constructor.Event.MOUSEENTER:t.constructor.Event.FOCUSIN,o=\"hover\"===n?t.constructor.Event.MOUSELEAVE:t.constructor.Event.FOCUSOUT;e(t.element).on(i,t.config.selector,(function(e){return\x20t._enter(e)})).on(o,t.config.selector,(function(e){return\x20t._leave(e)}))}})),this._hideModalHandler=function(){t.element&&t.hide()},e(this.element).closest(\".modal\").on(\"hide.bs.modal\",this._hideModalHandler),this.config.selector?this.config=s({},this.config,{trigger:\"manual\",selector:\"\"}):this._fixTitle()},i._fixTitle=function(){var\x20t=typeof\x20this.element.getAttribute(\"data-original-title\");(this.element.getAttribute(\"title\")||\"string\"!==t)&&(this.element.setAttribute(\"data-original-title\",this.element.getAttribute(\"title\")||\"\"),this.element.setAttribute(\"title\",\"\"))},i._enter=function(t,n){var\x20i=this.constructor.DATA_KEY;(n=n||e(t.currentTarget).data(i))||(n=new\x20this.constructor(t.currentTarget,this._getDelegateConfig()),e(t.currentTarget).data(i,n)),t&&(n._activeTrigger[\"focusin\"===t.type?\"focus\":\"hover\"]=!0),e(n.getTipElement()).hasClass(\"show\")||\"show\"===n._hoverState?n._hoverState=\"show\":(clearTimeout(n._timeout),n._hoverState=\"show\",n.config.delay&&n.config.delay.show?n._timeout=setTimeout((function(){\"show\"===n._hoverState&&n.show()}),n.config.delay.show):n.show())},i._leave=function(t,n){var\x20i=this.constructor.DATA_KEY;(n=n||e(t.currentTarget).data(i))||(n=new\x20this.constructor(t.currentTarget,this._getDelegateConfig()),e(t.currentTarget).data(i,n)),t&&(n._activeTrigger[\"focusout\"===t.type?\"focus\":\"hover\"]=!1),n._isWithActiveTrigger()||(clearTimeout(n._timeout),n._hoverState=\"out\",n.config.delay&&n.config.delay.hide?n._timeout=setTimeout((function(){\"out\"===n._hoverState&&n.hide()}),n.config.delay.hide):n.hide())},i._isWithActiveTrigger=function(){for(var\x20t\x20in\x20this._activeTrigger)if(this._activeTrigger[t])return!0;return!1},i._getConfig=function(t){var\x20n=e(this.element).data();return\x20Object.keys(n).forEach((function(t){-1!==M.indexOf(t)&&delete\x20n[t]})),\"number\"==typeof(t=s({},this.constructor.Default,n,\"object\"==typeof\x20t&&t?t:{})).delay&&(t.delay={show:t.delay,hide:t.delay}),\"number\"==typeof\x20t.title&&(t.title=t.title.toString()),\"number\"==typeof\x20t.content&&(t.content=t.content.toString()),a.typeCheckConfig(B,t,this.constructor.DefaultType),t.sanitize&&(t.template=Q(t.template,t.whiteList,t.sanitizeFn)),t},i._getDelegateConfig=function(){var\x20t={};if(this.config)for(var\x20e\x20in\x20this.config)this.constructor.Default[e]!==this.config[e]&&(t[e]=this.config[e]);return\x20t},i._cleanTipClass=function(){var\x20t=e(this.getTipElement()),n=t.attr(\"class\").match(U);null!==n&&n.length&&t.removeClass(n.join(\"\"))},i._handlePopperPlacementChange=function(t){this.tip=t.instance.popper,this._cleanTipClass(),this.addAttachmentClass(this._getAttachment(t.placement))},i._fixTransition=function(){var\x20t=this.getTipElement(),n=this.config.animation;null===t.getAttribute(\"x-placement\")&&(e(t).removeClass(\"fade\"),this.config.animation=!1,this.hide(),this.show(),this.config.animation=n)},t._jQueryInterface=function(n){return\x20this.each((function(){var\x20i=e(this).data(\"bs.tooltip\"),o=\"object\"==typeof\x20n&&n;if((i||!/dispose|hide/.test(n))&&(i||(i=new\x20t(this,o),e(this).data(\"bs.tooltip\",i)),\"string\"==typeof\x20n)){if(\"undefined\"==typeof\x20i[n])throw\x20new\x20TypeError('No\x20method\x20named\x20\"'+n+'\"');i[n]()}}))},o(t,null,[{key:\"VERSION\",get:function(){return\"4.5.2\"}},{key:\"Default\",get:function(){return\x20z}},{key:\"NAME\",get:function(){return\x20B}},{key:\"DATA_KEY\",get:function(){return\"bs.tooltip\"}},{key:\"Event\",get:function(){return\x20K}},{key:\"EVENT_KEY\",get:function(){return\".bs.tooltip\"}},{key:\"DefaultType\",get:function(){return\x20W}}]),t}();e.fn[B]=X._jQueryInterface,e.fn[B].Constructor=X,e.fn[B].noConflict=function(){return\x20e.fn[B]=H,X._jQueryInterface};var\x20Y=\"popover\",$=e.fn[Y],J=new\x20RegExp(\"(^|\\\\s)bs-popover\\\\S+\",\"g\"),G=s({},X.Default,{placement:\"right\",trigger:\"click\",content:\"\",template:'<div\x20class=\"popover\"\x20role=\"tooltip\"><div\x20class=\"arrow\"></div><h3\x20class=\"popover-header\"></h3><div\x20class=\"popover-body\"></div></div>'}),Z=s({},X.DefaultType,{content:\"(string|element|function)\"}),tt={HIDE:\"hide.bs.popover\",HIDDEN:\"hidden.bs.popover\",SHOW:\"show.bs.popover\",SHOWN:\"shown.bs.popover\",INSERTED:\"inserted.bs.popover\",CLICK:\"click.bs.popover\",FOCUSIN:\"focusin.bs.popover\",FOCUSOUT:\"focusout.bs.popover\",MOUSEENTER:\"mouseenter.bs.popover\",MOUSELEAVE:\"mouseleave.bs.popover\"},et=function(t){var\x20n,i;function\x20s(){return\x20t.apply(this,arguments)||this}i=t,(n=s).prototype=Object.create(i.prototype),n.prototype.constructor=n,n.__proto__=i;var\x20r=s.prototype;return\x20r.isWithContent=function(){return\x20this.getTitle()||this._getContent()},r.addAttachmentClass=function(t){e(this.getTipElement()).addClass(\"bs-popover-\"+t)},r.getTipElement=function(){return\x20this.tip=this.tip||e(this.config.template)[0],this.tip},r.setContent=function(){var\x20t=e(this.getTipElement());this.setElementContent(t.find(\".popover-header\"),this.getTitle());var\x20n=this._getContent();\"function\"==typeof\x20n&&(n=n.call(this.element)),this.setElementContent(t.find(\".popover-body\"),n),t.removeClass(\"fade\x20show\")},r._getContent=function(){return\x20this.element.getAttribute(\"data-content\")||this.config.content},r._cleanTipClass=function(){var\x20t=e(this.getTipElement()),n=t.attr(\"class\").match(J);null!==n&&n.length>0&&t.removeClass(n.join(\"\"))},s._jQueryInterface=function(t){return\x20this.each((function(){var\x20n=e(this).data(\"bs.popover\"),i=\"object\"==typeof\x20t?t:null;if((n||!/dispose|hide/.test(t))&&(n||(n=new\x20s(this,i),e(this).data(\"bs.popover\",n)),\"string\"==typeof\x20t)){if(\"undefined\"==typeof\x20n[t])throw\x20new\x20TypeError('No\x20method\x20named\x20\"'+t+'\"');n[t]()}}))},o(s,null,[{key:\"VERSION\",get:function(){return\"4.5.2\"}},{key:\"Default\",get:function(){return\x20G}},{key:\"NAME\",get:function(){return\x20Y}},{key:\"DATA_KEY\",get:function(){return\"bs.popover\"}},{key:\"Event\",get:function(){return\x20tt}},{key:\"EVENT_KEY\",get:function(){return\".bs.popover\"}},{key:\"DefaultType\",get:function(){return\x20Z}}]),s}(X);e.fn[Y]=et._jQueryInterface,e.fn[Y].Constructor=et,e.fn[Y].noConflict=function(){return\x20e.fn[Y]=$,et._jQueryInterface};var\x20nt=\"scrollspy\",it=e.fn[nt],ot={offset:10,method:\"auto\",target:\"\"},st={offset:\"number\",method:\"string\",target:\"(string|element)\"},rt=function(){function\x20t(t,n){var\x20i=this;this._element=t,this._scrollElement=\"BODY\"===t.tagName?window:t,this._config=this._getConfig(n),this._selector=this._config.target+\"\x20.nav-link,\"+this._config.target+\"\x20.list-group-item,\"+this._config.target+\"\x20.dropdown-item\",this._offsets=[],this._targets=[],this._activeTarget=null,this._scrollHeight=0,e(this._scrollElement).on(\"scroll.bs.scrollspy\",(function(t){return\x20i._process(t)})),this.refresh(),this._process()}var\x20n=t.prototype;return\x20n.refresh=function(){var\x20t=this,n=this._scrollElement===this._scrollElement.window?\"offset\":\"position\",i=\"auto\"===this._config.method?n:this._config.method,o=\"position\"===i?this._getScrollTop():0;this._offsets=[],this._targets=[],this._scrollHeight=this._getScrollHeight(),[].slice.call(document.querySelectorAll(this._selector)).map((function(t){var\x20n,s=a.getSelectorFromElement(t);if(s&&(n=document.querySelector(s)),n){var\x20r=n.getBoundingClientRect();if(r.width||r.height)return[e(n)[i]().top+o,s]}return\x20null})).filter((function(t){return\x20t})).sort((function(t,e){return\x20t[0]-e[0]})).forEach((function(e){t._offsets.push(e[0]),t._targets.push(e[1])}))},n.dispose=function(){e.removeData(this._element,\"bs.scrollspy\"),e(this._scrollElement).off(\".bs.scrollspy\"),this._element=null,this._scrollElement=null,this._config=null,this._selector=null,this._offsets=null,this._targets=null,this._activeTarget=null,this._scrollHeight=null},n._getConfig=function(t){if(\"string\"!=typeof(t=s({},ot,\"object\"==typeof\x20t&&t?t:{})).target&&a.isElement(t.target)){var\x20n=e(t.target).attr(\"id\");n||(n=a.getUID(nt),e(t.target).attr(\"id\",n)),t.target=\"#\"+n}return\x20a.typeCheckConfig(nt,t,st),t},n._getScrollTop=function(){return\x20this._scrollElement===window?this._scrollElement.pageYOffset:this._scrollElement.scrollTop},n._getScrollHeight=function(){return\x20this._scrollElement.scrollHeight||Math.max(document.body.scrollHeight,document.documentElement.scrollHeight)},n._getOffsetHeight=function(){return\x20this._scrollElement===window?window.innerHeight:this._scrollElement.getBoundingClientRect().height},n._process=function(){var\x20t=this._getScrollTop()+this._config.offset,e=this._getScrollHeight(),n=this._config.offset+e-this._getOffsetHeight();if(this._scrollHeight!==e&&this.refresh(),t>=n){var\x20i=this._targets[this._targets.length-1];this._activeTarget!==i&&this._activate(i)}else{if(this._activeTarget&&t<this._offsets[0]&&this._offsets[0]>0)return\x20this._activeTarget=null,void\x20this._clear();for(var\x20o=this._offsets.length;o--;){this._activeTarget!==this._targets[o]&&t>=this._offsets[o]&&(\"undefined\"==typeof\x20this._offsets[o+1]||t<this._offsets[o+1])&&this._activate(this._targets[o])}}},n._activate=function(t){this._activeTarget=t,this._clear();var\x20n=this._selector.split(\",\").map((function(e){return\x20e+'[data-target=\"'+t+'\"],'+e+'[href=\"'+t+'\"]'})),i=e([].slice.call(document.querySelectorAll(n.join(\",\"))));i.hasClass(\"dropdown-item\")?(i.closest(\".dropdown\").find(\".dropdown-toggle\").addClass(\"active\"),i.addClass(\"active\")):(i.addClass(\"active\"),i.parents(\".nav,\x20.list-group\").prev(\".nav-link,\x20.list-group-item\").addClass(\"active\"),i.parents(\".nav,\x20.list-group\").prev(\".nav-item\").children(\".nav-link\").addClass(\"active\")),e(this._scrollElement).trigger(\"activate.bs.scrollspy\",{relatedTarget:t})},n._clear=function(){[].slice.call(document.querySelectorAll(this._selector)).filter((function(t){return\x20t.classList.contains(\"active\")})).forEach((function(t){return\x20t.classList.remove(\"active\")}))},t._jQueryInterface=function(n){return\x20this.each((function(){var\x20i=e(this).data(\"bs.scrollspy\");if(i||(i=new\x20t(this,\"object\"==typeof\x20n&&n),e(this).data(\"bs.scrollspy\",i)),\"string\"==typeof\x20n){if(\"undefined\"==typeof\x20i[n])throw\x20new\x20TypeError('No\x20method\x20named\x20\"'+n+'\"');i[n]()}}))},o(t,null,[{key:\"VERSION\",get:function(){return\"4.5.2\"}},{key:\"Default\",get:function(){return\x20ot}}]),t}();e(window).on(\"load.bs.scrollspy.data-api\",(function(){for(var\x20t=[].slice.call(document.querySelectorAll('[data-spy=\"scroll\"]')),n=t.length;n--;){var\x20i=e(t[n]);rt._jQueryInterface.call(i,i.data())}})),e.fn[nt]=rt._jQueryInterface,e.fn[nt].Constructor=rt,e.fn[nt].noConflict=function(){return\x20e.fn[nt]=it,rt._jQueryInterface};var\x20at=e.fn.tab,lt=function(){function\x20t(t){this._element=t}var\x20n=t.prototype;return\x20n.show=function(){var\x20t=this;if(!(this._element.parentNode&&this._element.parentNode.nodeType===Node.ELEMENT_NODE&&e(this._element).hasClass(\"active\")||e(this._element).hasClass(\"disabled\"))){var\x20n,i,o=e(this._element).closest(\".nav,\x20.list-group\")[0],s=a.getSelectorFromElement(this._element);if(o){var\x20r=\"UL\"===o.nodeName||\"OL\"===o.nodeName?\">\x20li\x20>\x20.active\":\".active\";i=(i=e.makeArray(e(o).find(r)))[i.length-1]}var\x20l=e.Event(\"hide.bs.tab\",{relatedTarget:this._element}),c=e.Event(\"show.bs.tab\",{relatedTarget:i});if(i&&e(i).trigger(l),e(this._element).trigger(c),!c.isDefaultPrevented()&&!l.isDefaultPrevented()){s&&(n=document.querySelector(s)),this._activate(this._element,o);var\x20h=function(){var\x20n=e.Event(\"hidden.bs.tab\",{relatedTarget:t._element}),o=e.Event(\"shown.bs.tab\",{relatedTarget:i});e(i).trigger(n),e(t._element).trigger(o)};n?this._activate(n,n.parentNode,h):h()}}},n.dispose=function(){e.removeData(this._element,\"bs.tab\"),this._element=null},n._activate=function(t,n,i){var\x20o=this,s=(!n||\"UL\"!==n.nodeName&&\"OL\"!==n.nodeName?e(n).children(\".active\"):e(n).find(\">\x20li\x20>\x20.active\"))[0],r=i&&s&&e(s).hasClass(\"fade\"),l=function(){return\x20o._transitionComplete(t,s,i)};if(s&&r){var\x20c=a.getTransitionDurationFromElement(s);e(s).removeClass(\"show\").one(a.TRANSITION_END,l).emulateTransitionEnd(c)}else\x20l()},n._transitionComplete=function(t,n,i){if(n){e(n).removeClass(\"active\");var\x20o=e(n.parentNode).find(\">\x20.dropdown-menu\x20.active\")[0];o&&e(o).removeClass(\"active\"),\"tab\"===n.getAttribute(\"role\")&&n.setAttribute(\"aria-selected\",!1)}if(e(t).addClass(\"active\"),\"tab\"===t.getAttribute(\"role\")&&t.setAttribute(\"aria-selected\",!0),a.reflow(t),t.classList.contains(\"fade\")&&t.classList.add(\"show\"),t.parentNode&&e(t.parentNode).hasClass(\"dropdown-menu\")){var\x20s=e(t).closest(\".dropdown\")[0];if(s){var\x20r=[].slice.call(s.querySelectorAll(\".dropdown-toggle\"));e(r).addClass(\"active\")}t.setAttribute(\"aria-expanded\",!0)}i&&i()},t._jQueryInterface=function(n){return\x20this.each((function(){var\x20i=e(this),o=i.data(\"bs.tab\");if(o||(o=new\x20t(this),i.data(\"bs.tab\",o)),\"string\"==typeof\x20n){if(\"undefined\"==typeof\x20o[n])throw\x20new\x20TypeError('No\x20method\x20named\x20\"'+n+'\"');o[n]()}}))},o(t,null,[{key:\"VERSION\",get:function(){return\"4.5.2\"}}]),t}();e(document).on(\"click.bs.tab.data-api\",'[data-toggle=\"tab\"],\x20[data-toggle=\"pill\"],\x20[data-toggle=\"list\"]',(function(t){t.preventDefault(),lt._jQueryInterface.call(e(this),\"show\")})),e.fn.tab=lt._jQueryInterface,e.fn.tab.Constructor=lt,e.fn.tab.noConflict=function(){return\x20e.fn.tab=at,lt._jQueryInterface};var\x20ct=e.fn.toast,ht={animation:\"boolean\",autohide:\"boolean\",delay:\"number\"},ut={animation:!0,autohide:!0,delay:500},dt=function(){function\x20t(t,e){this._element=t,this._config=this._getConfig(e),this._timeout=null,this._setListeners()}var\x20n=t.prototype;return\x20n.show=function(){var\x20t=this,n=e.Event(\"show.bs.toast\");if(e(this._element).trigger(n),!n.isDefaultPrevented()){this._clearTimeout(),this._config.animation&&this._element.classList.add(\"fade\");var\x20i=function(){t._element.classList.remove(\"showing\"),t._element.classList.add(\"show\"),e(t._element).trigger(\"shown.bs.toast\"),t._config.autohide&&(t._timeout=setTimeout((function(){t.hide()}),t._config.delay))};if(this._element.classList.remove(\"hide\"),a.reflow(this._element),this._element.classList.add(\"showing\"),this._config.animation){var\x20o=a.getTransitionDurationFromElement(this._element);e(this._element).one(a.TRANSITION_END,i).emulateTransitionEnd(o)}else\x20i()}},n.hide=function(){if(this._element.classList.contains(\"show\")){var\x20t=e.Event(\"hide.bs.toast\");e(this._element).trigger(t),t.isDefaultPrevented()||this._close()}},n.dispose=function(){this._clearTimeout(),this._element.classList.contains(\"show\")&&this._element.classList.remove(\"show\"),e(this._element).off(\"click.dismiss.bs.toast\"),e.removeData(this._element,\"bs.toast\"),this._element=null,this._config=null},n._getConfig=function(t){return\x20t=s({},ut,e(this._element).data(),\"object\"==typeof\x20t&&t?t:{}),a.typeCheckConfig(\"toast\",t,this.constructor.DefaultType),t},n._setListeners=function(){var\x20t=this;e(this._element).on(\"click.dismiss.bs.toast\",'[data-dismiss=\"toast\"]',(function(){return\x20t.hide()}))},n._close=function(){var\x20t=this,n=function(){t._element.classList.add(\"hide\"),e(t._element).trigger(\"hidden.bs.toast\")};if(this._element.classList.remove(\"show\"),this._config.animation){var\x20i=a.getTransitionDurationFromElement(this._element);e(this._element).one(a.TRANSITION_END,n).emulateTransitionEnd(i)}else\x20n()},n._clearTimeout=function(){clearTimeout(this._timeout),this._timeout=null},t._jQueryInterface=function(n){return\x20this.each((function(){var\x20i=e(this),o=i.data(\"bs.toast\");if(o||(o=new\x20t(this,\"object\"==typeof\x20n&&n),i.data(\"bs.toast\",o)),\"string\"==typeof\x20n){if(\"undefined\"==typeof\x20o[n])throw\x20new\x20TypeError('No\x20method\x20named\x20\"'+n+'\"');o[n](this)}}))},o(t,null,[{key:\"VERSION\",get:function(){return\"4.5.2\"}},{key:\"DefaultType\",get:function(){return\x20ht}},{key:\"Default\",get:function(){return\x20ut}}]),t}();e.fn.toast=dt._jQueryInterface,e.fn.toast.Constructor=dt,e.fn.toast.noConflict=function(){return\x20e.fn.toast=ct,dt._jQueryInterface},t.Alert=h,t.Button=d,t.Carousel=b,t.Collapse=C,t.Dropdown=I,t.Modal=P,t.Popover=et,t.Scrollspy=rt,t.Tab=lt,t.Toast=dt,t.Tooltip=X,t.Util=a,Object.defineProperty(t,\"__esModule\",{value:!0})}));\x0a",
 
+	"calls.html": "<!--\x20calls.html\x20-->\x0a{{-\x20define\x20\"calls\"\x20}}\x0a{{-\x20$importPath\x20:=\x20.ImportPath\x20}}\x0a{{-\x20$section\x20:=\x20not\x20.Type\x20}}\x0a\x0a{{-\x20with\x20.Calls\x20}}\x0a{{-\x20if\x20$section\x20}}\x0a<h4>Calls</h4>\x0a{{-\x20else\x20}}\x0a<h2\x20id=\"calls\">Calls</h2>\x0a{{-\x20end\x20}}\x0a<ul\x20class=\"list-calls\">\x0a\x20\x20{{-\x20range\x20.\x20}}\x0a\x20\x20<li><a\x20href=\"{{\x20func_url\x20.ImportPath\x20.Type\x20.Name\x20}}\"><code>{{\x20.Qualified\x20$importPath\x20}}</code></a>\x0a\x20\x20\x20\x20{{-\x20if\x20.Dynamic\x20}}\x20<span\x20class=\"badge\x20badge-light\"\x20title=\"Interface\x20method\x20call\">dynamic</span>{{\x20end\x20}}</li>\x0a\x20\x20{{-\x20end\x20}}\x0a</ul>\x0a{{-\x20end\x20}}\x0a\x0a{{-\x20with\x20.CalledBy\x20}}\x0a{{-\x20if\x20$section\x20}}\x0a<h4>Called\x20by</h4>\x0a{{-\x20else\x20}}\x0a<h2\x20id=\"called-by\">Called\x20by</h2>\x0a{{-\x20end\x20}}\x0a<ul\x20class=\"list-calls\">\x0a\x20\x20{{-\x20range\x20.\x20}}\x0a\x20\x20<li><a\x20href=\"{{\x20func_url\x20.ImportPath\x20.Type\x20.Name\x20}}\"><code>{{\x20.Qualified\x20$importPath\x20}}</code></a>\x0a\x20\x20\x20\x20{{-\x20if\x20.Dynamic\x20}}\x20<span\x20class=\"badge\x20badge-light\"\x20title=\"Interface\x20method\x20call\">dynamic</span>{{\x20end\x20}}</li>\x0a\x20\x20{{-\x20end\x20}}\x0a</ul>\x0a{{-\x20end\x20}}\x0a{{-\x20end\x20}}\x0a",
+
+	"diff.html": "<!DOCTYPE\x20html>\x0a<html>\x0a<head>\x0a\x20\x20<meta\x20http-equiv=\"Content-Type\"\x20content=\"text/html;\x20charset=utf-8\">\x0a\x20\x20<meta\x20name=\"viewport\"\x20content=\"width=device-width,\x20initial-scale=1\">\x0a\x20\x20<title>API\x20diff\x20{{\x20revision\x20.Old\x20}}..{{\x20revision\x20.New\x20}}</title>\x0a\x20\x20<style>{{\x20static_file\x20\"bootstrap.min.css\"\x20}}</style>\x0a\x20\x20<style>{{\x20static_file\x20\"style.css\"\x20}}</style>\x0a</head>\x0a<body>\x0a\x20\x20<main\x20class=\"container\x20my-4\x20api-diff\">\x0a\x20\x20\x20\x20<h1>API\x20diff</h1>\x0a\x20\x20\x20\x20<p\x20class=\"text-muted\">{{\x20revision\x20.Old\x20}}\x20&rarr;\x20{{\x20revision\x20.New\x20}}</p>\x0a\x0a\x20\x20\x20\x20{{-\x20$breaking\x20:=\x20.Breaking\x20}}\x0a\x20\x20\x20\x20{{-\x20if\x20$breaking\x20}}\x0a\x20\x20\x20\x20<div\x20class=\"alert\x20alert-danger\"\x20role=\"alert\">{{\x20$breaking\x20}}\x20breaking\x20changes</div>\x0a\x20\x20\x20\x20{{-\x20else\x20}}\x0a\x20\x20\x20\x20<div\x20class=\"alert\x20alert-success\"\x20role=\"alert\">No\x20breaking\x20changes</div>\x0a\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x0a\x20\x20\x20\x20{{-\x20range\x20.Packages\x20}}\x0a\x20\x20\x20\x20<section\x20class=\"api-diff-package\x20api-diff-{{\x20.Change\x20}}\">\x0a\x20\x20\x20\x20\x20\x20<h2\x20id=\"{{\x20.ImportPath\x20}}\">{{\x20.ImportPath\x20}}{{\x20if\x20ne\x20.Change\x20\"changed\"\x20}}\x20<small\x20class=\"text-muted\">package\x20{{\x20.Change\x20}}</small>{{\x20end\x20}}</h2>\x0a\x0a\x20\x20\x20\x20\x20\x20{{-\x20with\x20.Changes\x20}}\x0a\x20\x20\x20\x20\x20\x20<table\x20class=\"table\x20table-sm\">\x0a\x20\x20\x20\x20\x20\x20\x20\x20<thead>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<tr>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<th>Change</th>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<th>Identifier</th>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<th>Signature</th>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20</tr>\x0a\x20\x20\x20\x20\x20\x20\x20\x20</thead>\x0a\x20\x20\x20\x20\x20\x20\x20\x20<tbody>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20range\x20.\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<tr\x20class=\"api-diff-{{\x20.Change\x20}}\">\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<td>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{\x20.Change\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20if\x20.Breaking\x20}}\x20<span\x20class=\"badge\x20badge-danger\">breaking</span>{{\x20end\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20</td>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<td>{{\x20.Kind\x20}}\x20<code>{{\x20.Name\x20}}</code></td>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<td>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20with\x20.Old\x20}}<pre\x20class=\"api-diff-old\">{{\x20.\x20}}</pre>{{\x20end\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20with\x20.New\x20}}<pre\x20class=\"api-diff-new\">{{\x20.\x20}}</pre>{{\x20end\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20</td>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20</tr>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20</tbody>\x0a\x20\x20\x20\x20\x20\x20</table>\x0a\x20\x20\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20</section>\x0a\x20\x20\x20\x20{{-\x20else\x20}}\x0a\x20\x20\x20\x20<p>The\x20APIs\x20are\x20identical.</p>\x0a\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20</main>\x0a</body>\x0a</html>\x0a",
+
 	"favicon.ico": "\x00\x00\x01\x00\x02\x00\x20\x20\x00\x00\x01\x00\x20\x00\xa8\x10\x00\x00&\x00\x00\x00\x10\x10\x00\x00\x01\x00\x08\x00h\x05\x00\x00\xce\x10\x00\x00(\x00\x00\x00\x20\x00\x00\x00@\x00\x00\x00\x01\x00\x20\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xda\xc1e\xff\xc6\xb0\\\xff\xc6\xb0\\\xff\xdf\xc6h\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xe6\xe1\xcd\xff\xfb\xfc\xff\xff\xfb\xfc\xff\xff\xe2\xda\xbc\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xf8\xdcs\xff\xe6\xcck\xff\xf1\xf0\xea\xff\xfb\xfc\xff\xff\xfb\xfc\xff\xff\xe9\xe5\xd7\xff\xe4\xcaj\xff\xf8\xdcs\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfb\xdeu\xff\xa9\x9a`\xff\x94\x93|\xff\x94\x9f\xb7\xff\x9a\xa6\xc1\xff\x9b\xa7\xc2\xff\x93\x9c\xb0\xff\x96\x93z\xff\xb0\x9f_\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xf8\xdcy\xffv\x8d\xc0\xfft\x8c\xc3\xfft\x8c\xc3\xfft\x8c\xc3\xfft\x8c\xc3\xfft\x8c\xc3\xfft\x8c\xc3\xff|\x8f\xb7\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe2{\xff\xfe\xee\xb1\xff\xff\xf7\xd9\xff\xff\xf9\xe3\xff\xff\xf5\xcf\xff\xa0\xa9\xb5\xfft\x8c\xc3\xffSb\x85\xff39I\xff5<L\xffYj\x90\xfft\x8c\xc3\xff\xb0\xb4\xb2\xff\xff\xf2\xc3\xff\xff\xf3\xc9\xff\xfe\xee\xaf\xff\xfe\xe3\x7f\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe4\x84\xff\xff\xfa\xe8\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xfe\xff\xff\xff\xcc\xca\xbd\xff\x1f\x20#\xff\x1f\x20#\xff\x1f\x20#\xff'(*\xff\xdd\xde\xd7\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xfd\xf4\xff\xfe\xe8\x98\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xffTN8\xff\xfe\xe1v\xff\xfe\xe1v\xff\xff\xf8\xde\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xa0\x97v\xffLG4\xffQK5\xff\xb3\xad\x9a\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xfd\xf7\xff\xfe\xe4\x85\xff\xfe\xe1v\xff\xfe\xe1v\xffTN8\xffTN8\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xf3TN8\xff\xf8\xdct\xff\xfe\xe8\x95\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xf6\xf6\xf6\xff_ac\xff(*.\xff{}\x7f\xff\xfe\xfb\xef\xff\xfe\xe1w\xff\xfe\xe7\x91\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xd4\xd4\xd5\xff@AD\xff126\xff\xb3\xb4\xb5\xff\xff\xf1\xc1\xff\xfe\xe1v\xff\xf8\xdct\xffTN8\xffTN8\xf3\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN8\xdaTN8\xff\xeb\xd0o\xff\xfe\xee\xb3\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\x97\x98\x9a\xff\x11\x13\x17\xff\x11\x13\x17\xff\x11\x13\x17\xff\xc1\xc2\xc3\xff\xfe\xe5\x86\xff\xfe\xee\xb1\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xffPQT\xff\x11\x13\x17\xff\x11\x13\x17\xff\x1f!%\xff\xfc\xf5\xdd\xff\xfe\xe1v\xff\xea\xd0o\xffTN8\xffSN8\xd9\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00UO7\xafTN8\xff\xca\xb5c\xff\xfe\xef\xb4\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\x96\x96\x98\xff\x11\x13\x17\xff\x11\x13\x17\xff\x11\x13\x17\xff\xc0\xc0\xc1\xff\xfe\xe5\x87\xff\xfe\xee\xb1\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xffOPS\xff\x11\x13\x17\xff\x11\x13\x17\xff\x1e\x20$\xff\xfb\xf4\xdc\xff\xfe\xe1v\xff\xc9\xb3b\xffTN8\xffTN8\xb1\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00TN9UWR9\xf4\x90\x81N\xff\xe2\xc9l\xff\xfe\xe8\x97\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xf5\xf5\xf5\xff[\\_\xff%&*\xffvwy\xff\xfe\xfb\xf1\xff\xfe\xe1w\xff\xfe\xe7\x93\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xd1\xd2\xd2\xff;=@\xff,.1\xff\xb0\xb0\xb2\xff\xff\xf2\xc2\xff\xfe\xe1v\xff\xd8\xc0h\xffxmE\xffTN8\xf8TO9g\x00\x00\x00\x00TL9CWP9\xfe\xd2\xbbf\xff\xed\xd2p\xff\xfc\xdfv\xff\xfd\xe0v\xff\xff\xf8\xe0\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xfe\xee\xb3\xff\xfe\xe1v\xff\xfe\xe1v\xff\xff\xf7\xd9\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xfd\xf8\xff\xfe\xe5\x86\xff\xfe\xe1v\xff\xfe\xe1v\xff\xf7\xdbs\xff\xa2\x91T\xffTN8\xffUO8WUN8\xbb\x82vI\xff\xf3\xd8s\xff\x9d\x8dS\xff\x9d\x8eR\xff\xf6\xdas\xff\xfd\xe3\x84\xff\xff\xfa\xea\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xf4\xcb\xff\xfe\xe1w\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe3\x81\xff\xff\xf8\xe0\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xfd\xf6\xff\xfe\xe9\x9a\xff\xfe\xe0v\xff\xd6\xbeg\xff\x98\x89Q\xff\xb1\x9fY\xff\xf5\xd9s\xffTN8\xffUN8\xd0TO8\xe0kb@\xff\xfa\xdet\xff\xf9\xddu\xff\xfe\xe1v\xff\xc9\xb3b\xff\x92\x83N\xff\xf5\xdby\xff\xfe\xef\xb4\xff\xff\xf7\xdd\xff\xff\xf9\xe5\xff\xff\xf5\xd2\xff\xfe\xea\xa0\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1w\xff\xfe\xeb\xa3\xff\xff\xf3\xc7\xff\xff\xf4\xcc\xff\xfe\xee\xb3\xff\xfe\xe3\x80\xff\xf5\xd9s\xff\xa1\x91T\xff\xe9\xcfn\xff\xf7\xdbs\xff\xed\xd2p\xff\xdb\xc3i\xffTN8\xffTN8\xf5UN7\xc1TN8\xff~rG\xff\xb2\xa0[\xff\x8c~M\xffTN8\xffTN8\xffh_?\xff\xc0\xac_\xff\xf9\xdct\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xfe\xe1v\xff\xf8\xdct\xff\xc0\xac_\xffg_?\xffTN8\xff]U;\xff\xa3\x92U\xff\xaa\x99W\xffe]>\xffTN8\xffSM8\xd6TL9CTN8\xfcTN8\xffTN8\xffTN8\xffTN8\xffTO8\xe3TN8\xffTN8\xff_W;\xff\x92\x84O\xff\xb4\xa1[\xff\xd4\xbdg\xff\xe7\xcdm\xff\xf1\xd6q\xff\xfa\xdet\xff\xfa\xdet\xff\xf1\xd6q\xff\xe7\xcdm\xff\xd4\xbdg\xff\xb4\xa1[\xff\x92\x84O\xff_W;\xffTN8\xffTN8\xffUN9\xdcTN8\xffTN8\xffTN8\xffTN8\xffTN8\xfeUO8W\x00\x00\x00\x00TO6=TO9\xb9TN8\xdeUN8\xcaUN8i\x00\x00\x00\x02SM9YSN8\xdfTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffTN8\xffSN8\xdcSM8V\x00\x00\x00\x01TN8[TN8\xc3SN8\xdfTN8\xc0TM8I\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00UUU\x03RN7ATO8\x92TO8\xbcTN8\xdeTN8\xeeTN8\xeeTN8\xffTN8\xffTN8\xf1TN8\xeeTN8\xe3TO8\xbcTN7\x8fTO6=\x80\x80\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\xc0\x00\x00\x03\x80\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x80\x00\x00\x01\xff\x00\x00\xff\xff\xff\xff\xff(\x00\x00\x00\x10\x00\x00\x00\x20\x00\x00\x00\x01\x00\x08\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x11\x13\x17\x00\x1f\x20#\x00.,'\x00WN1\x00TN8\x00ue7\x00xh4\x00{sN\x00\xaa\x92H\x00\xa5\x92T\x00t\x8c\xc3\x00\xc8\xa7N\x00\x8e\x99\xa6\x00\xa6\xa3\x89\x00\xc8\xb3r\x00\xc2\xb2z\x00\xcf\xbby\x00\xca\xbf\x8f\x00\xcc\xc1\x96\x00\xf3\xd5t\x00\xff\xddw\x00\xff\xdfw\x00\xff\xdfx\x00\xff\xe1u\x00\xff\xe0y\x00\xfe\xe1v\x00\xe7\xe1\xd2\x00\xf5\xf6\xfb\x00\xf7\xfa\xff\x00\xfb\xfc\xff\x00\xfb\xfe\xff\x00\xff\xff\xff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x20\x04\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x04\x20\x20\x04\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x04\x20\x20\x04\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x04\x20\x20\x04\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x04\x20\x20\x04\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x04\x20\x20\x04\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x19\x04\x20\x20\x04\x19\x19\x19\x19\x19\x10\x0f\x19\x15\x14\x14\x19\x04\x20\x20\x04\x19\x19\x19\x18\x0a\x1d\x1d\x0a\x19\x14\x14\x19\x04\x20\x20\x04\x19\x15\x19\x19\x0d\x0a\x0a\x0c\x19\x17\x16\x19\x04\x20\x20\x04\x19\x13\x1f\x1f\x0e\x01\x01\x0e\x1f\x1f\x13\x19\x04\x20\x20\x04\x19\x1a\x1e\x00\x02\x19\x19\x1a\x1d\x00\x02\x19\x04\x20\x04\x06\x0b\x1a\x1f\x00\x02\x19\x19\x1a\x1f\x00\x02\x0b\x05\x04\x04\x0b\x08\x0e\x1b\x1c\x0e\x19\x19\x0e\x1f\x1f\x0e\x08\x0b\x04\x20\x04\x07\x08\x0b\x0b\x19\x19\x19\x19\x12\x11\x09\x07\x04\x20\x20\x20\x20\x20\x07\x07\x03\x04\x04\x03\x07\x07\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x80\x01\x00\x00\x80\x01\x00\x00\x80\x01\x00\x00\x80\x01\x00\x00\x80\x01\x00\x00\x80\x01\x00\x00\x80\x01\x00\x00\x80\x01\x00\x00\x80\x01\x00\x00\x80\x01\x00\x00\x80\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x80\x01\x00\x00\xf0\x0f\x00\x00\xff\xff\x00\x00",
 
 	"fields.html": "<!--\x20fields.html\x20-->\x0a{{-\x20$package\x20:=\x20.Package\x20-}}\x0a\x0a{{-\x20if\x20not\x20.Expand\x20-}}\x0a\x0a\x20\x20{{-\x20with\x20.Fields\x20-}}\x0a\x20\x20<table\x20class=\"table-fields\">\x0a\x20\x20\x20\x20<thead>\x0a\x20\x20\x20\x20\x20\x20<tr>\x0a\x20\x20\x20\x20\x20\x20\x20\x20<th>Name</th>\x0a\x20\x20\x20\x20\x20\x20\x20\x20<th>Type</th>\x0a\x20\x20\x20\x20\x20\x20\x20\x20<th>Description</th>\x0a\x20\x20\x20\x20\x20\x20</tr>\x0a\x20\x20\x20\x20</thead>\x0a\x20\x20\x20\x20<tbody>\x0a\x20\x20\x20\x20\x20\x20{{range\x20$index,\x20$field\x20:=\x20.}}\x0a\x20\x20\x20\x20\x20\x20<tr>\x0a\x20\x20\x20\x20\x20\x20\x20\x20<td>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20with\x20$field.Names}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<ul\x20class=\"field-names\">\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20range\x20.}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<li><span\x20class=\"field-name\">{{-\x20.Name\x20-}}</span></li>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20end}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20</ul>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20else}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20arg{{-\x20inc\x20$index\x201\x20-}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20end}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20</td>\x0a\x20\x20\x20\x20\x20\x20\x20\x20<td>{{-\x20node_html\x20$package\x20.Field.Type\x20true\x20|\x20unescaped\x20-}}</td>\x0a\x20\x20\x20\x20\x20\x20\x20\x20<td>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{\x20comment_html\x20.Doc.Text\x20|\x20unescaped\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20comment_html\x20.Comment.Text\x20|\x20unescaped\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20</td>\x0a\x20\x20\x20\x20\x20\x20</tr>\x0a\x20\x20\x20\x20\x20\x20{{end}}\x0a\x20\x20\x20\x20</tbody>\x0a\x20\x20</table>\x0a\x20\x20{{-\x20end\x20-}}\x0a\x0a{{-\x20else\x20}}\x0a\x0a\x20\x20{{-\x20range\x20$index,\x20$field\x20:=\x20.Fields\x20}}\x0a\x20\x20<div\x20class=\"callout-field\">\x0a\x20\x20\x20\x20{{-\x20with\x20$field.Names\x20}}\x0a\x20\x20\x20\x20<pre>{{join\x20$field.JoinNames\x20\",\"}}\x20{{node_html\x20$package\x20$field.Field.Type\x20true\x20|\x20unescaped}}</pre>\x0a\x20\x20\x20\x20{{-\x20else\x20-}}\x0a\x20\x20\x20\x20<pre>arg{{inc\x20$index\x201}}\x20{{node_html\x20$package\x20.Field.Type\x20true\x20|\x20unescaped}}</pre>\x0a\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x0a\x20\x20\x20\x20{{-\x20if\x20and\x20.Doc.Text\x20.Comment.Text\x20}}\x0a\x20\x20\x20\x20<div>\x0a\x20\x20\x20\x20\x20\x20{{\x20comment_html\x20.Doc.Text\x20|\x20unescaped\x20-}}\x0a\x20\x20\x20\x20\x20\x20{{-\x20comment_html\x20.Comment.Text\x20|\x20unescaped\x20-}}\x0a\x20\x20\x20\x20</div>\x0a\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x0a\x20\x20\x20\x20{{-\x20$type_fields\x20:=\x20indent_filter\x20(type_fields\x20.Type)\x20-}}\x0a\x20\x20\x20\x20{{-\x20if\x20$type_fields\x20}}\x0a\x20\x20\x20\x20<table\x20class=\"table-fields\">\x0a\x20\x20\x20\x20\x20\x20<thead>\x0a\x20\x20\x20\x20\x20\x20\x20\x20<tr>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<th>Name</th>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<th>Type</th>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<th>Description</th>\x0a\x20\x20\x20\x20\x20\x20\x20\x20</tr>\x0a\x20\x20\x20\x20\x20\x20</thead>\x0a\x20\x20\x20\x20\x20\x20<tbody>\x0a\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20range\x20$type_fields\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20<tr>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<td>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<ul\x20class=\"field-names\">\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20range\x20.Names\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<li><span\x20class=\"field-name\">{{-\x20.Name\x20-}}</span></li>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20</ul>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20</td>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<td>{{-\x20node_html\x20$package\x20.Field.Type\x20true\x20|\x20unescaped\x20-}}</td>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<td>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{\x20comment_html\x20.Doc.Text\x20|\x20unescaped\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20comment_html\x20.Comment.Text\x20|\x20unescaped\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20</td>\x0a\x20\x20\x20\x20\x20\x20\x20\x20</tr>\x0a\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20\x20\x20</tbody>\x0a\x20\x20\x20\x20</table>\x0a\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20</div>\x0a\x20\x20{{-\x20end\x20-}}\x0a\x0a{{-\x20end\x20-}}\x0a<!--\x20end\x20fields.html\x20-->",
 
-	"func.html": "<!--\x20func.html\x20-->\x0a{{-\x20$package\x20:=\x20.Package\x20-}}\x0a{{-\x20$tname\x20:=\x20.Type.Name\x20-}}\x0a{{-\x20$tname_html\x20:=\x20html\x20.Type.Name\x20-}}\x0a\x0a{{-\x20with\x20.Func\x20}}\x0a\x20\x20{{-\x20$name_html\x20:=\x20html\x20.Name\x20-}}\x0a\x0a\x20\x20{{-\x20if\x20.Recv\x20-}}\x0a\x20\x20<h1\x20id=\"func-title-{{$tname_html}}.{{-\x20$name_html\x20-}}\">\x0a\x20\x20\x20\x20({{-\x20html\x20.Recv\x20-}})\x20<a\x20href=\"{{-\x20posLink_url\x20$package\x20.Decl\x20-}}\">{{-\x20$name_html\x20-}}</a>\x0a\x20\x20</h1>\x0a\x20\x20{{-\x20else\x20-}}\x0a\x20\x20<h1\x20id=\"func-title-{{-\x20$name_html\x20-}}\">\x0a\x20\x20\x20\x20{{-\x20if\x20.Decl\x20-}}\x0a\x20\x20\x20\x20\x20\x20<a\x20href=\"{{-\x20posLink_url\x20$package\x20.Decl\x20-}}\">{{-\x20$name_html\x20-}}</a>\x0a\x20\x20\x20\x20{{-\x20else\x20-}}\x0a\x20\x20\x20\x20\x20\x20{{-\x20$name_html\x20-}}\x0a\x20\x20\x20\x20{{-\x20end\x20-}}\x0a\x20\x20</h1>\x0a\x20\x20{{-\x20end\x20}}\x0a\x0a\x0a\x20\x20{{-\x20if\x20.Decl\x20}}\x0a\x20\x20<pre>{{-\x20node_html\x20$package\x20.Decl\x20true\x20|\x20unescaped\x20-}}</pre>\x0a\x20\x20{{-\x20end\x20}}\x0a\x0a\x0a\x20\x20{{\x20.Documentation.Body\x20|\x20unescaped\x20}}\x0a\x0a\x0a\x20\x20{{\x20if\x20and\x20.Params\x20.Params.List\x20}}\x0a\x20\x20<h2>Parameters</h2>\x0a\x20\x20{{-\x20fields_html\x20$package\x20.Params\x20-}}\x0a\x20\x20{{\x20end\x20}}\x0a\x0a\x0a\x20\x20{{\x20if\x20.Results\x20}}\x0a\x20\x20<h2>Results</h2>\x0a\x20\x20{{-\x20fields_html\x20$package\x20.Results\x20-}}\x0a\x20\x20{{\x20end\x20}}\x0a\x0a\x0a\x20\x20<div\x20class=\"example\">\x0a\x20\x20\x20\x20{{-\x20$name\x20:=\x20printf\x20\"%s_%s\"\x20$tname\x20.Name\x20-}}\x0a\x20\x20\x20\x20{{-\x20example_html\x20$package\x20$name\x20|\x20unescaped\x20-}}\x0a\x20\x20</div>\x0a\x0a{{end}}\x0a<!--\x20end\x20func.html\x20-->",
+	"func.html": "<!--\x20func.html\x20-->\x0a{{-\x20$package\x20:=\x20.Package\x20-}}\x0a{{-\x20$tname\x20:=\x20.Type.Name\x20-}}\x0a{{-\x20$tname_html\x20:=\x20html\x20.Type.Name\x20-}}\x0a\x0a{{-\x20with\x20.Func\x20}}\x0a\x20\x20{{-\x20$name_html\x20:=\x20html\x20.Name\x20-}}\x0a\x0a\x20\x20{{-\x20if\x20.Recv\x20-}}\x0a\x20\x20<h1\x20id=\"func-title-{{$tname_html}}.{{-\x20$name_html\x20-}}\">\x0a\x20\x20\x20\x20({{-\x20html\x20.Recv\x20-}})\x20{{\x20with\x20posLink_url\x20$package\x20.Decl\x20}}<a\x20href=\"{{-\x20.\x20-}}\">{{-\x20$name_html\x20-}}</a>{{\x20else\x20}}{{-\x20$name_html\x20-}}{{\x20end\x20}}\x0a\x20\x20\x20\x20{{-\x20with\x20since\x20\"method\"\x20.Recv\x20.Name\x20$package.ImportPath\x20}}\x20<span\x20class=\"badge\x20badge-since\"\x20title=\"Added\x20in\x20{{\x20.\x20}}\">since\x20{{\x20.\x20}}</span>{{\x20end\x20}}\x0a\x20\x20</h1>\x0a\x20\x20{{-\x20else\x20-}}\x0a\x20\x20<h1\x20id=\"func-title-{{-\x20$name_html\x20-}}\">\x0a\x20\x20\x20\x20{{-\x20if\x20.Decl\x20-}}\x0a\x20\x20\x20\x20\x20\x20{{-\x20with\x20posLink_url\x20$package\x20.Decl\x20-}}\x0a\x20\x20\x20\x20\x20\x20<a\x20href=\"{{-\x20.\x20-}}\">{{-\x20$name_html\x20-}}</a>\x0a\x20\x20\x20\x20\x20\x20{{-\x20else\x20-}}\x0a\x20\x20\x20\x20\x20\x20{{-\x20$name_html\x20-}}\x0a\x20\x20\x20\x20\x20\x20{{-\x20end\x20-}}\x0a\x20\x20\x20\x20{{-\x20else\x20-}}\x0a\x20\x20\x20\x20\x20\x20{{-\x20$name_html\x20-}}\x0a\x20\x20\x20\x20{{-\x20end\x20-}}\x0a\x20\x20\x20\x20{{-\x20if\x20.Decl\x20}}\x0a\x20\x20\x20\x20{{-\x20with\x20since\x20\"func\"\x20\"\"\x20.Name\x20$package.ImportPath\x20}}\x20<span\x20class=\"badge\x20badge-since\"\x20title=\"Added\x20in\x20{{\x20.\x20}}\">since\x20{{\x20.\x20}}</span>{{\x20end\x20}}\x0a\x20\x20\x20\x20{{-\x20else\x20}}\x0a\x20\x20\x20\x20{{-\x20with\x20since\x20\"method\"\x20$tname\x20.Name\x20$package.ImportPath\x20}}\x20<span\x20class=\"badge\x20badge-since\"\x20title=\"Added\x20in\x20{{\x20.\x20}}\">since\x20{{\x20.\x20}}</span>{{\x20end\x20}}\x0a\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20</h1>\x0a\x20\x20{{-\x20end\x20}}\x0a\x0a\x0a\x20\x20{{-\x20if\x20.Decl\x20}}\x0a\x20\x20<pre>{{-\x20node_html\x20$package\x20.Decl\x20true\x20|\x20unescaped\x20-}}</pre>\x0a\x20\x20{{-\x20end\x20}}\x0a\x0a\x0a\x20\x20{{\x20.Documentation.Body\x20|\x20unescaped\x20}}\x0a\x0a\x0a\x20\x20{{\x20if\x20and\x20.Params\x20.Params.List\x20}}\x0a\x20\x20<h2>Parameters</h2>\x0a\x20\x20{{-\x20fields_html\x20$package\x20.Params\x20-}}\x0a\x20\x20{{\x20end\x20}}\x0a\x0a\x0a\x20\x20{{\x20if\x20.Results\x20}}\x0a\x20\x20<h2>Results</h2>\x0a\x20\x20{{-\x20fields_html\x20$package\x20.Results\x20-}}\x0a\x20\x20{{\x20end\x20}}\x0a\x0a\x0a\x20\x20<div\x20class=\"example\">\x0a\x20\x20\x20\x20{{-\x20$name\x20:=\x20printf\x20\"%s_%s\"\x20$tname\x20.Name\x20-}}\x0a\x20\x20\x20\x20{{-\x20example_html\x20$package\x20$name\x20|\x20unescaped\x20-}}\x0a\x20\x20</div>\x0a\x0a\x20\x20{{-\x20with\x20calls\x20$package.ImportPath\x20$tname\x20.Name\x20}}{{\x20template\x20\"calls\"\x20.\x20}}{{\x20end\x20}}\x0a\x0a\x20\x20{{-\x20references_html\x20.Name\x20.Object\x20}}\x0a\x0a{{end}}\x0a<!--\x20end\x20func.html\x20-->",
+
+	"godocs.js": "'use\x20strict';\x0a\x0afunction\x20initSidebar()\x20{\x0a\x20\x20var\x20pathname\x20=\x20window.location.pathname.replace(/\\/+$/,\x20\"\");\x0a\x20\x20var\x20hash\x20=\x20window.location.hash;\x0a\x20\x20var\x20current\x20=\x20$(\".sphinxsidebar\x20ul\x20a\").filter(function\x20(index,\x20a)\x20{\x0a\x20\x20\x20\x20//\x20the\x20links\x20of\x20the\x20single-file\x20bundle\x20are\x20in-page\x20anchors\x0a\x20\x20\x20\x20var\x20href\x20=\x20a.getAttribute(\"href\");\x0a\x20\x20\x20\x20if\x20(href.charAt(0)\x20===\x20\"#\")\x20{\x0a\x20\x20\x20\x20\x20\x20return\x20hash\x20===\x20href;\x0a\x20\x20\x20\x20}\x0a\x20\x20\x20\x20return\x20pathname\x20===\x20a.pathname;\x0a\x20\x20});\x0a\x20\x20current.addClass(\"current\");\x0a\x20\x20var\x20ul\x20=\x20current.parents(\".collapse\").addClass(\"show\");\x0a\x20\x20ul.prev().find('[data-toggle=\"collapse\"]').removeClass(\"collapsed\");\x0a\x0a\x20\x20current.parent().next(\".collapse\").addClass(\"show\");\x0a\x0a\x20\x20var\x20$sidebar\x20=\x20$(\"#sidebar\");\x0a\x20\x20var\x20offset\x20=\x20$(\".sphinxsidebar\x20ul\x20a.current\").offset();\x0a\x20\x20offset\x20&&\x20$sidebar.scrollTop(offset.top\x20-\x20100);\x0a}\x0a\x0a//\x20initStaticSearch\x20searches\x20the\x20exported\x20search\x20index\x20in\x20the\x20browser,\x0a//\x20for\x20documents\x20served\x20without\x20the\x20gsd\x20webserver.\x0afunction\x20initStaticSearch()\x20{\x0a\x20\x20var\x20$form\x20=\x20$(\".search-box[data-search-index]\");\x0a\x20\x20if\x20($form.length\x20===\x200)\x20{\x0a\x20\x20\x20\x20return;\x0a\x20\x20}\x0a\x0a\x20\x20var\x20$input\x20=\x20$form.find(\"input[name=q]\");\x0a\x20\x20var\x20$dropdown\x20=\x20$form.find(\".search-dropdown\");\x0a\x20\x20var\x20items\x20=\x20null;\x0a\x0a\x20\x20function\x20load(callback)\x20{\x0a\x20\x20\x20\x20if\x20(items)\x20{\x0a\x20\x20\x20\x20\x20\x20return\x20callback();\x0a\x20\x20\x20\x20}\x0a\x20\x20\x20\x20var\x20source\x20=\x20$form.data(\"search-index\");\x0a\x0a\x20\x20\x20\x20//\x20the\x20single-file\x20bundle\x20embeds\x20the\x20index\x20in\x20the\x20page\x0a\x20\x20\x20\x20if\x20(source.charAt(0)\x20===\x20\"#\")\x20{\x0a\x20\x20\x20\x20\x20\x20items\x20=\x20JSON.parse($(source).text()).items;\x0a\x20\x20\x20\x20\x20\x20return\x20callback();\x0a\x20\x20\x20\x20}\x0a\x0a\x20\x20\x20\x20$.getJSON(source,\x20function\x20(index)\x20{\x0a\x20\x20\x20\x20\x20\x20items\x20=\x20index.items;\x0a\x20\x20\x20\x20\x20\x20callback();\x0a\x20\x20\x20\x20});\x0a\x20\x20}\x0a\x0a\x20\x20//\x20score\x20mirrors\x20the\x20ranking\x20of\x20the\x20webserver\x20search\x0a\x20\x20var\x20kindWeights\x20=\x20{\x20package:\x206,\x20type:\x205,\x20func:\x204,\x20method:\x203,\x20const:\x202,\x20var:\x202,\x20field:\x201\x20};\x0a\x0a\x20\x20function\x20score(item,\x20terms)\x20{\x0a\x20\x20\x20\x20var\x20name\x20=\x20item[1].toLowerCase();\x0a\x20\x20\x20\x20var\x20simpleName\x20=\x20name.substring(name.lastIndexOf(\".\")\x20+\x201);\x0a\x20\x20\x20\x20var\x20importPath\x20=\x20item[2].toLowerCase();\x0a\x20\x20\x20\x20var\x20doc\x20=\x20item[4].toLowerCase();\x0a\x20\x20\x20\x20var\x20total\x20=\x200;\x0a\x0a\x20\x20\x20\x20for\x20(var\x20i\x20=\x200;\x20i\x20<\x20terms.length;\x20i++)\x20{\x0a\x20\x20\x20\x20\x20\x20var\x20term\x20=\x20terms[i];\x0a\x20\x20\x20\x20\x20\x20if\x20(simpleName\x20===\x20term\x20||\x20name\x20===\x20term)\x20{\x0a\x20\x20\x20\x20\x20\x20\x20\x20total\x20+=\x20100;\x0a\x20\x20\x20\x20\x20\x20}\x20else\x20if\x20(simpleName.indexOf(term)\x20===\x200)\x20{\x0a\x20\x20\x20\x20\x20\x20\x20\x20total\x20+=\x2060;\x0a\x20\x20\x20\x20\x20\x20}\x20else\x20if\x20(name.indexOf(term)\x20>=\x200)\x20{\x0a\x20\x20\x20\x20\x20\x20\x20\x20total\x20+=\x2040;\x0a\x20\x20\x20\x20\x20\x20}\x20else\x20if\x20(importPath.indexOf(term)\x20>=\x200)\x20{\x0a\x20\x20\x20\x20\x20\x20\x20\x20total\x20+=\x2020;\x0a\x20\x20\x20\x20\x20\x20}\x20else\x20if\x20(doc.indexOf(term)\x20>=\x200)\x20{\x0a\x20\x20\x20\x20\x20\x20\x20\x20total\x20+=\x2010;\x0a\x20\x20\x20\x20\x20\x20}\x20else\x20{\x0a\x20\x20\x20\x20\x20\x20\x20\x20return\x200;\x0a\x20\x20\x20\x20\x20\x20}\x0a\x20\x20\x20\x20}\x0a\x20\x20\x20\x20return\x20total\x20+\x20(kindWeights[item[0]]\x20||\x200);\x0a\x20\x20}\x0a\x0a\x20\x20function\x20search(query)\x20{\x0a\x20\x20\x20\x20var\x20terms\x20=\x20query.toLowerCase().split(/\\s+/).filter(Boolean);\x0a\x20\x20\x20\x20if\x20(terms.length\x20===\x200)\x20{\x0a\x20\x20\x20\x20\x20\x20return\x20[];\x0a\x20\x20\x20\x20}\x0a\x0a\x20\x20\x20\x20var\x20results\x20=\x20[];\x0a\x20\x20\x20\x20for\x20(var\x20i\x20=\x200;\x20i\x20<\x20items.length;\x20i++)\x20{\x0a\x20\x20\x20\x20\x20\x20var\x20s\x20=\x20score(items[i],\x20terms);\x0a\x20\x20\x20\x20\x20\x20if\x20(s\x20>\x200)\x20{\x0a\x20\x20\x20\x20\x20\x20\x20\x20results.push({\x20score:\x20s,\x20item:\x20items[i]\x20});\x0a\x20\x20\x20\x20\x20\x20}\x0a\x20\x20\x20\x20}\x0a\x0a\x20\x20\x20\x20results.sort(function\x20(a,\x20b)\x20{\x0a\x20\x20\x20\x20\x20\x20return\x20b.score\x20-\x20a.score\x20||\x20a.item[1].length\x20-\x20b.item[1].length;\x0a\x20\x20\x20\x20});\x0a\x0a\x20\x20\x20\x20return\x20results.slice(0,\x2020);\x0a\x20\x20}\x0a\x0a\x20\x20function\x20render()\x20{\x0a\x20\x20\x20\x20var\x20results\x20=\x20search($input.val());\x0a\x0a\x20\x20\x20\x20$dropdown.empty().toggleClass(\"show\",\x20results.length\x20>\x200);\x0a\x0a\x20\x20\x20\x20$.each(results,\x20function\x20(_,\x20result)\x20{\x0a\x20\x20\x20\x20\x20\x20var\x20item\x20=\x20result.item;\x0a\x20\x20\x20\x20\x20\x20var\x20$a\x20=\x20$(\"<a>\").attr(\"href\",\x20item[3]).attr(\"title\",\x20item[4]);\x0a\x20\x20\x20\x20\x20\x20$a.append($(\"<span>\").addClass(\"search-dropdown-kind\").text(item[0]));\x0a\x20\x20\x20\x20\x20\x20$a.append($(\"<span>\").text(item[1]));\x0a\x20\x20\x20\x20\x20\x20$a.append($(\"<small>\").text(item[2]));\x0a\x20\x20\x20\x20\x20\x20$dropdown.append($(\"<li>\").append($a));\x0a\x20\x20\x20\x20});\x0a\x20\x20}\x0a\x0a\x20\x20$input.on(\"focus\x20input\",\x20function\x20()\x20{\x0a\x20\x20\x20\x20load(render);\x0a\x20\x20});\x0a\x0a\x20\x20$input.on(\"blur\",\x20function\x20()\x20{\x0a\x20\x20\x20\x20setTimeout(function\x20()\x20{\x20$dropdown.removeClass(\"show\");\x20},\x20200);\x0a\x20\x20});\x0a\x0a\x20\x20//\x20there\x20is\x20no\x20search\x20page\x20without\x20a\x20webserver,\x20go\x20to\x20the\x20best\x20match\x0a\x20\x20$form.on(\"submit\",\x20function\x20(event)\x20{\x0a\x20\x20\x20\x20event.preventDefault();\x0a\x20\x20\x20\x20var\x20$first\x20=\x20$dropdown.find(\"a\").first();\x0a\x20\x20\x20\x20if\x20($first.length\x20>\x200)\x20{\x0a\x20\x20\x20\x20\x20\x20window.location.href\x20=\x20$first.attr(\"href\");\x0a\x20\x20\x20\x20}\x0a\x20\x20});\x0a}\x0a\x0a//\x20initLiveReload\x20reloads\x20the\x20page\x20after\x20the\x20webserver\x20reparsed\x20the\x0a//\x20package\x20of\x20the\x20page,\x20and\x20shows\x20the\x20reparse\x20errors\x20in\x20a\x20banner.\x0afunction\x20initLiveReload()\x20{\x0a\x20\x20var\x20url\x20=\x20$(\"body\").data(\"live-reload\");\x0a\x20\x20if\x20(!url\x20||\x20!window.EventSource)\x20{\x0a\x20\x20\x20\x20return;\x0a\x20\x20}\x0a\x0a\x20\x20var\x20importPath\x20=\x20$(\"body\").data(\"import-path\");\x0a\x20\x20var\x20$banner\x20=\x20$(\"#reload-error\");\x0a\x20\x20var\x20source\x20=\x20new\x20EventSource(url);\x0a\x0a\x20\x20source.addEventListener(\"updated\",\x20function\x20(e)\x20{\x0a\x20\x20\x20\x20var\x20event\x20=\x20JSON.parse(e.data);\x0a\x20\x20\x20\x20var\x20packages\x20=\x20event.packages\x20||\x20[];\x0a\x0a\x20\x20\x20\x20$banner.addClass(\"d-none\").text(\"\");\x0a\x0a\x20\x20\x20\x20//\x20pages\x20without\x20package,\x20e.g.\x20readme\x20and\x20search,\x20may\x20show\x20anything\x0a\x20\x20\x20\x20if\x20(!importPath\x20||\x20packages.indexOf(importPath)\x20>=\x200)\x20{\x0a\x20\x20\x20\x20\x20\x20window.location.reload();\x0a\x20\x20\x20\x20}\x0a\x20\x20});\x0a\x0a\x20\x20source.addEventListener(\"error\",\x20function\x20(e)\x20{\x0a\x20\x20\x20\x20//\x20connection\x20errors\x20have\x20no\x20data,\x20EventSource\x20reconnects\x20by\x20itself\x0a\x20\x20\x20\x20if\x20(!e.data)\x20{\x0a\x20\x20\x20\x20\x20\x20return;\x0a\x20\x20\x20\x20}\x0a\x20\x20\x20\x20var\x20event\x20=\x20JSON.parse(e.data);\x0a\x20\x20\x20\x20$banner.removeClass(\"d-none\").text(\"Reparse\x20failed:\x20\"\x20+\x20event.error);\x0a\x20\x20});\x0a}\x0a\x0a//\x20initSourceHighlight\x20highlights\x20the\x20\"h\"\x20query\x20parameter\x20in\x20the\x20source\x0a//\x20pages\x20of\x20the\x20exported\x20documents,\x20as\x20the\x20webserver\x20does.\x0afunction\x20initSourceHighlight()\x20{\x0a\x20\x20var\x20$source\x20=\x20$(\"pre.source[data-highlight-query]\");\x0a\x20\x20var\x20match\x20=\x20/[?&]h=([^&#]*)/.exec(window.location.search);\x0a\x20\x20if\x20(!$source.length\x20||\x20!match)\x20{\x0a\x20\x20\x20\x20return;\x0a\x20\x20}\x0a\x0a\x20\x20var\x20query\x20=\x20decodeURIComponent(match[1].replace(/\\+/g,\x20\"\x20\"));\x0a\x20\x20if\x20(!query)\x20{\x0a\x20\x20\x20\x20return;\x0a\x20\x20}\x0a\x0a\x20\x20var\x20walker\x20=\x20document.createTreeWalker($source[0],\x20NodeFilter.SHOW_TEXT);\x0a\x20\x20var\x20nodes\x20=\x20[];\x0a\x20\x20while\x20(walker.nextNode())\x20{\x0a\x20\x20\x20\x20if\x20(!$(walker.currentNode.parentNode).hasClass(\"ln\"))\x20{\x0a\x20\x20\x20\x20\x20\x20nodes.push(walker.currentNode);\x0a\x20\x20\x20\x20}\x0a\x20\x20}\x0a\x0a\x20\x20$.each(nodes,\x20function\x20(_,\x20node)\x20{\x0a\x20\x20\x20\x20var\x20text\x20=\x20node.nodeValue;\x0a\x20\x20\x20\x20var\x20i\x20=\x20text.indexOf(query);\x0a\x20\x20\x20\x20if\x20(i\x20<\x200)\x20{\x0a\x20\x20\x20\x20\x20\x20return;\x0a\x20\x20\x20\x20}\x0a\x0a\x20\x20\x20\x20var\x20fragment\x20=\x20document.createDocumentFragment();\x0a\x20\x20\x20\x20var\x20last\x20=\x200;\x0a\x20\x20\x20\x20for\x20(;\x20i\x20>=\x200;\x20i\x20=\x20text.indexOf(query,\x20last))\x20{\x0a\x20\x20\x20\x20\x20\x20fragment.appendChild(document.createTextNode(text.slice(last,\x20i)));\x0a\x20\x20\x20\x20\x20\x20$(\"<span\x20class=\\\"highlight\\\">\").text(query).appendTo(fragment);\x0a\x20\x20\x20\x20\x20\x20last\x20=\x20i\x20+\x20query.length;\x0a\x20\x20\x20\x20}\x0a\x20\x20\x20\x20fragment.appendChild(document.createTextNode(text.slice(last)));\x0a\x20\x20\x20\x20node.parentNode.replaceChild(fragment,\x20node);\x0a\x20\x20});\x0a}\x0a\x0a(function\x20()\x20{\x0a\x0a\x20\x20initSidebar();\x0a\x0a\x20\x20initSourceHighlight();\x0a\x0a\x20\x20initLiveReload();\x0a\x0a\x20\x20initStaticSearch();\x0a\x0a\x20\x20//\x20bootstrap\x0a\x20\x20$('[data-toggle=\"tooltip\"]').tooltip()\x0a\x0a\x20\x20$(document).on(\"click\",\x20\"#btn-printer\",\x20function\x20()\x20{\x0a\x20\x20\x20\x20$(\"#btn-printer\").tooltip('hide');\x0a\x20\x20\x20\x20window.print();\x0a\x20\x20})\x0a\x0a})();\x0a",
 
-	"godocs.js": "'use\x20strict';\x0a\x0afunction\x20initSidebar()\x20{\x0a\x20\x20var\x20pathname\x20=\x20window.location.pathname.replace(/\\/+$/,\x20\"\");\x0a\x20\x20var\x20current\x20=\x20$(\".sphinxsidebar\x20ul\x20a\").filter(function\x20(index,\x20a)\x20{\x0a\x20\x20\x20\x20return\x20pathname\x20===\x20a.pathname;\x0a\x20\x20});\x0a\x20\x20current.addClass(\"current\");\x0a\x20\x20var\x20ul\x20=\x20current.parents(\".collapse\").addClass(\"show\");\x0a\x20\x20ul.prev().find('[data-toggle=\"collapse\"]').removeClass(\"collapsed\");\x0a\x0a\x20\x20current.parent().next(\".collapse\").addClass(\"show\");\x0a\x0a\x20\x20var\x20$sidebar\x20=\x20$(\"#sidebar\");\x0a\x20\x20var\x20offset\x20=\x20$(\".sphinxsidebar\x20ul\x20a.current\").offset();\x0a\x20\x20offset\x20&&\x20$sidebar.scrollTop(offset.top\x20-\x20100);\x0a}\x0a\x0a(function\x20()\x20{\x0a\x0a\x20\x20initSidebar();\x0a\x0a\x20\x20//\x20bootstrap\x0a\x20\x20$('[data-toggle=\"tooltip\"]').tooltip()\x0a\x0a\x20\x20$(document).on(\"click\",\x20\"#btn-printer\",\x20function\x20()\x20{\x0a\x20\x20\x20\x20$(\"#btn-printer\").tooltip('hide');\x0a\x20\x20\x20\x20window.print();\x0a\x20\x20})\x0a\x0a})();\x0a",
+	"graph.html": "<!--\x20graph.html\x20-->\x0a{{-\x20with\x20.Graph\x20}}\x0a<h1\x20id=\"graph-title\">Import\x20graph</h1>\x0a\x0a<p>\x0a\x20\x20The\x20imports\x20between\x20the\x20{{\x20len\x20.Packages\x20}}\x20packages,\x20the\x20importers\x20above\x20the\x20imported\x20packages.\x0a\x20\x20{{-\x20if\x20not\x20$.Bundle\x20}}\x0a\x20\x20Download\x20as\x20<a\x20href=\"{{\x20abs_url\x20\"/_graph/graph.dot\"\x20}}\">DOT</a>\x20or\x20<a\x20href=\"{{\x20abs_url\x20\"/_graph/graph.json\"\x20}}\">JSON</a>.\x0a\x20\x20{{-\x20end\x20}}\x0a</p>\x0a\x0a{{-\x20with\x20.Cycles\x20}}\x0a<h2\x20id=\"graph-cycles\">Import\x20cycles</h2>\x0a<ul\x20class=\"list-imports\">\x0a\x20\x20{{-\x20range\x20.\x20}}\x0a\x20\x20<li><span\x20class=\"badge\x20badge-danger\">cycle</span>\x20{{\x20join\x20.\x20\",\x20\"\x20}}</li>\x0a\x20\x20{{-\x20end\x20}}\x0a</ul>\x0a{{-\x20end\x20}}\x0a\x0a{{-\x20with\x20.Violations\x20}}\x0a<h2\x20id=\"graph-violations\">Layering\x20violations</h2>\x0a<ul\x20class=\"list-imports\">\x0a\x20\x20{{-\x20range\x20.\x20}}\x0a\x20\x20<li><span\x20class=\"badge\x20badge-warning\">{{\x20.Violation\x20}}</span>\x20<a\x20href=\"{{\x20package_url\x20.From\x20}}\">{{\x20.From\x20}}</a>\x20imports\x20{{\x20.To\x20}}</li>\x0a\x20\x20{{-\x20end\x20}}\x0a</ul>\x0a{{-\x20end\x20}}\x0a\x0a<div\x20class=\"import-graph-image\">\x0a\x20\x20{{\x20$.GraphSVG\x20|\x20unescaped\x20}}\x0a</div>\x0a{{-\x20end\x20}}\x0a",
 
 	"jquery.js": "/*!\x20jQuery\x20v3.5.1\x20|\x20(c)\x20JS\x20Foundation\x20and\x20other\x20contributors\x20|\x20jquery.org/license\x20*/\x0a!function(e,t){\"use\x20strict\";\"object\"==typeof\x20module&&\"object\"==typeof\x20module.exports?module.exports=e.document?t(e,!0):function(e){if(!e.document)throw\x20new\x20Error(\"jQuery\x20requires\x20a\x20window\x20with\x20a\x20document\");return\x20t(e)}:t(e)}(\"undefined\"!=typeof\x20window?window:this,function(C,e){\"use\x20strict\";var\x20t=[],r=Object.getPrototypeOf,s=t.slice,g=t.flat?function(e){return\x20t.flat.call(e)}:function(e){return\x20t.concat.apply([],e)},u=t.push,i=t.indexOf,n={},o=n.toString,v=n.hasOwnProperty,a=v.toString,l=a.call(Object),y={},m=function(e){return\"function\"==typeof\x20e&&\"number\"!=typeof\x20e.nodeType},x=function(e){return\x20null!=e&&e===e.window},E=C.document,c={type:!0,src:!0,nonce:!0,noModule:!0};function\x20b(e,t,n){var\x20r,i,o=(n=n||E).createElement(\"script\");if(o.text=e,t)for(r\x20in\x20c)(i=t[r]||t.getAttribute&&t.getAttribute(r))&&o.setAttribute(r,i);n.head.appendChild(o).parentNode.removeChild(o)}function\x20w(e){return\x20null==e?e+\"\":\"object\"==typeof\x20e||\"function\"==typeof\x20e?n[o.call(e)]||\"object\":typeof\x20e}var\x20f=\"3.5.1\",S=function(e,t){return\x20new\x20S.fn.init(e,t)};function\x20p(e){var\x20t=!!e&&\"length\"in\x20e&&e.length,n=w(e);return!m(e)&&!x(e)&&(\"array\"===n||0===t||\"number\"==typeof\x20t&&0<t&&t-1\x20in\x20e)}S.fn=S.prototype={jquery:f,constructor:S,length:0,toArray:function(){return\x20s.call(this)},get:function(e){return\x20null==e?s.call(this):e<0?this[e+this.length]:this[e]},pushStack:function(e){var\x20t=S.merge(this.constructor(),e);return\x20t.prevObject=this,t},each:function(e){return\x20S.each(this,e)},map:function(n){return\x20this.pushStack(S.map(this,function(e,t){return\x20n.call(e,t,e)}))},slice:function(){return\x20this.pushStack(s.apply(this,arguments))},first:function(){return\x20this.eq(0)},last:function(){return\x20this.eq(-1)},even:function(){return\x20this.pushStack(S.grep(this,function(e,t){return(t+1)%2}))},odd:function(){return\x20this.pushStack(S.grep(this,function(e,t){return\x20t%2}))},eq:function(e){var\x20t=this.length,n=+e+(e<0?t:0);return\x20this.pushStack(0<=n&&n<t?[this[n]]:[])},end:function(){return\x20this.prevObject||this.constructor()},push:u,sort:t.sort,splice:t.splice},S.extend=S.fn.extend=function(){var\x20e,t,n,r,i,o,a=arguments[0]||{},s=1,u=arguments.length,l=!1;for(\"boolean\"==typeof\x20a&&(l=a,a=arguments[s]||{},s++),\"object\"==typeof\x20a||m(a)||(a={}),s===u&&(a=this,s--);s<u;s++)if(null!=(e=arguments[s]))for(t\x20in\x20e)r=e[t],\"__proto__\"!==t&&a!==r&&(l&&r&&(S.isPlainObject(r)||(i=Array.isArray(r)))?(n=a[t],o=i&&!Array.isArray(n)?[]:i||S.isPlainObject(n)?n:{},i=!1,a[t]=S.extend(l,o,r)):void\x200!==r&&(a[t]=r));return\x20a},S.extend({expando:\"jQuery\"+(f+Math.random()).replace(/\\D/g,\"\"),isReady:!0,error:function(e){throw\x20new\x20Error(e)},noop:function(){},isPlainObject:function(e){var\x20t,n;return!(!e||\"[object\x20Object]\"!==o.call(e))&&(!(t=r(e))||\"function\"==typeof(n=v.call(t,\"constructor\")&&t.constructor)&&a.call(n)===l)},isEmptyObject:function(e){var\x20t;for(t\x20in\x20e)return!1;return!0},globalEval:function(e,t,n){b(e,{nonce:t&&t.nonce},n)},each:function(e,t){var\x20n,r=0;if(p(e)){for(n=e.length;r<n;r++)if(!1===t.call(e[r],r,e[r]))break}else\x20for(r\x20in\x20e)if(!1===t.call(e[r],r,e[r]))break;return\x20e},makeArray:function(e,t){var\x20n=t||[];return\x20null!=e&&(p(Object(e))?S.merge(n,\"string\"==typeof\x20e?[e]:e):u.call(n,e)),n},inArray:function(e,t,n){return\x20null==t?-1:i.call(t,e,n)},merge:function(e,t){for(var\x20n=+t.length,r=0,i=e.length;r<n;r++)e[i++]=t[r];return\x20e.length=i,e},grep:function(e,t,n){for(var\x20r=[],i=0,o=e.length,a=!n;i<o;i++)!t(e[i],i)!==a&&r.push(e[i]);return\x20r},map:function(e,t,n){var\x20r,i,o=0,a=[];if(p(e))for(r=e.length;o<r;o++)null!=(i=t(e[o],o,n))&&a.push(i);else\x20for(o\x20in\x20e)null!=(i=t(e[o],o,n))&&a.push(i);return\x20g(a)},guid:1,support:y}),\"function\"==typeof\x20Symbol&&(S.fn[Symbol.iterator]=t[Symbol.iterator]),S.each(\"Boolean\x20Number\x20String\x20Function\x20Array\x20Date\x20RegExp\x20Object\x20Error\x20Symbol\".split(\"\x20\"),function(e,t){n[\"[object\x20\"+t+\"]\"]=t.toLowerCase()});var\x20d=function(n){var\x20e,d,b,o,i,h,f,g,w,u,l,T,C,a,E,v,s,c,y,S=\"sizzle\"+1*new\x20Date,p=n.document,k=0,r=0,m=ue(),x=ue(),A=ue(),N=ue(),D=function(e,t){return\x20e===t&&(l=!0),0},j={}.hasOwnProperty,t=[],q=t.pop,L=t.push,H=t.push,O=t.slice,P=function(e,t){for(var\x20n=0,r=e.length;n<r;n++)if(e[n]===t)return\x20n;return-1},R=\"checked|selected|async|autofocus|autoplay|controls|defer|disabled|hidden|ismap|loop|multiple|open|readonly|required|scoped\",M=\"[\\\\x20\\\\t\\\\r\\\\n\\\\f]\",I=\"(?:\\\\\\\\[\\\\da-fA-F]{1,6}\"+M+\"?|\\\\\\\\[^\\\\r\\\\n\\\\f]|[\\\\w-]|[^\\0-\\\\x7f])+\",W=\"\\\\[\"+M+\"*(\"+I+\")(?:\"+M+\"*([*^$|!~]?=)\"+M+\"*(?:'((?:\\\\\\\\.|[^\\\\\\\\'])*)'|\\\"((?:\\\\\\\\.|[^\\\\\\\\\\\"])*)\\\"|(\"+I+\"))|)\"+M+\"*\\\\]\",F=\":(\"+I+\")(?:\\\\((('((?:\\\\\\\\.|[^\\\\\\\\'])*)'|\\\"((?:\\\\\\\\.|[^\\\\\\\\\\\"])*)\\\")|((?:\\\\\\\\.|[^\\\\\\\\()[\\\\]]|\"+W+\")*)|.*)\\\\)|)\",B=new\x20RegExp(M+\"+\",\"g\"),$=new\x20RegExp(\"^\"+M+\"+|((?:^|[^\\\\\\\\])(?:\\\\\\\\.)*)\"+M+\"+$\",\"g\"),_=new\x20RegExp(\"^\"+M+\"*,\"+M+\"*\"),z=new\x20RegExp(\"^\"+M+\"*([>+~]|\"+M+\")\"+M+\"*\"),U=new\x20RegExp(M+\"|>\"),X=new\x20RegExp(F),V=new\x20RegExp(\"^\"+I+\"$\"),G={ID:new\x20RegExp(\"^#(\"+I+\")\"),CLASS:new\x20RegExp(\"^\\\\.(\"+I+\")\"),TAG:new\x20RegExp(\"^(\"+I+\"|[*])\"),ATTR:new\x20RegExp(\"^\"+W),PSEUDO:new\x20RegExp(\"^\"+F),CHILD:new\x20RegExp(\"^:(only|first|last|nth|nth-last)-(child|of-type)(?:\\\\(\"+M+\"*(even|odd|(([+-]|)(\\\\d*)n|)\"+M+\"*(?:([+-]|)\"+M+\"*(\\\\d+)|))\"+M+\"*\\\\)|)\",\"i\"),bool:new\x20RegExp(\"^(?:\"+R+\")$\",\"i\"),needsContext:new\x20RegExp(\"^\"+M+\"*[>+~]|:(even|odd|eq|gt|lt|nth|first|last)(?:\\\\(\"+M+\"*((?:-\\\\d)?\\\\d*)\"+M+\"*\\\\)|)(?=[^-]|$)\",\"i\")},Y=/HTML$/i,Q=/^(?:input|select|textarea|button)$/i,J=/^h\\d$/i,K=/^[^{]+\\{\\s*\\[native\x20\\w/,Z=/^(?:#([\\w-]+)|(\\w+)|\\.([\\w-]+))$/,ee=/[+~]/,te=new\x20RegExp(\"\\\\\\\\[\\\\da-fA-F]{1,6}\"+M+\"?|\\\\\\\\([^\\\\r\\\\n\\\\f])\",\"g\"),ne=function(e,t){var\x20n=\"0x\"+e.slice(1)-65536;return\x20t||(n<0?String.fromCharCode(n+65536):String.fromCharCode(n>>10|55296,1023&n|56320))},re=/([\\0-\\x1f\\x7f]|^-?\\d)|^-$|[^\\0-\\x1f\\x7f-\\uFFFF\\w-]/g,ie=function(e,t){return\x20t?\"\\0\"===e?\"\\ufffd\":e.slice(0,-1)+\"\\\\\"+e.charCodeAt(e.length-1).toString(16)+\"\x20\":\"\\\\\"+e},oe=function(){T()},ae=be(function(e){return!0===e.disabled&&\"fieldset\"===e.nodeName.toLowerCase()},{dir:\"parentNode\",next:\"legend\"});try{H.apply(t=O.call(p.childNodes),p.childNodes),t[p.childNodes.length].nodeType}catch(e){H={apply:t.length?function(e,t){L.apply(e,O.call(t))}:function(e,t){var\x20n=e.length,r=0;while(e[n++]=t[r++]);e.length=n-1}}}function\x20se(t,e,n,r){var\x20i,o,a,s,u,l,c,f=e&&e.ownerDocument,p=e?e.nodeType:9;if(n=n||[],\"string\"!=typeof\x20t||!t||1!==p&&9!==p&&11!==p)return\x20n;if(!r&&(T(e),e=e||C,E)){if(11!==p&&(u=Z.exec(t)))if(i=u[1]){if(9===p){if(!(a=e.getElementById(i)))return\x20n;if(a.id===i)return\x20n.push(a),n}else\x20if(f&&(a=f.getElementById(i))&&y(e,a)&&a.id===i)return\x20n.push(a),n}else{if(u[2])return\x20H.apply(n,e.getElementsByTagName(t)),n;if((i=u[3])&&d.getElementsByClassName&&e.getElementsByClassName)return\x20H.apply(n,e.getElementsByClassName(i)),n}if(d.qsa&&!N[t+\"\x20\"]&&(!v||!v.test(t))&&(1!==p||\"object\"!==e.nodeName.toLowerCase())){if(c=t,f=e,1===p&&(U.test(t)||z.test(t))){(f=ee.test(t)&&ye(e.parentNode)||e)===e&&d.scope||((s=e.getAttribute(\"id\"))?s=s.replace(re,ie):e.setAttribute(\"id\",s=S)),o=(l=h(t)).length;while(o--)l[o]=(s?\"#\"+s:\":scope\")+\"\x20\"+xe(l[o]);c=l.join(\",\")}try{return\x20H.apply(n,f.querySelectorAll(c)),n}catch(e){N(t,!0)}finally{s===S&&e.removeAttribute(\"id\")}}}return\x20g(t.replace($,\"$1\"),e,n,r)}function\x20ue(){var\x20r=[];return\x20function\x20e(t,n){return\x20r.push(t+\"\x20\")>b.cacheLength&&delete\x20e[r.shift()],e[t+\"\x20\"]=n}}function\x20le(e){return\x20e[S]=!0,e}function\x20ce(e){var\x20t=C.createElement(\"fieldset\");try{return!!e(t)}catch(e){return!1}finally{t.parentNode&&t.parentNode.removeChild(t),t=null}}function\x20fe(e,t){var\x20n=e.split(\"|\"),r=n.length;while(r--)b.attrHandle[n[r]]=t}function\x20pe(e,t){var\x20n=t&&e,r=n&&1===e.nodeType&&1===t.nodeType&&e.sourceIndex-t.sourceIndex;if(r)return\x20r;if(n)while(n=n.nextSibling)if(n===t)return-1;return\x20e?1:-1}function\x20de(t){return\x20function(e){return\"input\"===e.nodeName.toLowerCase()&&e.type===t}}function\x20he(n){return\x20function(e){var\x20t=e.nodeName.toLowerCase();return(\"input\"===t||\"button\"===t)&&e.type===n}}function\x20ge(t){return\x20function(e){return\"form\"in\x20e?e.parentNode&&!1===e.disabled?\"label\"in\x20e?\"label\"in\x20e.parentNode?e.parentNode.disabled===t:e.disabled===t:e.isDisabled===t||e.isDisabled!==!t&&ae(e)===t:e.disabled===t:\"label\"in\x20e&&e.disabled===t}}function\x20ve(a){return\x20le(function(o){return\x20o=+o,le(function(e,t){var\x20n,r=a([],e.length,o),i=r.length;while(i--)e[n=r[i]]&&(e[n]=!(t[n]=e[n]))})})}function\x20ye(e){return\x20e&&\"undefined\"!=typeof\x20e.getElementsByTagName&&e}for(e\x20in\x20d=se.support={},i=se.isXML=function(e){var\x20t=e.namespaceURI,n=(e.ownerDocument||e).documentElement;return!Y.test(t||n&&n.nodeName||\"HTML\")},T=se.setDocument=function(e){var\x20t,n,r=e?e.ownerDocument||e:p;return\x20r!=C&&9===r.nodeType&&r.documentElement&&(a=(C=r).documentElement,E=!i(C),p!=C&&(n=C.defaultView)&&n.top!==n&&(n.addEventListener?n.addEventListener(\"unload\",oe,!1):n.attachEvent&&n.attachEvent(\"onunload\",oe)),d.scope=ce(function(e){return\x20a.appendChild(e).appendChild(C.createElement(\"div\")),\"undefined\"!=typeof\x20e.querySelectorAll&&!e.querySelectorAll(\":scope\x20fieldset\x20div\").length}),d.attributes=ce(function(e){return\x20e.className=\"i\",!e.getAttribute(\"className\")}),d.getElementsByTagName=ce(function(e){return\x20e.appendChild(C.createComment(\"\")),!e.getElementsByTagName(\"*\").length}),d.getElementsByClassName=K.test(C.getElementsByClassName),d.getById=ce(function(e){return\x20a.appendChild(e).id=S,!C.getElementsByName||!C.getElementsByName(S).length}),d.getById?(b.filter.ID=function(e){var\x20t=e.replace(te,ne);return\x20function(e){return\x20e.getAttribute(\"id\")===t}},b.find.ID=function(e,t){if(\"undefined\"!=typeof\x20t.getElementById&&E){var\x20n=t.getElementById(e);return\x20n?[n]:[]}}):(b.filter.ID=function(e){var\x20n=e.replace(te,ne);return\x20function(e){var\x20t=\"undefined\"!=typeof\x20e.getAttributeNode&&e.getAttributeNode(\"id\");return\x20t&&t.value===n}},b.find.ID=function(e,t){if(\"undefined\"!=typeof\x20t.getElementById&&E){var\x20n,r,i,o=t.getElementById(e);if(o){if((n=o.getAttributeNode(\"id\"))&&n.value===e)return[o];i=t.getElementsByName(e),r=0;while(o=i[r++])if((n=o.getAttributeNode(\"id\"))&&n.value===e)return[o]}return[]}}),b.find.TAG=d.getElementsByTagName?function(e,t){return\"undefined\"!=typeof\x20t.getElementsByTagName?t.getElementsByTagName(e):d.qsa?t.querySelectorAll(e):void\x200}:function(e,t){var\x20n,r=[],i=0,o=t.getElementsByTagName(e);if(\"*\"===e){while(n=o[i++])1===n.nodeType&&r.push(n);return\x20r}return\x20o},b.find.CLASS=d.getElementsByClassName&&function(e,t){if(\"undefined\"!=typeof\x20t.getElementsByClassName&&E)return\x20t.getElementsByClassName(e)},s=[],v=[],(d.qsa=K.test(C.querySelectorAll))&&(ce(function(e){var\x20t;a.appendChild(e).innerHTML=\"<a\x20id='\"+S+\"'></a><select\x20id='\"+S+\"-\\r\\\\'\x20msallowcapture=''><option\x20selected=''></option></select>\",e.querySelectorAll(\"[msallowcapture^='']\").length&&v.push(\"[*^$]=\"+M+\"*(?:''|\\\"\\\")\"),e.querySelectorAll(\"[selected]\").length||v.push(\"\\\\[\"+M+\"*(?:value|\"+R+\")\"),e.querySelectorAll(\"[id~=\"+S+\"-]\").length||v.push(\"~=\"),(t=C.createElement(\"input\")).setAttribute(\"name\",\"\"),e.appendChild(t),e.querySelectorAll(\"[name='']\").length||v.push(\"\\\\[\"+M+\"*name\"+M+\"*=\"+M+\"*(?:''|\\\"\\\")\"),e.querySelectorAll(\":checked\").length||v.push(\":checked\"),e.querySelectorAll(\"a#\"+S+\"+*\").length||v.push(\".#.+[+~]\"),e.querySelectorAll(\"\\\\\\f\"),v.push(\"[\\\\r\\\\n\\\\f]\")}),ce(function(e){e.innerHTML=\"<a\x20href=''\x20disabled='disabled'></a><select\x20disabled='disabled'><option/></select>\";var\x20t=C.createElement(\"input\");t.setAttribute(\"type\",\"hidden\"),e.appendChild(t).setAttribute(\"name\",\"D\"),e.querySelectorAll(\"[name=d]\").length&&v.push(\"name\"+M+\"*[*^$|!~]?=\"),2!==e.querySelectorAll(\":enabled\").length&&v.push(\":enabled\",\":disabled\"),a.appendChild(e).disabled=!0,2!==e.querySelectorAll(\":disabled\").length&&v.push(\":enabled\",\":disabled\"),e.querySelectorAll(\"*,:x\"),v.push(\",.*:\")})),(d.matchesSelector=K.test(c=a.matches||a.webkitMatchesSelector||a.mozMatchesSelector||a.oMatchesSelector||a.msMatchesSelector))&&ce(function(e){d.disconnectedMatch=c.call(e,\"*\"),c.call(e,\"[s!='']:x\"),s.push(\"!=\",F)}),v=v.length&&new\x20RegExp(v.join(\"|\")),s=s.length&&new\x20RegExp(s.join(\"|\")),t=K.test(a.compareDocumentPosition),y=t||K.test(a.contains)?function(e,t){var\x20n=9===e.nodeType?e.documentElement:e,r=t&&t.parentNode;return\x20e===r||!(!r||1!==r.nodeType||!(n.contains?n.contains(r):e.compareDocumentPosition&&16&e.compareDocumentPosition(r)))}:function(e,t){if(t)while(t=t.parentNode)if(t===e)return!0;return!1},D=t?function(e,t){if(e===t)return\x20l=!0,0;var\x20n=!e.compareDocumentPosition-!t.compareDocumentPosition;return\x20n||(1&(n=(e.ownerDocument||e)==(t.ownerDocument||t)?e.compareDocumentPosition(t):1)||!d.sortDetached&&t.compareDocumentPosition(e)===n?e==C||e.ownerDocument==p&&y(p,e)?-1:t==C||t.ownerDocument==p&&y(p,t)?1:u?P(u,e)-P(u,t):0:4&n?-1:1)}:function(e,t){if(e===t)return\x20l=!0,0;var\x20n,r=0,i=e.parentNode,o=t.parentNode,a=[e],s=[t];if(!i||!o)return\x20e==C?-1:t==C?1:i?-1:o?1:u?P(u,e)-P(u,t):0;if(i===o)return\x20pe(e,t);n=e;while(n=n.parentNode)a.unshift(n);n=t;while(n=n.parentNode)s.unshift(n);while(a[r]===s[r])r++;return\x20r?pe(a[r],s[r]):a[r]==p?-1:s[r]==p?1:0}),C},se.matches=function(e,t){return\x20se(e,null,null,t)},se.matchesSelector=function(e,t){if(T(e),d.matchesSelector&&E&&!N[t+\"\x20\"]&&(!s||!s.test(t))&&(!v||!v.test(t)))try{var\x20n=c.call(e,t);if(n||d.disconnectedMatch||e.document&&11!==e.document.nodeType)return\x20n}catch(e){N(t,!0)}return\x200<se(t,C,null,[e]).length},se.contains=function(e,t){return(e.ownerDocument||e)!=C&&T(e),y(e,t)},se.attr=function(e,t){(e.ownerDocument||e)!=C&&T(e);var\x20n=b.attrHandle[t.toLowerCase()],r=n&&j.call(b.attrHandle,t.toLowerCase())?n(e,t,!E):void\x200;return\x20void\x200!==r?r:d.attributes||!E?e.getAttribute(t):(r=e.getAttributeNode(t))&&r.specified?r.value:null},se.escape=function(e){return(e+\"\").replace(re,ie)},se.error=function(e){throw\x20new\x20Error(\"Syntax\x20error,\x20unrecognized\x20expression:\x20\"+e)},se.uniqueSort=function(e){var\x20t,n=[],r=0,i=0;if(l=!d.detectDuplicates,u=!d.sortStable&&e.slice(0),e.sort(D),l){while(t=e[i++])t===e[i]&&(r=n.push(i));while(r--)e.splice(n[r],1)}return\x20u=null,e},o=se.getText=function(e){var\x20t,n=\"\",r=0,i=e.nodeType;if(i){if(1===i||9===i||11===i){if(\"string\"==typeof\x20e.textContent)return\x20e.textContent;for(e=e.firstChild;e;e=e.nextSibling)n+=o(e)}else\x20if(3===i||4===i)return\x20e.nodeValue}else\x20while(t=e[r++])n+=o(t);return\x20n},(b=se.selectors={cacheLength:50,createPseudo:le,match:G,attrHandle:{},find:{},relative:{\">\":{dir:\"parentNode\",first:!0},\"\x20\":{dir:\"parentNode\"},\"+\":{dir:\"previousSibling\",first:!0},\"~\":{dir:\"previousSibling\"}},preFilter:{ATTR:function(e){return\x20e[1]=e[1].replace(te,ne),e[3]=(e[3]||e[4]||e[5]||\"\").replace(te,ne),\"~=\"===e[2]&&(e[3]=\"\x20\"+e[3]+\"\x20\"),e.slice(0,4)},CHILD:function(e){return\x20e[1]=e[1].toLowerCase(),\"nth\"===e[1].slice(0,3)?(e[3]||se.error(e[0]),e[4]=+(e[4]?e[5]+(e[6]||1):2*(\"even\"===e[3]||\"odd\"===e[3])),e[5]=+(e[7]+e[8]||\"odd\"===e[3])):e[3]&&se.error(e[0]),e},PSEUDO:function(e){var\x20t,n=!e[6]&&e[2];return\x20G.CHILD.test(e[0])?null:(e[3]?e[2]=e[4]||e[5]||\"\":n&&X.test(n)&&(t=h(n,!0))&&(t=n.indexOf(\")\",n.length-t)-n.length)&&(e[0]=e[0].slice(0,t),e[2]=n.slice(0,t)),e.slice(0,3))}},filter:{TAG:function(e){var\x20t=e.replace(te,ne).toLowerCase();return\"*\"===e?function(){return!0}:function(e){return\x20e.nodeName&&e.nodeName.toLowerCase()===t}},CLASS:function(e){var\x20t=m[e+\"\x20\"];return\x20t||(t=new\x20RegExp(\"(^|\"+M+\")\"+e+\"(\"+M+\"|$)\"))&&m(e,function(e){return\x20t.test(\"string\"==typeof\x20e.className&&e.className||\"undefined\"!=typeof\x20e.getAttribute&&e.getAttribute(\"class\")||\"\")})},ATTR:function(n,r,i){return\x20function(e){var\x20t=se.attr(e,n);return\x20null==t?\"!=\"===r:!r||(t+=\"\",\"=\"===r?t===i:\"!=\"===r?t!==i:\"^=\"===r?i&&0===t.indexOf(i):\"*=\"===r?i&&-1<t.indexOf(i):\"$=\"===r?i&&t.slice(-i.length)===i:\"~=\"===r?-1<(\"\x20\"+t.replace(B,\"\x20\")+\"\x20\").indexOf(i):\"|=\"===r&&(t===i||t.slice(0,i.length+1)===i+\"-\"))}},CHILD:function(h,e,t,g,v){var\x20y=\"nth\"!==h.slice(0,3),m=\"last\"!==h.slice(-4),x=\"of-type\"===e;return\x201===g&&0===v?function(e){return!!e.parentNode}:function(e,t,n){var\x20r,i,o,a,s,u,l=y!==m?\"nextSibling\":\"previousSibling\",c=e.parentNode,f=x&&e.nodeName.toLowerCase(),p=!n&&!x,d=!1;if(c){if(y){while(l){a=e;while(a=a[l])if(x?a.nodeName.toLowerCase()===f:1===a.nodeType)return!1;u=l=\"only\"===h&&!u&&\"nextSibling\"}return!0}if(u=[m?c.firstChild:c.lastChild],m&&p){d=(s=(r=(i=(o=(a=c)[S]||(a[S]={}))[a.uniqueID]||(o[a.uniqueID]={}))[h]||[])[0]===k&&r[1])&&r[2],a=s&&c.childNodes[s];while(a=++s&&a&&a[l]||(d=s=0)||u.pop())if(1===a.nodeType&&++d&&a===e){i[h]=[k,s,d];break}}else\x20if(p&&(d=s=(r=(i=(o=(a=e)[S]||(a[S]={}))[a.uniqueID]||(o[a.uniqueID]={}))[h]||[])[0]===k&&r[1]),!1===d)while(a=++s&&a&&a[l]||(d=s=0)||u.pop())if((x?a.nodeName.toLowerCase()===f:1===a.nodeType)&&++d&&(p&&((i=(o=a[S]||(a[S]={}))[a.uniqueID]||(o[a.uniqueID]={}))[h]=[k,d]),a===e))break;return(d-=v)===g||d%g==0&&0<=d/g}}},PSEUDO:function(e,o){var\x20t,a=b.pseudos[e]||b.setFilters[e.toLowerCase()]||se.error(\"unsupported\x20pseudo:\x20\"+e);return\x20a[S]?a(o):1<a.length?(t=[e,e,\"\",o],b.setFilters.hasOwnProperty(e.toLowerCase())?le(function(e,t){var\x20n,r=a(e,o),i=r.length;while(i--)e[n=P(e,r[i])]=!(t[n]=r[i])}):function(e){return\x20a(e,0,t)}):a}},pseudos:{not:le(function(e){var\x20r=[],i=[],s=f(e.replace($,\"$1\"));return\x20s[S]?le(function(e,t,n,r){var\x20i,o=s(e,null,r,[]),a=e.length;while(a--)(i=o[a])&&(e[a]=!(t[a]=i))}):function(e,t,n){return\x20r[0]=e,s(r,null,n,i),r[0]=null,!i.pop()}}),has:le(function(t){return\x20function(e){return\x200<se(t,e).length}}),contains:le(function(t){return\x20t=t.replace(te,ne),function(e){return-1<(e.textContent||o(e)).indexOf(t)}}),lang:le(function(n){return\x20V.test(n||\"\")||se.error(\"unsupported\x20lang:\x20\"+n),n=n.replace(te,ne).toLowerCase(),function(e){var\x20t;do{if(t=E?e.lang:e.getAttribute(\"xml:lang\")||e.getAttribute(\"lang\"))return(t=t.toLowerCase())===n||0===t.indexOf(n+\"-\")}while((e=e.parentNode)&&1===e.nodeType);return!1}}),target:function(e){var\x20t=n.location&&n.location.hash;return\x20t&&t.slice(1)===e.id},root:function(e){return\x20e===a},focus:function(e){return\x20e===C.activeElement&&(!C.hasFocus||C.hasFocus())&&!!(e.type||e.href||~e.tabIndex)},enabled:ge(!1),disabled:ge(!0),checked:function(e){var\x20t=e.nodeName.toLowerCase();return\"input\"===t&&!!e.checked||\"option\"===t&&!!e.selected},selected:function(e){return\x20e.parentNode&&e.parentNode.selectedIndex,!0===e.selected},empty:function(e){for(e=e.firstChild;e;e=e.nextSibling)if(e.nodeType<6)return!1;return!0},parent:function(e){return!b.pseudos.empty(e)},header:function(e){return\x20J.test(e.nodeName)},input:function(e){return\x20Q.test(e.nodeName)},button:function(e){var\x20t=e.nodeName.toLowerCase();return\"input\"===t&&\"button\"===e.type||\"button\"===t},text:function(e){var\x20t;return\"input\"===e.nodeName.toLowerCase()&&\"text\"===e.type&&(null==(t=e.getAttribute(\"type\"))||\"text\"===t.toLowerCase())},first:ve(function(){return[0]}),last:ve(function(e,t){return[t-1]}),eq:ve(function(e,t,n){return[n<0?n+t:n]}),even:ve(function(e,t){for(var\x20n=0;n<t;n+=2)e.push(n);return\x20e}),odd:ve(function(e,t){for(var\x20n=1;n<t;n+=2)e.push(n);return\x20e}),lt:ve(function(e,t,n){for(var\x20r=n<0?n+t:t<n?t:n;0<=--r;)e.push(r);return\x20e}),gt:ve(function(e,t,n){for(var\x20r=n<0?n+t:n;++r<t;)e.push(r);return\x20e})}}).pseudos.nth=b.pseudos.eq,{radio:!0,checkbox:!0,file:!0,password:!0,image:!0})b.pseudos[e]=de(e);for(e\x20in{submit:!0,reset:!0})b.pseudos[e]=he(e);function\x20me(){}function\x20xe(e){for(var\x20t=0,n=e.length,r=\"\";t<n;t++)r+=e[t].value;return\x20r}function\x20be(s,e,t){var\x20u=e.dir,l=e.next,c=l||u,f=t&&\"parentNode\"===c,p=r++;return\x20e.first?function(e,t,n){while(e=e[u])if(1===e.nodeType||f)return\x20s(e,t,n);return!1}:function(e,t,n){var\x20r,i,o,a=[k,p];if(n){while(e=e[u])if((1===e.nodeType||f)&&s(e,t,n))return!0}else\x20while(e=e[u])if(1===e.nodeType||f)if(i=(o=e[S]||(e[S]={}))[e.uniqueID]||(o[e.uniqueID]={}),l&&l===e.nodeName.toLowerCase())e=e[u]||e;else{if((r=i[c])&&r[0]===k&&r[1]===p)return\x20a[2]=r[2];if((i[c]=a)[2]=s(e,t,n))return!0}return!1}}function\x20we(i){return\x201<i.length?function(e,t,n){var\x20r=i.length;while(r--)if(!i[r](e,t,n))return!1;return!0}:i[0]}function\x20Te(e,t,n,r,i){for(var\x20o,a=[],s=0,u=e.length,l=null!=t;s<u;s++)(o=e[s])&&(n&&!n(o,r,i)||(a.push(o),l&&t.push(s)));return\x20a}function\x20Ce(d,h,g,v,y,e){return\x20v&&!v[S]&&(v=Ce(v)),y&&!y[S]&&(y=Ce(y,e)),le(function(e,t,n,r){var\x20i,o,a,s=[],u=[],l=t.length,c=e||function(e,t,n){for(var\x20r=0,i=t.length;r<i;r++)se(e,t[r],n);return\x20n}(h||\"*\",n.nodeType?[n]:n,[]),f=!d||!e&&h?c:Te(c,s,d,n,r),p=g?y||(e?d:l||v)?[]:t:f;if(g&&g(f,p,n,r),v){i=Te(p,u),v(i,[],n,r),o=i.length;while(o--)(a=i[o])&&(p[u[o]]=!(f[u[o]]=a))}if(e){if(y||d){if(y){i=[],o=p.length;while(o--)(a=p[o])&&i.push(f[o]=a);y(null,p=[],i,r)}o=p.length;while(o--)(a=p[o])&&-1<(i=y?P(e,a):s[o])&&(e[i]=!(t[i]=a))}}else\x20p=Te(p===t?p.splice(l,p.length):p),y?y(null,t,p,r):H.apply(t,p)})}function\x20Ee(e){for(var\x20i,t,n,r=e.length,o=b.relative[e[0].type],a=o||b.relative[\"\x20\"],s=o?1:0,u=be(function(e){return\x20e===i},a,!0),l=be(function(e){return-1<P(i,e)},a,!0),c=[function(e,t,n){var\x20r=!o&&(n||t!==w)||((i=t).nodeType?u(e,t,n):l(e,t,n));return\x20i=null,r}];s<r;s++)if(t=b.relative[e[s].type])c=[be(we(c),t)];else{if((t=b.filter[e[s].type].apply(null,e[s].matches))[S]){for(n=++s;n<r;n++)if(b.relative[e[n].type])break;return\x20Ce(1<s&&we(c),1<s&&xe(e.slice(0,s-1).concat({value:\"\x20\"===e[s-2].type?\"*\":\"\"})).replace($,\"$1\"),t,s<n&&Ee(e.slice(s,n)),n<r&&Ee(e=e.slice(n)),n<r&&xe(e))}c.push(t)}return\x20we(c)}return\x20me.prototype=b.filters=b.pseudos,b.setFilters=new\x20me,h=se.tokenize=function(e,t){var\x20n,r,i,o,a,s,u,l=x[e+\"\x20\"];if(l)return\x20t?0:l.slice(0);a=e,s=[],u=b.preFilter;while(a){for(o\x20in\x20n&&!(r=_.exec(a))||(r&&(a=a.slice(r[0].length)||a),s.push(i=[])),n=!1,(r=z.exec(a))&&(n=r.shift(),i.push({value:n,type:r[0].replace($,\"\x20\")}),a=a.slice(n.length)),b.filter)!(r=G[o].exec(a))||u[o]&&!(r=u[o](r))||(n=r.shift(),i.push({value:n,type:o,matches:r}),a=a.slice(n.length));if(!n)break}return\x20t?a.length:a?se.error(e):x(e,s).slice(0)},f=se.compile=function(e,t){var\x20n,v,y,m,x,r,i=[],o=[],a=A[e+\"\x20\"];if(!a){t||(t=h(e)),n=t.length;while(n--)(a=Ee(t[n]))[S]?i.push(a):o.push(a);(a=A(e,(v=o,m=0<(y=i).length,x=0<v.length,r=function(e,t,n,r,i){var\x20o,a,s,u=0,l=\"0\",c=e&&[],f=[],p=w,d=e||x&&b.find.TAG(\"*\",i),h=k+=null==p?1:Math.random()||.1,g=d.length;for(i&&(w=t==C||t||i);l!==g&&null!=(o=d[l]);l++){if(x&&o){a=0,t||o.ownerDocument==C||(T(o),n=!E);while(s=v[a++])if(s(o,t||C,n)){r.push(o);break}i&&(k=h)}m&&((o=!s&&o)&&u--,e&&c.push(o))}if(u+=l,m&&l!==u){a=0;while(s=y[a++])s(c,f,t,n);if(e){if(0<u)while(l--)c[l]||f[l]||(f[l]=q.call(r));f=Te(f)}H.apply(r,f),i&&!e&&0<f.length&&1<u+y.length&&se.uniqueSort(r)}return\x20i&&(k=h,w=p),c},m?le(r):r))).selector=e}return\x20a},g=se.select=function(e,t,n,r){var\x20i,o,a,s,u,l=\"function\"==typeof\x20e&&e,c=!r&&h(e=l.selector||e);if(n=n||[],1===c.length){if(2<(o=c[0]=c[0].slice(0)).length&&\"ID\"===(a=o[0]).type&&9===t.nodeType&&E&&b.relative[o[1].type]){if(!(t=(b.find.ID(a.matches[0].replace(te,ne),t)||[])[0]))return\x20n;l&&(t=t.parentNode),e=e.slice(o.shift().value.length)}i=G.needsContext.test(e)?0:o.length;while(i--){if(a=o[i],b.relative[s=a.type])break;if((u=b.find[s])&&(r=u(a.matches[0].replace(te,ne),ee.test(o[0].type)&&ye(t.parentNode)||t))){if(o.splice(i,1),!(e=r.length&&xe(o)))return\x20H.apply(n,r),n;break}}}return(l||f(e,c))(r,t,!E,n,!t||ee.test(e)&&ye(t.parentNode)||t),n},d.sortStable=S.split(\"\").sort(D).join(\"\")===S,d.detectDuplicates=!!l,T(),d.sortDetached=ce(function(e){return\x201&e.compareDocumentPosition(C.createElement(\"fieldset\"))}),ce(function(e){return\x20e.innerHTML=\"<a\x20href='#'></a>\",\"#\"===e.firstChild.getAttribute(\"href\")})||fe(\"type|href|height|width\",function(e,t,n){if(!n)return\x20e.getAttribute(t,\"type\"===t.toLowerCase()?1:2)}),d.attributes&&ce(function(e){return\x20e.innerHTML=\"<input/>\",e.firstChild.setAttribute(\"value\",\"\"),\"\"===e.firstChild.getAttribute(\"value\")})||fe(\"value\",function(e,t,n){if(!n&&\"input\"===e.nodeName.toLowerCase())return\x20e.defaultValue}),ce(function(e){return\x20null==e.getAttribute(\"disabled\")})||fe(R,function(e,t,n){var\x20r;if(!n)return!0===e[t]?t.toLowerCase():(r=e.getAttributeNode(t))&&r.specified?r.value:null}),se}(C);S.find=d,S.expr=d.selectors,S.expr[\":\"]=S.expr.pseudos,S.uniqueSort=S.unique=d.uniqueSort,S.text=d.getText,S.isXMLDoc=d.isXML,S.contains=d.contains,S.escapeSelector=d.escape;var\x20h=function(e,t,n){var\x20r=[],i=void\x200!==n;while((e=e[t])&&9!==e.nodeType)if(1===e.nodeType){if(i&&S(e).is(n))break;r.push(e)}return\x20r},T=function(e,t){for(var\x20n=[];e;e=e.nextSibling)1===e.nodeType&&e!==t&&n.push(e);return\x20n},k=S.expr.match.needsContext;function\x20A(e,t){return\x20e.nodeName&&e.nodeName.toLowerCase()===t.toLowerCase()}var\x20N=/^<([a-z][^\\/\\0>:\\x20\\t\\r\\n\\f]*)[\\x20\\t\\r\\n\\f]*\\/?>(?:<\\/\\1>|)$/i;function\x20D(e,n,r){return\x20m(n)?S.grep(e,function(e,t){return!!n.call(e,t,e)!==r}):n.nodeType?S.grep(e,function(e){return\x20e===n!==r}):\"string\"!=typeof\x20n?S.grep(e,function(e){return-1<i.call(n,e)!==r}):S.filter(n,e,r)}S.filter=function(e,t,n){var\x20r=t[0];return\x20n&&(e=\":not(\"+e+\")\"),1===t.length&&1===r.nodeType?S.find.matchesSelector(r,e)?[r]:[]:S.find.matches(e,S.grep(t,function(e){return\x201===e.nodeType}))},S.fn.extend({find:function(e){var\x20t,n,r=this.length,i=this;if(\"string\"!=typeof\x20e)return\x20this.pushStack(S(e).filter(function(){for(t=0;t<r;t++)if(S.contains(i[t],this))return!0}));for(n=this.pushStack([]),t=0;t<r;t++)S.find(e,i[t],n);return\x201<r?S.uniqueSort(n):n},filter:function(e){return\x20this.pushStack(D(this,e||[],!1))},not:function(e){return\x20this.pushStack(D(this,e||[],!0))},is:function(e){return!!D(this,\"string\"==typeof\x20e&&k.test(e)?S(e):e||[],!1).length}});var\x20j,q=/^(?:\\s*(<[\\w\\W]+>)[^>]*|#([\\w-]+))$/;(S.fn.init=function(e,t,n){var\x20r,i;if(!e)return\x20this;if(n=n||j,\"string\"==typeof\x20e){if(!(r=\"<\"===e[0]&&\">\"===e[e.length-1]&&3<=e.length?[null,e,null]:q.exec(e))||!r[1]&&t)return!t||t.jquery?(t||n).find(e):this.constructor(t).find(e);if(r[1]){if(t=t\x20instanceof\x20S?t[0]:t,S.merge(this,S.parseHTML(r[1],t&&t.nodeType?t.ownerDocument||t:E,!0)),N.test(r[1])&&S.isPlainObject(t))for(r\x20in\x20t)m(this[r])?this[r](t[r]):this.attr(r,t[r]);return\x20this}return(i=E.getElementById(r[2]))&&(this[0]=i,this.length=1),this}return\x20e.nodeType?(this[0]=e,this.length=1,this):m(e)?void\x200!==n.ready?n.ready(e):e(S):S.makeArray(e,this)}).prototype=S.fn,j=S(E);var\x20L=/^(?:parents|prev(?:Until|All))/,H={children:!0,contents:!0,next:!0,prev:!0};function\x20O(e,t){while((e=e[t])&&1!==e.nodeType);return\x20e}S.fn.extend({has:function(e){var\x20t=S(e,this),n=t.length;return\x20this.filter(function(){for(var\x20e=0;e<n;e++)if(S.contains(this,t[e]))return!0})},closest:function(e,t){var\x20n,r=0,i=this.length,o=[],a=\"string\"!=typeof\x20e&&S(e);if(!k.test(e))for(;r<i;r++)for(n=this[r];n&&n!==t;n=n.parentNode)if(n.nodeType<11&&(a?-1<a.index(n):1===n.nodeType&&S.find.matchesSelector(n,e))){o.push(n);break}return\x20this.pushStack(1<o.length?S.uniqueSort(o):o)},index:function(e){return\x20e?\"string\"==typeof\x20e?i.call(S(e),this[0]):i.call(this,e.jquery?e[0]:e):this[0]&&this[0].parentNode?this.first().prevAll().length:-1},add:function(e,t){return\x20this.pushStack(S.uniqueSort(S.merge(this.get(),S(e,t))))},addBack:function(e){return\x20this.add(null==e?this.prevObject:this.prevObject.filter(e))}}),S.each({parent:function(e){var\x20t=e.parentNode;return\x20t&&11!==t.nodeType?t:null},parents:function(e){return\x20h(e,\"parentNode\")},parentsUntil:function(e,t,n){return\x20h(e,\"parentNode\",n)},next:function(e){return\x20O(e,\"nextSibling\")},prev:function(e){return\x20O(e,\"previousSibling\")},nextAll:function(e){return\x20h(e,\"nextSibling\")},prevAll:function(e){return\x20h(e,\"previousSibling\")},nextUntil:function(e,t,n){return\x20h(e,\"nextSibling\",n)},prevUntil:function(e,t,n){return\x20h(e,\"previousSibling\",n)},siblings:function(e){return\x20T((e.parentNode||{}).firstChild,e)},children:function(e){return\x20T(e.firstChild)},contents:function(e){return\x20null!=e.contentDocument&&r(e.contentDocument)?e.contentDocument:(A(e,\"template\")&&(e=e.content||e),S.merge([],e.childNodes))}},function(r,i){S.fn[r]=function(e,t){var\x20n=S.map(this,i,e);return\"Until\"!==r.slice(-5)&&(t=e),t&&\"string\"==typeof\x20t&&(n=S.filter(t,n)),1<this.length&&(H[r]||S.uniqueSort(n),L.test(r)&&n.reverse()),this.pushStack(n)}});var\x20P=/[^\\x20\\t\\r\\n\\f]+/g;function\x20R(e){return\x20e}function\x20M(e){throw\x20e}function\x20I(e,t,n,r){var\x20i;try{e&&m(i=e.promise)?i.call(e).done(t).fail(n):e&&m(i=e.then)?i.call(e,t,n):t.apply(void\x200,[e].slice(r))}catch(e){n.apply(void\x200,[e])}}S.Callbacks=function(r){var\x20e,n;r=\"string\"==typeof\x20r?(e=r,n={},S.each(e.match(P)||[],function(e,t){n[t]=!0}),n):S.extend({},r);var\x20i,t,o,a,s=[],u=[],l=-1,c=function(){for(a=a||r.once,o=i=!0;u.length;l=-1){t=u.shift();while(++l<s.length)!1===s[l].apply(t[0],t[1])&&r.stopOnFalse&&(l=s.length,t=!1)}r.memory||(t=!1),i=!1,a&&(s=t?[]:\"\")},f={add:function(){return\x20s&&(t&&!i&&(l=s.length-1,u.push(t)),function\x20n(e){S.each(e,function(e,t){m(t)?r.unique&&f.has(t)||s.push(t):t&&t.length&&\"string\"!==w(t)&&n(t)})}(arguments),t&&!i&&c()),this},remove:function(){return\x20S.each(arguments,function(e,t){var\x20n;while(-1<(n=S.inArray(t,s,n)))s.splice(n,1),n<=l&&l--}),this},has:function(e){return\x20e?-1<S.inArray(e,s):0<s.length},empty:function(){return\x20s&&(s=[]),this},disable:function(){return\x20a=u=[],s=t=\"\",this},disabled:function(){return!s},lock:function(){return\x20a=u=[],t||i||(s=t=\"\"),this},locked:function(){return!!a},fireWith:function(e,t){return\x20a||(t=[e,(t=t||[]).slice?t.slice():t],u.push(t),i||c()),this},fire:function(){return\x20f.fireWith(this,arguments),this},fired:function(){return!!o}};return\x20f},S.extend({Deferred:function(e){var\x20o=[[\"notify\",\"progress\",S.Callbacks(\"memory\"),S.Callbacks(\"memory\"),2],[\"resolve\",\"done\",S.Callbacks(\"once\x20memory\"),S.Callbacks(\"once\x20memory\"),0,\"resolved\"],[\"reject\",\"fail\",S.Callbacks(\"once\x20memory\"),S.Callbacks(\"once\x20memory\"),1,\"rejected\"]],i=\"pending\",a={state:function(){return\x20i},always:function(){return\x20s.done(arguments).fail(arguments),this},\"catch\":function(e){return\x20a.then(null,e)},pipe:function(){var\x20i=arguments;return\x20S.Deferred(function(r){S.each(o,function(e,t){var\x20n=m(i[t[4]])&&i[t[4]];s[t[1]](function(){var\x20e=n&&n.apply(this,arguments);e&&m(e.promise)?e.promise().progress(r.notify).done(r.resolve).fail(r.reject):r[t[0]+\"With\"](this,n?[e]:arguments)})}),i=null}).promise()},then:function(t,n,r){var\x20u=0;function\x20l(i,o,a,s){return\x20function(){var\x20n=this,r=arguments,e=function(){var\x20e,t;if(!(i<u)){if((e=a.apply(n,r))===o.promise())throw\x20new\x20TypeError(\"Thenable\x20self-resolution\");t=e&&(\"object\"==typeof\x20e||\"function\"==typeof\x20e)&&e.then,m(t)?s?t.call(e,l(u,o,R,s),l(u,o,M,s)):(u++,t.call(e,l(u,o,R,s),l(u,o,M,s),l(u,o,R,o.notifyWith))):(a!==R&&(n=void\x200,r=[e]),(s||o.resolveWith)(n,r))}},t=s?e:function(){try{e()}catch(e){S.Deferred.exceptionHook&&S.Deferred.exceptionHook(e,t.stackTrace),u<=i+1&&(a!==M&&(n=void\x200,r=[e]),o.rejectWith(n,r))}};i?t():(S.Deferred.getStackHook&&(t.stackTrace=S.Deferred.getStackHook()),C.setTimeout(t))}}return\x20S.Deferred(function(e){o[0][3].add(l(0,e,m(r)?r:R,e.notifyWith)),o[1][3].add(l(0,e,m(t)?t:R)),o[2][3].add(l(0,e,m(n)?n:M))}).promise()},promise:function(e){return\x20null!=e?S.extend(e,a):a}},s={};return\x20S.each(o,function(e,t){var\x20n=t[2],r=t[5];a[t[1]]=n.add,r&&n.add(function(){i=r},o[3-e][2].disable,o[3-e][3].disable,o[0][2].lock,o[0][3].lock),n.add(t[3].fire),s[t[0]]=function(){return\x20s[t[0]+\"With\"](this===s?void\x200:this,arguments),this},s[t[0]+\"With\"]=n.fireWith}),a.promise(s),e&&e.call(s,s),s},when:function(e){var\x20n=arguments.length,t=n,r=Array(t),i=s.call(arguments),o=S.Deferred(),a=function(t){return\x20function(e){r[t]=this,i[t]=1<arguments.length?s.call(arguments):e,--n||o.resolveWith(r,i)}};if(n<=1&&(I(e,o.done(a(t)).resolve,o.reject,!n),\"pending\"===o.state()||m(i[t]&&i[t].then)))return\x20o.then();while(t--)I(i[t],a(t),o.reject);return\x20o.promise()}});var\x20W=/^(Eval|Internal|Range|Reference|Syntax|Type|URI)Error$/;S.Deferred.exceptionHook=function(e,t){C.console&&C.console.warn&&e&&W.test(e.name)&&C.console.warn(\"jQuery.Deferred\x20exception:\x20\"+e.message,e.stack,t)},S.readyException=function(e){C.setTimeout(function(){throw\x20e})};var\x20F=S.Deferred();function\x20B(){E.removeEventListener(\"DOMContentLoaded\",B),C.removeEventListener(\"load\",B),S.ready()}S.fn.ready=function(e){return\x20F.then(e)[\"catch\"](function(e){S.readyException(e)}),this},S.extend({isReady:!1,readyWait:1,ready:function(e){(!0===e?--S.readyWait:S.isReady)||(S.isReady=!0)!==e&&0<--S.readyWait||F.resolveWith(E,[S])}}),S.ready.then=F.then,\"complete\"===E.readyState||\"loading\"!==E.readyState&&!E.documentElement.doScroll?C.setTimeout(S.ready):(E.addEventListener(\"DOMContentLoaded\",B),C.addEventListener(\"load\",B));var\x20$=function(e,t,n,r,i,o,a){var\x20s=0,u=e.length,l=null==n;if(\"object\"===w(n))for(s\x20in\x20i=!0,n)$(e,t,s,n[s],!0,o,a);else\x20if(void\x200!==r&&(i=!0,m(r)||(a=!0),l&&(a?(t.call(e,r),t=null):(l=t,t=function(e,t,n){return\x20l.call(S(e),n)})),t))for(;s<u;s++)t(e[s],n,a?r:r.call(e[s],s,t(e[s],n)));return\x20i?e:l?t.call(e):u?t(e[0],n):o},_=/^-ms-/,z=/-([a-z])/g;function\x20U(e,t){return\x20t.toUpperCase()}function\x20X(e){return\x20e.replace(_,\"ms-\").replace(z,U)}var\x20V=function(e){return\x201===e.nodeType||9===e.nodeType||!+e.nodeType};function\x20G(){this.expando=S.expando+G.uid++}G.uid=1,G.prototype={cache:function(e){var\x20t=e[this.expando];return\x20t||(t={},V(e)&&(e.nodeType?e[this.expando]=t:Object.defineProperty(e,this.expando,{value:t,configurable:!0}))),t},set:function(e,t,n){var\x20r,i=this.cache(e);if(\"string\"==typeof\x20t)i[X(t)]=n;else\x20for(r\x20in\x20t)i[X(r)]=t[r];return\x20i},get:function(e,t){return\x20void\x200===t?this.cache(e):e[this.expando]&&e[this.expando][X(t)]},access:function(e,t,n){return\x20void\x200===t||t&&\"string\"==typeof\x20t&&void\x200===n?this.get(e,t):(this.set(e,t,n),void\x200!==n?n:t)},remove:function(e,t){var\x20n,r=e[this.expando];if(void\x200!==r){if(void\x200!==t){n=(t=Array.isArray(t)?t.map(X):(t=X(t))in\x20r?[t]:t.match(P)||[]).length;while(n--)delete\x20r[t[n]]}(void\x200===t||S.isEmptyObject(r))&&(e.nodeType?e[this.expando]=void\x200:delete\x20e[this.expando])}},hasData:function(e){var\x20t=e[this.expando];return\x20void\x200!==t&&!S.isEmptyObject(t)}};var\x20Y=new\x20G,Q=new\x20G,J=/^(?:\\{[\\w\\W]*\\}|\\[[\\w\\W]*\\])$/,K=/[A-Z]/g;function\x20Z(e,t,n){var\x20r,i;if(void\x200===n&&1===e.nodeType)if(r=\"data-\"+t.replace(K,\"-$&\").toLowerCase(),\"string\"==typeof(n=e.getAttribute(r))){try{n=\"true\"===(i=n)||\"false\"!==i&&(\"null\"===i?null:i===+i+\"\"?+i:J.test(i)?JSON.parse(i):i)}catch(e){}Q.set(e,t,n)}else\x20n=void\x200;return\x20n}S.extend({hasData:function(e){return\x20Q.hasData(e)||Y.hasData(e)},data:function(e,t,n){return\x20Q.access(e,t,n)},removeData:function(e,t){Q.remove(e,t)},_data:function(e,t,n){return\x20Y.access(e,t,n)},_removeData:function(e,t){Y.remove(e,t)}}),S.fn.extend({data:function(n,e){var\x20t,r,i,o=this[0],a=o&&o.attributes;if(void\x200===n){if(this.length&&(i=Q.get(o),1===o.nodeType&&!Y.get(o,\"hasDataAttrs\"))){t=a.length;while(t--)a[t]&&0===(r=a[t].name).indexOf(\"data-\")&&(r=X(r.slice(5)),Z(o,r,i[r]));Y.set(o,\"hasDataAttrs\",!0)}return\x20i}return\"object\"==typeof\x20n?this.each(function(){Q.set(this,n)}):$(this,function(e){var\x20t;if(o&&void\x200===e)return\x20void\x200!==(t=Q.get(o,n))?t:void\x200!==(t=Z(o,n))?t:void\x200;this.each(function(){Q.set(this,n,e)})},null,e,1<arguments.length,null,!0)},removeData:function(e){return\x20this.each(function(){Q.remove(this,e)})}}),S.extend({queue:function(e,t,n){var\x20r;if(e)return\x20t=(t||\"fx\")+\"queue\",r=Y.get(e,t),n&&(!r||Array.isArray(n)?r=Y.access(e,t,S.makeArray(n)):r.push(n)),r||[]},dequeue:function(e,t){t=t||\"fx\";var\x20n=S.queue(e,t),r=n.length,i=n.shift(),o=S._queueHooks(e,t);\"inprogress\"===i&&(i=n.shift(),r--),i&&(\"fx\"===t&&n.unshift(\"inprogress\"),delete\x20o.stop,i.call(e,function(){S.dequeue(e,t)},o)),!r&&o&&o.empty.fire()},_queueHooks:function(e,t){var\x20n=t+\"queueHooks\";return\x20Y.get(e,n)||Y.access(e,n,{empty:S.Callbacks(\"once\x20memory\").add(function(){Y.remove(e,[t+\"queue\",n])})})}}),S.fn.extend({queue:function(t,n){var\x20e=2;return\"string\"!=typeof\x20t&&(n=t,t=\"fx\",e--),arguments.length<e?S.queue(this[0],t):void\x200===n?this:this.each(function(){var\x20e=S.queue(this,t,n);S._queueHooks(this,t),\"fx\"===t&&\"inprogress\"!==e[0]&&S.dequeue(this,t)})},dequeue:function(e){return\x20this.each(function(){S.dequeue(this,e)})},clearQueue:function(e){return\x20this.queue(e||\"fx\",[])},promise:function(e,t){var\x20n,r=1,i=S.Deferred(),o=this,a=this.length,s=function(){--r||i.resolveWith(o,[o])};\"string\"!=typeof\x20e&&(t=e,e=void\x200),e=e||\"fx\";while(a--)(n=Y.get(o[a],e+\"queueHooks\"))&&n.empty&&(r++,n.empty.add(s));return\x20s(),i.promise(t)}});var\x20ee=/[+-]?(?:\\d*\\.|)\\d+(?:[eE][+-]?\\d+|)/.source,te=new\x20RegExp(\"^(?:([+-])=|)(\"+ee+\")([a-z%]*)$\",\"i\"),ne=[\"Top\",\"Right\",\"Bottom\",\"Left\"],re=E.documentElement,ie=function(e){return\x20S.contains(e.ownerDocument,e)},oe={composed:!0};re.getRootNode&&(ie=function(e){return\x20S.contains(e.ownerDocument,e)||e.getRootNode(oe)===e.ownerDocument});var\x20ae=function(e,t){return\"none\"===(e=t||e).style.display||\"\"===e.style.display&&ie(e)&&\"none\"===S.css(e,\"display\")};function\x20se(e,t,n,r){var\x20i,o,a=20,s=r?function(){return\x20r.cur()}:function(){return\x20S.css(e,t,\"\")},u=s(),l=n&&n[3]||(S.cssNumber[t]?\"\":\"px\"),c=e.nodeType&&(S.cssNumber[t]||\"px\"!==l&&+u)&&te.exec(S.css(e,t));if(c&&c[3]!==l){u/=2,l=l||c[3],c=+u||1;while(a--)S.style(e,t,c+l),(1-o)*(1-(o=s()/u||.5))<=0&&(a=0),c/=o;c*=2,S.style(e,t,c+l),n=n||[]}return\x20n&&(c=+c||+u||0,i=n[1]?c+(n[1]+1)*n[2]:+n[2],r&&(r.unit=l,r.start=c,r.end=i)),i}var\x20ue={};function\x20le(e,t){for(var\x20n,r,i,o,a,s,u,l=[],c=0,f=e.length;c<f;c++)(r=e[c]).style&&(n=r.style.display,t?(\"none\"===n&&(l[c]=Y.get(r,\"display\")||null,l[c]||(r.style.display=\"\")),\"\"===r.style.display&&ae(r)&&(l[c]=(u=a=o=void\x200,a=(i=r).ownerDocument,s=i.nodeName,(u=ue[s])||(o=a.body.appendChild(a.createElement(s)),u=S.css(o,\"display\"),o.parentNode.removeChild(o),\"none\"===u&&(u=\"block\"),ue[s]=u)))):\"none\"!==n&&(l[c]=\"none\",Y.set(r,\"display\",n)));for(c=0;c<f;c++)null!=l[c]&&(e[c].style.display=l[c]);return\x20e}S.fn.extend({show:function(){return\x20le(this,!0)},hide:function(){return\x20le(this)},toggle:function(e){return\"boolean\"==typeof\x20e?e?this.show():this.hide():this.each(function(){ae(this)?S(this).show():S(this).hide()})}});var\x20ce,fe,pe=/^(?:checkbox|radio)$/i,de=/<([a-z][^\\/\\0>\\x20\\t\\r\\n\\f]*)/i,he=/^$|^module$|\\/(?:java|ecma)script/i;ce=E.createDocumentFragment().appendChild(E.createElement(\"div\")),(fe=E.createElement(\"input\")).setAttribute(\"type\",\"radio\"),fe.setAttribute(\"checked\",\"checked\"),fe.setAttribute(\"name\",\"t\"),ce.appendChild(fe),y.checkClone=ce.cloneNode(!0).cloneNode(!0).lastChild.checked,ce.innerHTML=\"<textarea>x</textarea>\",y.noCloneChecked=!!ce.cloneNode(!0).lastChild.defaultValue,ce.innerHTML=\"<option></option>\",y.option=!!ce.lastChild;var\x20ge={thead:[1,\"<table>\",\"</table>\"],col:[2,\"<table><colgroup>\",\"</colgroup></table>\"],tr:[2,\"<table><tbody>\",\"</tbody></table>\"],td:[3,\"<table><tbody><tr>\",\"</tr></tbody></table>\"],_default:[0,\"\",\"\"]};function\x20ve(e,t){var\x20n;return\x20n=\"undefined\"!=typeof\x20e.getElementsByTagName?e.getElementsByTagName(t||\"*\"):\"undefined\"!=typeof\x20e.querySelectorAll?e.querySelectorAll(t||\"*\"):[],void\x200===t||t&&A(e,t)?S.merge([e],n):n}function\x20ye(e,t){for(var\x20n=0,r=e.length;n<r;n++)Y.set(e[n],\"globalEval\",!t||Y.get(t[n],\"globalEval\"))}ge.tbody=ge.tfoot=ge.colgroup=ge.caption=ge.thead,ge.th=ge.td,y.option||(ge.optgroup=ge.option=[1,\"<select\x20multiple='multiple'>\",\"</select>\"]);var\x20me=/<|&#?\\w+;/;function\x20xe(e,t,n,r,i){for(var\x20o,a,s,u,l,c,f=t.createDocumentFragment(),p=[],d=0,h=e.length;d<h;d++)if((o=e[d])||0===o)if(\"object\"===w(o))S.merge(p,o.nodeType?[o]:o);else\x20if(me.test(o)){a=a||f.appendChild(t.createElement(\"div\")),s=(de.exec(o)||[\"\",\"\"])[1].toLowerCase(),u=ge[s]||ge._default,a.innerHTML=u[1]+S.htmlPrefilter(o)+u[2],c=u[0];while(c--)a=a.lastChild;S.merge(p,a.childNodes),(a=f.firstChild).textContent=\"\"}else\x20p.push(t.createTextNode(o));f.textContent=\"\",d=0;while(o=p[d++])if(r&&-1<S.inArray(o,r))i&&i.push(o);else\x20if(l=ie(o),a=ve(f.appendChild(o),\"script\"),l&&ye(a),n){c=0;while(o=a[c++])he.test(o.type||\"\")&&n.push(o)}return\x20f}var\x20be=/^key/,we=/^(?:mouse|pointer|contextmenu|drag|drop)|click/,Te=/^([^.]*)(?:\\.(.+)|)/;function\x20Ce(){return!0}function\x20Ee(){return!1}function\x20Se(e,t){return\x20e===function(){try{return\x20E.activeElement}catch(e){}}()==(\"focus\"===t)}function\x20ke(e,t,n,r,i,o){var\x20a,s;if(\"object\"==typeof\x20t){for(s\x20in\"string\"!=typeof\x20n&&(r=r||n,n=void\x200),t)ke(e,s,n,r,t[s],o);return\x20e}if(null==r&&null==i?(i=n,r=n=void\x200):null==i&&(\"string\"==typeof\x20n?(i=r,r=void\x200):(i=r,r=n,n=void\x200)),!1===i)i=Ee;else\x20if(!i)return\x20e;return\x201===o&&(a=i,(i=function(e){return\x20S().off(e),a.apply(this,arguments)}).guid=a.guid||(a.guid=S.guid++)),e.each(function(){S.event.add(this,t,i,r,n)})}function\x20Ae(e,i,o){o?(Y.set(e,i,!1),S.event.add(e,i,{namespace:!1,handler:function(e){var\x20t,n,r=Y.get(this,i);if(1&e.isTrigger&&this[i]){if(r.length)(S.event.special[i]||{}).delegateType&&e.stopPropagation();else\x20if(r=s.call(arguments),Y.set(this,i,r),t=o(this,i),this[i](),r!==(n=Y.get(this,i))||t?Y.set(this,i,!1):n={},r!==n)return\x20e.stopImmediatePropagation(),e.preventDefault(),n.value}else\x20r.length&&(Y.set(this,i,{value:S.event.trigger(S.extend(r[0],S.Event.prototype),r.slice(1),this)}),e.stopImmediatePropagation())}})):void\x200===Y.get(e,i)&&S.event.add(e,i,Ce)}S.event={global:{},add:function(t,e,n,r,i){var\x20o,a,s,u,l,c,f,p,d,h,g,v=Y.get(t);if(V(t)){n.handler&&(n=(o=n).handler,i=o.selector),i&&S.find.matchesSelector(re,i),n.guid||(n.guid=S.guid++),(u=v.events)||(u=v.events=Object.create(null)),(a=v.handle)||(a=v.handle=function(e){return\"undefined\"!=typeof\x20S&&S.event.triggered!==e.type?S.event.dispatch.apply(t,arguments):void\x200}),l=(e=(e||\"\").match(P)||[\"\"]).length;while(l--)d=g=(s=Te.exec(e[l])||[])[1],h=(s[2]||\"\").split(\".\").sort(),d&&(f=S.event.special[d]||{},d=(i?f.delegateType:f.bindType)||d,f=S.event.special[d]||{},c=S.extend({type:d,origType:g,data:r,handler:n,guid:n.guid,selector:i,needsContext:i&&S.expr.match.needsContext.test(i),namespace:h.join(\".\")},o),(p=u[d])||((p=u[d]=[]).delegateCount=0,f.setup&&!1!==f.setup.call(t,r,h,a)||t.addEventListener&&t.addEventListener(d,a)),f.add&&(f.add.call(t,c),c.handler.guid||(c.handler.guid=n.guid)),i?p.splice(p.delegateCount++,0,c):p.push(c),S.event.global[d]=!0)}},remove:function(e,t,n,r,i){var\x20o,a,s,u,l,c,f,p,d,h,g,v=Y.hasData(e)&&Y.get(e);if(v&&(u=v.events)){l=(t=(t||\"\").match(P)||[\"\"]).length;while(l--)if(d=g=(s=Te.exec(t[l])||[])[1],h=(s[2]||\"\").split(\".\").sort(),d){f=S.event.special[d]||{},p=u[d=(r?f.delegateType:f.bindType)||d]||[],s=s[2]&&new\x20RegExp(\"(^|\\\\.)\"+h.join(\"\\\\.(?:.*\\\\.|)\")+\"(\\\\.|$)\"),a=o=p.length;while(o--)c=p[o],!i&&g!==c.origType||n&&n.guid!==c.guid||s&&!s.test(c.namespace)||r&&r!==c.selector&&(\"**\"!==r||!c.selector)||(p.splice(o,1),c.selector&&p.delegateCount--,f.remove&&f.remove.call(e,c));a&&!p.length&&(f.teardown&&!1!==f.teardown.call(e,h,v.handle)||S.removeEvent(e,d,v.handle),delete\x20u[d])}else\x20for(d\x20in\x20u)S.event.remove(e,d+t[l],n,r,!0);S.isEmptyObject(u)&&Y.remove(e,\"handle\x20events\")}},dispatch:function(e){var\x20t,n,r,i,o,a,s=new\x20Array(arguments.length),u=S.event.fix(e),l=(Y.get(this,\"events\")||Object.create(null))[u.type]||[],c=S.event.special[u.type]||{};for(s[0]=u,t=1;t<arguments.length;t++)s[t]=arguments[t];if(u.delegateTarget=this,!c.preDispatch||!1!==c.preDispatch.call(this,u)){a=S.event.handlers.call(this,u,l),t=0;while((i=a[t++])&&!u.isPropagationStopped()){u.currentTarget=i.elem,n=0;while((o=i.handlers[n++])&&!u.isImmediatePropagationStopped())u.rnamespace&&!1!==o.namespace&&!u.rnamespace.test(o.namespace)||(u.handleObj=o,u.data=o.data,void\x200!==(r=((S.event.special[o.origType]||{}).handle||o.handler).apply(i.elem,s))&&!1===(u.result=r)&&(u.preventDefault(),u.stopPropagation()))}return\x20c.postDispatch&&c.postDispatch.call(this,u),u.result}},handlers:function(e,t){var\x20n,r,i,o,a,s=[],u=t.delegateCount,l=e.target;if(u&&l.nodeType&&!(\"click\"===e.type&&1<=e.button))for(;l!==this;l=l.parentNode||this)if(1===l.nodeType&&(\"click\"!==e.type||!0!==l.disabled)){for(o=[],a={},n=0;n<u;n++)void\x200===a[i=(r=t[n]).selector+\"\x20\"]&&(a[i]=r.needsContext?-1<S(i,this).index(l):S.find(i,this,null,[l]).length),a[i]&&o.push(r);o.length&&s.push({elem:l,handlers:o})}return\x20l=this,u<t.length&&s.push({elem:l,handlers:t.slice(u)}),s},addProp:function(t,e){Object.defineProperty(S.Event.prototype,t,{enumerable:!0,configurable:!0,get:m(e)?function(){if(this.originalEvent)return\x20e(this.originalEvent)}:function(){if(this.originalEvent)return\x20this.originalEvent[t]},set:function(e){Object.defineProperty(this,t,{enumerable:!0,configurable:!0,writable:!0,value:e})}})},fix:function(e){return\x20e[S.expando]?e:new\x20S.Event(e)},special:{load:{noBubble:!0},click:{setup:function(e){var\x20t=this||e;return\x20pe.test(t.type)&&t.click&&A(t,\"input\")&&Ae(t,\"click\",Ce),!1},trigger:function(e){var\x20t=this||e;return\x20pe.test(t.type)&&t.click&&A(t,\"input\")&&Ae(t,\"click\"),!0},_default:function(e){var\x20t=e.target;return\x20pe.test(t.type)&&t.click&&A(t,\"input\")&&Y.get(t,\"click\")||A(t,\"a\")}},beforeunload:{postDispatch:function(e){void\x200!==e.result&&e.originalEvent&&(e.originalEvent.returnValue=e.result)}}}},S.removeEvent=function(e,t,n){e.removeEventListener&&e.removeEventListener(t,n)},S.Event=function(e,t){if(!(this\x20instanceof\x20S.Event))return\x20new\x20S.Event(e,t);e&&e.type?(this.originalEvent=e,this.type=e.type,this.isDefaultPrevented=e.defaultPrevented||void\x200===e.defaultPrevented&&!1===e.returnValue?Ce:Ee,this.target=e.target&&3===e.target.nodeType?e.target.parentNode:e.target,this.currentTarget=e.currentTarget,this.relatedTarget=e.relatedTarget):this.type=e,t&&S.extend(this,t),this.timeStamp=e&&e.timeStamp||Date.now(),this[S.expando]=!0},S.Event.prototype={constructor:S.Event,isDefaultPrevented:Ee,isPropagationStopped:Ee,isImmediatePropagationStopped:Ee,isSimulated:!1,preventDefault:function(){var\x20e=this.originalEvent;this.isDefaultPrevented=Ce,e&&!this.isSimulated&&e.preventDefault()},stopPropagation:function(){var\x20e=this.originalEvent;this.isPropagationStopped=Ce,e&&!this.isSimulated&&e.stopPropagation()},stopImmediatePropagation:function(){var\x20e=this.originalEvent;this.isImmediatePropagationStopped=Ce,e&&!this.isSimulated&&e.stopImmediatePropagation(),this.stopPropagation()}},S.each({altKey:!0,bubbles:!0,cancelable:!0,changedTouches:!0,ctrlKey:!0,detail:!0,eventPhase:!0,metaKey:!0,pageX:!0,pageY:!0,shiftKey:!0,view:!0,\"char\":!0,code:!0,charCode:!0,key:!0,keyCode:!0,button:!0,buttons:!0,clientX:!0,clientY:!0,offsetX:!0,offsetY:!0,pointerId:!0,pointerType:!0,screenX:!0,screenY:!0,targetTouches:!0,toElement:!0,touches:!0,which:function(e){var\x20t=e.button;return\x20null==e.which&&be.test(e.type)?null!=e.charCode?e.charCode:e.keyCode:!e.which&&void\x200!==t&&we.test(e.type)?1&t?1:2&t?3:4&t?2:0:e.which}},S.event.addProp),S.each({focus:\"focusin\",blur:\"focusout\"},function(e,t){S.event.special[e]={setup:function(){return\x20Ae(this,e,Se),!1},trigger:function(){return\x20Ae(this,e),!0},delegateType:t}}),S.each({mouseenter:\"mouseover\",mouseleave:\"mouseout\",pointerenter:\"pointerover\",pointerleave:\"pointerout\"},function(e,i){S.event.special[e]={delegateType:i,bindType:i,handle:function(e){var\x20t,n=e.relatedTarget,r=e.handleObj;return\x20n&&(n===this||S.contains(this,n))||(e.type=r.origType,t=r.handler.apply(this,arguments),e.type=i),t}}}),S.fn.extend({on:function(e,t,n,r){return\x20ke(this,e,t,n,r)},one:function(e,t,n,r){return\x20ke(this,e,t,n,r,1)},off:function(e,t,n){var\x20r,i;if(e&&e.preventDefault&&e.handleObj)return\x20r=e.handleObj,S(e.delegateTarget).off(r.namespace?r.origType+\".\"+r.namespace:r.origType,r.selector,r.handler),this;if(\"object\"==typeof\x20e){for(i\x20in\x20e)this.off(i,t,e[i]);return\x20this}return!1!==t&&\"function\"!=typeof\x20t||(n=t,t=void\x200),!1===n&&(n=Ee),this.each(function(){S.event.remove(this,e,n,t)})}});var\x20Ne=/<script|<style|<link/i,De=/checked\\s*(?:[^=]|=\\s*.checked.)/i,je=/^\\s*<!(?:\\[CDATA\\[|--)|(?:\\]\\]|--)>\\s*$/g;function\x20qe(e,t){return\x20A(e,\"table\")&&A(11!==t.nodeType?t:t.firstChild,\"tr\")&&S(e).children(\"tbody\")[0]||e}function\x20Le(e){return\x20e.type=(null!==e.getAttribute(\"type\"))+\"/\"+e.type,e}function\x20He(e){return\"true/\"===(e.type||\"\").slice(0,5)?e.type=e.type.slice(5):e.removeAttribute(\"type\"),e}function\x20Oe(e,t){var\x20n,r,i,o,a,s;if(1===t.nodeType){if(Y.hasData(e)&&(s=Y.get(e).events))for(i\x20in\x20Y.remove(t,\"handle\x20events\"),s)for(n=0,r=s[i].length;n<r;n++)S.event.add(t,i,s[i][n]);Q.hasData(e)&&(o=Q.access(e),a=S.extend({},o),Q.set(t,a))}}function\x20Pe(n,r,i,o){r=g(r);var\x20e,t,a,s,u,l,c=0,f=n.length,p=f-1,d=r[0],h=m(d);if(h||1<f&&\"string\"==typeof\x20d&&!y.checkClone&&De.test(d))return\x20n.each(function(e){var\x20t=n.eq(e);h&&(r[0]=d.call(this,e,t.html())),Pe(t,r,i,o)});if(f&&(t=(e=xe(r,n[0].ownerDocument,!1,n,o)).firstChild,1===e.childNodes.length&&(e=t),t||o)){for(s=(a=S.map(ve(e,\"script\"),Le)).length;c<f;c++)u=e,c!==p&&(u=S.clone(u,!0,!0),s&&S.merge(a,ve(u,\"script\"))),i.call(n[c],u,c);if(s)for(l=a[a.length-1].ownerDocument,S.map(a,He),c=0;c<s;c++)u=a[c],he.test(u.type||\"\")&&!Y.access(u,\"globalEval\")&&S.contains(l,u)&&(u.src&&\"module\"!==(u.type||\"\").toLowerCase()?S._evalUrl&&!u.noModule&&S._evalUrl(u.src,{nonce:u.nonce||u.getAttribute(\"nonce\")},l):b(u.textContent.replace(je,\"\"),u,l))}return\x20n}function\x20Re(e,t,n){for(var\x20r,i=t?S.filter(t,e):e,o=0;null!=(r=i[o]);o++)n||1!==r.nodeType||S.cleanData(ve(r)),r.parentNode&&(n&&ie(r)&&ye(ve(r,\"script\")),r.parentNode.removeChild(r));return\x20e}S.extend({htmlPrefilter:function(e){return\x20e},clone:function(e,t,n){var\x20r,i,o,a,s,u,l,c=e.cloneNode(!0),f=ie(e);if(!(y.noCloneChecked||1!==e.nodeType&&11!==e.nodeType||S.isXMLDoc(e)))for(a=ve(c),r=0,i=(o=ve(e)).length;r<i;r++)s=o[r],u=a[r],void\x200,\"input\"===(l=u.nodeName.toLowerCase())&&pe.test(s.type)?u.checked=s.checked:\"input\"!==l&&\"textarea\"!==l||(u.defaultValue=s.defaultValue);if(t)if(n)for(o=o||ve(e),a=a||ve(c),r=0,i=o.length;r<i;r++)Oe(o[r],a[r]);else\x20Oe(e,c);return\x200<(a=ve(c,\"script\")).length&&ye(a,!f&&ve(e,\"script\")),c},cleanData:function(e){for(var\x20t,n,r,i=S.event.special,o=0;void\x200!==(n=e[o]);o++)if(V(n)){if(t=n[Y.expando]){if(t.events)for(r\x20in\x20t.events)i[r]?S.event.remove(n,r):S.removeEvent(n,r,t.handle);n[Y.expando]=void\x200}n[Q.expando]&&(n[Q.expando]=void\x200)}}}),S.fn.extend({detach:function(e){return\x20Re(this,e,!0)},remove:function(e){return\x20Re(this,e)},text:function(e){return\x20$(this,function(e){return\x20void\x200===e?S.text(this):this.empty().each(function(){1!==this.nodeType&&11!==this.nodeType&&9!==this.nodeType||(this.textContent=e)})},null,e,arguments.length)},append:function(){return\x20Pe(this,arguments,function(e){1!==this.nodeType&&11!==this.nodeType&&9!==this.nodeType||qe(this,e).appendChild(e)})},prepend:function(){return\x20Pe(this,arguments,function(e){if(1===this.nodeType||11===this.nodeType||9===this.nodeType){var\x20t=qe(this,e);t.insertBefore(e,t.firstChild)}})},before:function(){return\x20Pe(this,arguments,function(e){this.parentNode&&this.parentNode.insertBefore(e,this)})},after:function(){return\x20Pe(this,arguments,function(e){this.parentNode&&this.parentNode.insertBefore(e,this.nextSibling)})},empty:function(){for(var\x20e,t=0;null!=(e=this[t]);t++)1===e.nodeType&&(S.cleanData(ve(e,!1)),e.textContent=\"\");return\x20this},clone:function(e,t){return\x20e=null!=e&&e,t=null==t?e:t,this.map(function(){return\x20S.clone(this,e,t)})},html:function(e){return\x20$(this,function(e){var\x20t=this[0]||{},n=0,r=this.length;if(void\x200===e&&1===t.nodeType)return\x20t.innerHTML;if(\"string\"==typeof\x20e&&!Ne.test(e)&&!ge[(de.exec(e)||[\"\",\"\"])[1].toLowerCase()]){e=S.htmlPrefilter(e);try{for(;n<r;n++)1===(t=this[n]||{}).nodeType&&(S.cleanData(ve(t,!1)),t.innerHTML=e);t=0}catch(e){}}t&&this.empty().append(e)},null,e,arguments.length)},replaceWith:function(){var\x20n=[];return\x20Pe(this,arguments,function(e){var\x20t=this.parentNode;S.inArray(this,n)<0&&(S.cleanData(ve(this)),t&&t.replaceChild(e,this))},n)}}),S.each({appendTo:\"append\",prependTo:\"prepend\",insertBefore:\"before\",insertAfter:\"after\",replaceAll:\"replaceWith\"},function(e,a){S.fn[e]=function(e){for(var\x20t,n=[],r=S(e),i=r.length-1,o=0;o<=i;o++)t=o===i?this:this.clone(!0),S(r[o])[a](t),u.apply(n,t.get());return\x20this.pushStack(n)}});var\x20Me=new\x20RegExp(\"^(\"+ee+\")(?!px)[a-z%]+$\",\"i\"),Ie=function(e){var\x20t=e.ownerDocument.defaultView;return\x20t&&t.opener||(t=C),t.getComputedStyle(e)},We=function(e,t,n){var\x20r,i,o={};for(i\x20in\x20t)o[i]=e.style[i],e.style[i]=t[i];for(i\x20in\x20r=n.call(e),t)e.style[i]=o[i];return\x20r},Fe=new\x20RegExp(ne.join(\"|\"),\"i\");function\x20Be(e,t,n){var\x20r,i,o,a,s=e.style;return(n=n||Ie(e))&&(\"\"!==(a=n.getPropertyValue(t)||n[t])||ie(e)||(a=S.style(e,t)),!y.pixelBoxStyles()&&Me.test(a)&&Fe.test(t)&&(r=s.width,i=s.minWidth,o=s.maxWidth,s.minWidth=s.maxWidth=s.width=a,a=n.width,s.width=r,s.minWidth=i,s.maxWidth=o)),void\x200!==a?a+\"\":a}function\x20$e(e,t){return{get:function(){if(!e())return(this.get=t).apply(this,arguments);delete\x20this.get}}}!function(){function\x20e(){if(l){u.style.cssText=\"position:absolute;left:-11111px;width:60px;margin-top:1px;padding:0;border:0\",l.style.cssText=\"position:relative;display:block;box-sizing:border-box;overflow:scroll;margin:auto;border:1px;padding:1px;width:60%;top:1%\",re.appendChild(u).appendChild(l);var\x20e=C.getComputedStyle(l);n=\"1%\"!==e.top,s=12===t(e.marginLeft),l.style.right=\"60%\",o=36===t(e.right),r=36===t(e.width),l.style.position=\"absolute\",i=12===t(l.offsetWidth/3),re.removeChild(u),l=null}}function\x20t(e){return\x20Math.round(parseFloat(e))}var\x20n,r,i,o,a,s,u=E.createElement(\"div\"),l=E.createElement(\"div\");l.style&&(l.style.backgroundClip=\"content-box\",l.cloneNode(!0).style.backgroundClip=\"\",y.clearCloneStyle=\"content-box\"===l.style.backgroundClip,S.extend(y,{boxSizingReliable:function(){return\x20e(),r},pixelBoxStyles:function(){return\x20e(),o},pixelPosition:function(){return\x20e(),n},reliableMarginLeft:function(){return\x20e(),s},scrollboxSize:function(){return\x20e(),i},reliableTrDimensions:function(){var\x20e,t,n,r;return\x20null==a&&(e=E.createElement(\"table\"),t=E.createElement(\"tr\"),n=E.createElement(\"div\"),e.style.cssText=\"position:absolute;left:-11111px\",t.style.height=\"1px\",n.style.height=\"9px\",re.appendChild(e).appendChild(t).appendChild(n),r=C.getComputedStyle(t),a=3<parseInt(r.height),re.removeChild(e)),a}}))}();var\x20_e=[\"Webkit\",\"Moz\",\"ms\"],ze=E.createElement(\"div\").style,Ue={};function\x20Xe(e){var\x20t=S.cssProps[e]||Ue[e];return\x20t||(e\x20in\x20ze?e:Ue[e]=function(e){var\x20t=e[0].toUpperCase()+e.slice(1),n=_e.length;while(n--)if((e=_e[n]+t)in\x20ze)return\x20e}(e)||e)}var\x20Ve=/^(none|table(?!-c[ea]).+)/,Ge=/^--/,Ye={position:\"absolute\",visibility:\"hidden\",display:\"block\"},Qe={letterSpacing:\"0\",fontWeight:\"400\"};function\x20Je(e,t,n){var\x20r=te.exec(t);return\x20r?Math.max(0,r[2]-(n||0))+(r[3]||\"px\"):t}function\x20Ke(e,t,n,r,i,o){var\x20a=\"width\"===t?1:0,s=0,u=0;if(n===(r?\"border\":\"content\"))return\x200;for(;a<4;a+=2)\"margin\"===n&&(u+=S.css(e,n+ne[a],!0,i)),r?(\"content\"===n&&(u-=S.css(e,\"padding\"+ne[a],!0,i)),\"margin\"!==n&&(u-=S.css(e,\"border\"+ne[a]+\"Width\",!0,i))):(u+=S.css(e,\"padding\"+ne[a],!0,i),\"padding\"!==n?u+=S.css(e,\"border\"+ne[a]+\"Width\",!0,i):s+=S.css(e,\"border\"+ne[a]+\"Width\",!0,i));return!r&&0<=o&&(u+=Math.max(0,Math.ceil(e[\"offset\"+t[0].toUpperCase()+t.slice(1)]-o-u-s-.5))||0),u}function\x20Ze(e,t,n){var\x20r=Ie(e),i=(!y.boxSizingReliable()||n)&&\"border-box\"===S.css(e,\"boxSizing\",!1,r),o=i,a=Be(e,t,r),s=\"offset\"+t[0].toUpperCase()+t.slice(1);if(Me.test(a)){if(!n)return\x20a;a=\"auto\"}return(!y.boxSizingReliable()&&i||!y.reliableTrDimensions()&&A(e,\"tr\")||\"auto\"===a||!parseFloat(a)&&\"inline\"===S.css(e,\"display\",!1,r))&&e.getClientRects().length&&(i=\"border-box\"===S.css(e,\"boxSizing\",!1,r),(o=s\x20in\x20e)&&(a=e[s])),(a=parseFloat(a)||0)+Ke(e,t,n||(i?\"border\":\"content\"),o,r,a)+\"px\"}function\x20et(e,t,n,r,i){return\x20new\x20et.prototype.init(e,t,n,r,i)}S.extend({cssHooks:{opacity:{get:function(e,t){if(t){var\x20n=Be(e,\"opacity\");return\"\"===n?\"1\":n}}}},cssNumber:{animationIterationCount:!0,columnCount:!0,fillOpacity:!0,flexGrow:!0,flexShrink:!0,fontWeight:!0,gridArea:!0,gridColumn:!0,gridColumnEnd:!0,gridColumnStart:!0,gridRow:!0,gridRowEnd:!0,gridRowStart:!0,lineHeight:!0,opacity:!0,order:!0,orphans:!0,widows:!0,zIndex:!0,zoom:!0},cssProps:{},style:function(e,t,n,r){if(e&&3!==e.nodeType&&8!==e.nodeType&&e.style){var\x20i,o,a,s=X(t),u=Ge.test(t),l=e.style;if(u||(t=Xe(s)),a=S.cssHooks[t]||S.cssHooks[s],void\x200===n)return\x20a&&\"get\"in\x20a&&void\x200!==(i=a.get(e,!1,r))?i:l[t];\"string\"===(o=typeof\x20n)&&(i=te.exec(n))&&i[1]&&(n=se(e,t,i),o=\"number\"),null!=n&&n==n&&(\"number\"!==o||u||(n+=i&&i[3]||(S.cssNumber[s]?\"\":\"px\")),y.clearCloneStyle||\"\"!==n||0!==t.indexOf(\"background\")||(l[t]=\"inherit\"),a&&\"set\"in\x20a&&void\x200===(n=a.set(e,n,r))||(u?l.setProperty(t,n):l[t]=n))}},css:function(e,t,n,r){var\x20i,o,a,s=X(t);return\x20Ge.test(t)||(t=Xe(s)),(a=S.cssHooks[t]||S.cssHooks[s])&&\"get\"in\x20a&&(i=a.get(e,!0,n)),void\x200===i&&(i=Be(e,t,r)),\"normal\"===i&&t\x20in\x20Qe&&(i=Qe[t]),\"\"===n||n?(o=parseFloat(i),!0===n||isFinite(o)?o||0:i):i}}),S.each([\"height\",\"width\"],function(e,u){S.cssHooks[u]={get:function(e,t,n){if(t)return!Ve.test(S.css(e,\"display\"))||e.getClientRects().length&&e.getBoundingClientRect().width?Ze(e,u,n):We(e,Ye,function(){return\x20Ze(e,u,n)})},set:function(e,t,n){var\x20r,i=Ie(e),o=!y.scrollboxSize()&&\"absolute\"===i.position,a=(o||n)&&\"border-box\"===S.css(e,\"boxSizing\",!1,i),s=n?Ke(e,u,n,a,i):0;return\x20a&&o&&(s-=Math.ceil(e[\"offset\"+u[0].toUpperCase()+u.slice(1)]-parseFloat(i[u])-Ke(e,u,\"border\",!1,i)-.5)),s&&(r=te.exec(t))&&\"px\"!==(r[3]||\"px\")&&(e.style[u]=t,t=S.css(e,u)),Je(0,t,s)}}}),S.cssHooks.marginLeft=$e(y.reliableMarginLeft,function(e,t){if(t)return(parseFloat(Be(e,\"marginLeft\"))||e.getBoundingClientRect().left-We(e,{marginLeft:0},function(){return\x20e.getBoundingClientRect().left}))+\"px\"}),S.each({margin:\"\",padding:\"\",border:\"Width\"},function(i,o){S.cssHooks[i+o]={expand:function(e){for(var\x20t=0,n={},r=\"string\"==typeof\x20e?e.split(\"\x20\"):[e];t<4;t++)n[i+ne[t]+o]=r[t]||r[t-2]||r[0];return\x20n}},\"margin\"!==i&&(S.cssHooks[i+o].set=Je)}),S.fn.extend({css:function(e,t){return\x20$(this,function(e,t,n){var\x20r,i,o={},a=0;if(Array.isArray(t)){for(r=Ie(e),i=t.length;a<i;a++)o[t[a]]=S.css(e,t[a],!1,r);return\x20o}return\x20void\x200!==n?S.style(e,t,n):S.css(e,t)},e,t,1<arguments.length)}}),((S.Tween=et).prototype={constructor:et,init:function(e,t,n,r,i,o){this.elem=e,this.prop=n,this.easing=i||S.easing._default,this.options=t,this.start=this.now=this.cur(),this.end=r,this.unit=o||(S.cssNumber[n]?\"\":\"px\")},cur:function(){var\x20e=et.propHooks[this.prop];return\x20e&&e.get?e.get(this):et.propHooks._default.get(this)},run:function(e){var\x20t,n=et.propHooks[this.prop];return\x20this.options.duration?this.pos=t=S.easing[this.easing](e,this.options.duration*e,0,1,this.options.duration):this.pos=t=e,this.now=(this.end-this.start)*t+this.start,this.options.step&&this.options.step.call(this.elem,this.now,this),n&&n.set?n.set(this):et.propHooks._default.set(this),this}}).init.prototype=et.prototype,(et.propHooks={_default:{get:function(e){var\x20t;return\x201!==e.elem.nodeType||null!=e.elem[e.prop]&&null==e.elem.style[e.prop]?e.elem[e.prop]:(t=S.css(e.elem,e.prop,\"\"))&&\"auto\"!==t?t:0},set:function(e){S.fx.step[e.prop]?S.fx.step[e.prop](e):1!==e.elem.nodeType||!S.cssHooks[e.prop]&&null==e.elem.style[Xe(e.prop)]?e.elem[e.prop]=e.now:S.style(e.elem,e.prop,e.now+e.unit)}}}).scrollTop=et.propHooks.scrollLeft={set:function(e){e.elem.nodeType&&e.elem.parentNode&&(e.elem[e.prop]=e.now)}},S.easing={linear:function(e){return\x20e},swing:function(e){return.5-Math.cos(e*Math.PI)/2},_default:\"swing\"},S.fx=et.prototype.init,S.fx.step={};var\x20tt,nt,rt,it,ot=/^(?:toggle|show|hide)$/,at=/queueHooks$/;function\x20st(){nt&&(!1===E.hidden&&C.requestAnimationFrame?C.requestAnimationFrame(st):C.setTimeout(st,S.fx.interval),S.fx.tick())}function\x20ut(){return\x20C.setTimeout(function(){tt=void\x200}),tt=Date.now()}function\x20lt(e,t){var\x20n,r=0,i={height:e};for(t=t?1:0;r<4;r+=2-t)i[\"margin\"+(n=ne[r])]=i[\"padding\"+n]=e;return\x20t&&(i.opacity=i.width=e),i}function\x20ct(e,t,n){for(var\x20r,i=(ft.tweeners[t]||[]).concat(ft.tweeners[\"*\"]),o=0,a=i.length;o<a;o++)if(r=i[o].call(n,t,e))return\x20r}function\x20ft(o,e,t){var\x20n,a,r=0,i=ft.prefilters.length,s=S.Deferred().always(function(){delete\x20u.elem}),u=function(){if(a)return!1;for(var\x20e=tt||ut(),t=Math.max(0,l.startTime+l.duration-e),n=1-(t/l.duration||0),r=0,i=l.tweens.length;r<i;r++)l.tweens[r].run(n);return\x20s.notifyWith(o,[l,n,t]),n<1&&i?t:(i||s.notifyWith(o,[l,1,0]),s.resolveWith(o,[l]),!1)},l=s.promise({elem:o,props:S.extend({},e),opts:S.extend(!0,{specialEasing:{},easing:S.easing._default},t),originalProperties:e,originalOptions:t,startTime:tt||ut(),duration:t.duration,tweens:[],createTween:function(e,t){var\x20n=S.Tween(o,l.opts,e,t,l.opts.specialEasing[e]||l.opts.easing);return\x20l.tweens.push(n),n},stop:function(e){var\x20t=0,n=e?l.tweens.length:0;if(a)return\x20this;for(a=!0;t<n;t++)l.tweens[t].run(1);return\x20e?(s.notifyWith(o,[l,1,0]),s.resolveWith(o,[l,e])):s.rejectWith(o,[l,e]),this}}),c=l.props;for(!function(e,t){var\x20n,r,i,o,a;for(n\x20in\x20e)if(i=t[r=X(n)],o=e[n],Array.isArray(o)&&(i=o[1],o=e[n]=o[0]),n!==r&&(e[r]=o,delete\x20e[n]),(a=S.cssHooks[r])&&\"expand\"in\x20a)for(n\x20in\x20o=a.expand(o),delete\x20e[r],o)n\x20in\x20e||(e[n]=o[n],t[n]=i);else\x20t[r]=i}(c,l.opts.specialEasing);r<i;r++)if(n=ft.prefilters[r].call(l,o,c,l.opts))return\x20m(n.stop)&&(S._queueHooks(l.elem,l.opts.queue).stop=n.stop.bind(n)),n;return\x20S.map(c,ct,l),m(l.opts.start)&&l.opts.start.call(o,l),l.progress(l.opts.progress).done(l.opts.done,l.opts.complete).fail(l.opts.fail).always(l.opts.always),S.fx.timer(S.extend(u,{elem:o,anim:l,queue:l.opts.queue})),l}S.Animation=S.extend(ft,{tweeners:{\"*\":[function(e,t){var\x20n=this.createTween(e,t);return\x20se(n.elem,e,te.exec(t),n),n}]},tweener:function(e,t){m(e)?(t=e,e=[\"*\"]):e=e.match(P);for(var\x20n,r=0,i=e.length;r<i;r++)n=e[r],ft.tweeners[n]=ft.tweeners[n]||[],ft.tweeners[n].unshift(t)},prefilters:[function(e,t,n){var\x20r,i,o,a,s,u,l,c,f=\"width\"in\x20t||\"height\"in\x20t,p=this,d={},h=e.style,g=e.nodeType&&ae(e),v=Y.get(e,\"fxshow\");for(r\x20in\x20n.queue||(null==(a=S._queueHooks(e,\"fx\")).unqueued&&(a.unqueued=0,s=a.empty.fire,a.empty.fire=function(){a.unqueued||s()}),a.unqueued++,p.always(function(){p.always(function(){a.unqueued--,S.queue(e,\"fx\").length||a.empty.fire()})})),t)if(i=t[r],ot.test(i)){if(delete\x20t[r],o=o||\"toggle\"===i,i===(g?\"hide\":\"show\")){if(\"show\"!==i||!v||void\x200===v[r])continue;g=!0}d[r]=v&&v[r]||S.style(e,r)}if((u=!S.isEmptyObject(t))||!S.isEmptyObject(d))for(r\x20in\x20f&&1===e.nodeType&&(n.overflow=[h.overflow,h.overflowX,h.overflowY],null==(l=v&&v.display)&&(l=Y.get(e,\"display\")),\"none\"===(c=S.css(e,\"display\"))&&(l?c=l:(le([e],!0),l=e.style.display||l,c=S.css(e,\"display\"),le([e]))),(\"inline\"===c||\"inline-block\"===c&&null!=l)&&\"none\"===S.css(e,\"float\")&&(u||(p.done(function(){h.display=l}),null==l&&(c=h.display,l=\"none\"===c?\"\":c)),h.display=\"inline-block\")),n.overflow&&(h.overflow=\"hidden\",p.always(function(){h.overflow=n.overflow[0],h.overflowX=n.overflow[1],h.overflowY=n.overflow[2]})),u=!1,d)u||(v?\"hidden\"in\x20v&&(g=v.hidden):v=Y.access(e,\"fxshow\",{display:l}),o&&(v.hidden=!g),g&&le([e],!0),p.done(function(){for(r\x20in\x20g||le([e]),Y.remove(e,\"fxshow\"),d)S.style(e,r,d[r])})),u=ct(g?v[r]:0,r,p),r\x20in\x20v||(v[r]=u.start,g&&(u.end=u.start,u.start=0))}],prefilter:function(e,t){t?ft.prefilters.unshift(e):ft.prefilters.push(e)}}),S.speed=function(e,t,n){var\x20r=e&&\"object\"==typeof\x20e?S.extend({},e):{complete:n||!n&&t||m(e)&&e,duration:e,easing:n&&t||t&&!m(t)&&t};return\x20S.fx.off?r.duration=0:\"number\"!=typeof\x20r.duration&&(r.duration\x20in\x20S.fx.speeds?r.duration=S.fx.speeds[r.duration]:r.duration=S.fx.speeds._default),null!=r.queue&&!0!==r.queue||(r.queue=\"fx\"),r.old=r.complete,r.complete=function(){m(r.old)&&r.old.call(this),r.queue&&S.dequeue(this,r.queue)},r},S.fn.extend({fadeTo:function(e,t,n,r){return\x20this.filter(ae).css(\"opacity\",0).show().end().animate({opacity:t},e,n,r)},animate:function(t,e,n,r){var\x20i=S.isEmptyObject(t),o=S.speed(e,n,r),a=function(){var\x20e=ft(this,S.extend({},t),o);(i||Y.get(this,\"finish\"))&&e.stop(!0)};return\x20a.finish=a,i||!1===o.queue?this.each(a):this.queue(o.queue,a)},stop:function(i,e,o){var\x20a=function(e){var\x20t=e.stop;delete\x20e.stop,t(o)};return\"string\"!=typeof\x20i&&(o=e,e=i,i=void\x200),e&&this.queue(i||\"fx\",[]),this.each(function(){var\x20e=!0,t=null!=i&&i+\"queueHooks\",n=S.timers,r=Y.get(this);if(t)r[t]&&r[t].stop&&a(r[t]);else\x20for(t\x20in\x20r)r[t]&&r[t].stop&&at.test(t)&&a(r[t]);for(t=n.length;t--;)n[t].elem!==this||null!=i&&n[t].queue!==i||(n[t].anim.stop(o),e=!1,n.splice(t,1));!e&&o||S.dequeue(this,i)})},finish:function(a){return!1!==a&&(a=a||\"fx\"),this.each(function(){var\x20e,t=Y.get(this),n=t[a+\"queue\"],r=t[a+\"queueHooks\"],i=S.timers,o=n?n.length:0;for(t.finish=!0,S.queue(this,a,[]),r&&r.stop&&r.stop.call(this,!0),e=i.length;e--;)i[e].elem===this&&i[e].queue===a&&(i[e].anim.stop(!0),i.splice(e,1));for(e=0;e<o;e++)n[e]&&n[e].finish&&n[e].finish.call(this);delete\x20t.finish})}}),S.each([\"toggle\",\"show\",\"hide\"],function(e,r){var\x20i=S.fn[r];S.fn[r]=function(e,t,n){return\x20null==e||\"boolean\"==typeof\x20e?i.apply(this,arguments):this.animate(lt(r,!0),e,t,n)}}),S.each({slideDown:lt(\"show\"),slideUp:lt(\"hide\"),slideToggle:lt(\"toggle\"),fadeIn:{opacity:\"show\"},fadeOut:{opacity:\"hide\"},fadeToggle:{opacity:\"toggle\"}},function(e,r){S.fn[e]=function(e,t,n){return\x20this.animate(r,e,t,n)}}),S.timers=[],S.fx.tick=function(){var\x20e,t=0,n=S.timers;for(tt=Date.now();t<n.length;t++)(e=n[t])()||n[t]!==e||n.splice(t--,1);n.length||S.fx.stop(),tt=void\x200},S.fx.timer=function(e){S.timers.push(e),S.fx.start()},S.fx.interval=13,S.fx.start=function(){nt||(nt=!0,st())},S.fx.stop=function(){nt=null},S.fx.speeds={slow:600,fast:200,_default:400},S.fn.delay=function(r,e){return\x20r=S.fx&&S.fx.speeds[r]||r,e=e||\"fx\",this.queue(e,function(e,t){var\x20n=C.setTimeout(e,r);t.stop=function(){C.clearTimeout(n)}})},rt=E.createElement(\"input\"),it=E.createElement(\"select\").appendChild(E.createElement(\"option\")),rt.type=\"checkbox\",y.checkOn=\"\"!==rt.value,y.optSelected=it.selected,(rt=E.createElement(\"input\")).value=\"t\",rt.type=\"radio\",y.radioValue=\"t\"===rt.value;var\x20pt,dt=S.expr.attrHandle;S.fn.extend({attr:function(e,t){return\x20$(this,S.attr,e,t,1<arguments.length)},removeAttr:function(e){return\x20this.each(function(){S.removeAttr(this,e)})}}),S.extend({attr:function(e,t,n){var\x20r,i,o=e.nodeType;if(3!==o&&8!==o&&2!==o)return\"undefined\"==typeof\x20e.getAttribute?S.prop(e,t,n):(1===o&&S.isXMLDoc(e)||(i=S.attrHooks[t.toLowerCase()]||(S.expr.match.bool.test(t)?pt:void\x200)),void\x200!==n?null===n?void\x20S.removeAttr(e,t):i&&\"set\"in\x20i&&void\x200!==(r=i.set(e,n,t))?r:(e.setAttribute(t,n+\"\"),n):i&&\"get\"in\x20i&&null!==(r=i.get(e,t))?r:null==(r=S.find.attr(e,t))?void\x200:r)},attrHooks:{type:{set:function(e,t){if(!y.radioValue&&\"radio\"===t&&A(e,\"input\")){var\x20n=e.value;return\x20e.setAttribute(\"type\",t),n&&(e.value=n),t}}}},removeAttr:function(e,t){var\x20n,r=0,i=t&&t.match(P);if(i&&1===e.nodeType)while(n=i[r++])e.removeAttribute(n)}}),pt={set:function(e,t,n){return!1===t?S.removeAttr(e,n):e.setAttribute(n,n),n}},S.each(S.expr.match.bool.source.match(/\\w+/g),function(e,t){var\x20a=dt[t]||S.find.attr;dt[t]=function(e,t,n){var\x20r,i,o=t.toLowerCase();return\x20n||(i=dt[o],dt[o]=r,r=null!=a(e,t,n)?o:null,dt[o]=i),r}});var\x20ht=/^(?:input|select|textarea|button)$/i,gt=/^(?:a|area)$/i;function\x20vt(e){return(e.match(P)||[]).join(\"\x20\")}function\x20yt(e){return\x20e.getAttribute&&e.getAttribute(\"class\")||\"\"}function\x20mt(e){return\x20Array.isArray(e)?e:\"string\"==typeof\x20e&&e.match(P)||[]}S.fn.extend({prop:function(e,t){return\x20$(this,S.prop,e,t,1<arguments.length)},removeProp:function(e){return\x20this.each(function(){delete\x20this[S.propFix[e]||e]})}}),S.extend({prop:function(e,t,n){var\x20r,i,o=e.nodeType;if(3!==o&&8!==o&&2!==o)return\x201===o&&S.isXMLDoc(e)||(t=S.propFix[t]||t,i=S.propHooks[t]),void\x200!==n?i&&\"set\"in\x20i&&void\x200!==(r=i.set(e,n,t))?r:e[t]=n:i&&\"get\"in\x20i&&null!==(r=i.get(e,t))?r:e[t]},propHooks:{tabIndex:{get:function(e){var\x20t=S.find.attr(e,\"tabindex\");return\x20t?parseInt(t,10):ht.test(e.nodeName)||gt.test(e.nodeName)&&e.href?0:-1}}},propFix:{\"for\":\"htmlFor\",\"class\":\"className\"}}),y.optSelected||(S.propHooks.selected={get:function(e){var\x20t=e.parentNode;return\x20t&&t.parentNode&&t.parentNode.selectedIndex,null},set:function(e){var\x20t=e.parentNode;t&&(t.selectedIndex,t.parentNode&&t.parentNode.selectedIndex)}}),S.each([\"tabIndex\",\"readOnly\",\"maxLength\",\"cellSpacing\",\"cellPadding\",\"rowSpan\",\"colSpan\",\"useMap\",\"frameBorder\",\"contentEditable\"],function(){S.propFix[this.toLowerCase()]=this}),S.fn.extend({addClass:function(t){var\x20e,n,r,i,o,a,s,u=0;if(m(t))return\x20this.each(function(e){S(this).addClass(t.call(this,e,yt(this)))});if((e=mt(t)).length)while(n=this[u++])if(i=yt(n),r=1===n.nodeType&&\"\x20\"+vt(i)+\"\x20\"){a=0;while(o=e[a++])r.indexOf(\"\x20\"+o+\"\x20\")<0&&(r+=o+\"\x20\");i!==(s=vt(r))&&n.setAttribute(\"class\",s)}return\x20this},removeClass:function(t){var\x20e,n,r,i,o,a,s,u=0;if(m(t))return\x20this.each(function(e){S(this).removeClass(t.call(this,e,yt(this)))});if(!arguments.length)return\x20this.attr(\"class\",\"\");if((e=mt(t)).length)while(n=this[u++])if(i=yt(n),r=1===n.nodeType&&\"\x20\"+vt(i)+\"\x20\"){a=0;while(o=e[a++])while(-1<r.indexOf(\"\x20\"+o+\"\x20\"))r=r.replace(\"\x20\"+o+\"\x20\",\"\x20\");i!==(s=vt(r))&&n.setAttribute(\"class\",s)}return\x20this},toggleClass:function(i,t){var\x20o=typeof\x20i,a=\"string\"===o||Array.isArray(i);return\"boolean\"==typeof\x20t&&a?t?this.addClass(i):this.removeClass(i):m(i)?this.each(function(e){S(this).toggleClass(i.call(this,e,yt(this),t),t)}):this.each(function(){var\x20e,t,n,r;if(a){t=0,n=S(this),r=mt(i);while(e=r[t++])n.hasClass(e)?n.removeClass(e):n.addClass(e)}else\x20void\x200!==i&&\"boolean\"!==o||((e=yt(this))&&Y.set(this,\"__className__\",e),this.setAttribute&&this.setAttribute(\"class\",e||!1===i?\"\":Y.get(this,\"__className__\")||\"\"))})},hasClass:function(e){var\x20t,n,r=0;t=\"\x20\"+e+\"\x20\";while(n=this[r++])if(1===n.nodeType&&-1<(\"\x20\"+vt(yt(n))+\"\x20\").indexOf(t))return!0;return!1}});var\x20xt=/\\r/g;S.fn.extend({val:function(n){var\x20r,e,i,t=this[0];return\x20arguments.length?(i=m(n),this.each(function(e){var\x20t;1===this.nodeType&&(null==(t=i?n.call(this,e,S(this).val()):n)?t=\"\":\"number\"==typeof\x20t?t+=\"\":Array.isArray(t)&&(t=S.map(t,function(e){return\x20null==e?\"\":e+\"\"})),(r=S.valHooks[this.type]||S.valHooks[this.nodeName.toLowerCase()])&&\"set\"in\x20r&&void\x200!==r.set(this,t,\"value\")||(this.value=t))})):t?(r=S.valHooks[t.type]||S.valHooks[t.nodeName.toLowerCase()])&&\"get\"in\x20r&&void\x200!==(e=r.get(t,\"value\"))?e:\"string\"==typeof(e=t.value)?e.replace(xt,\"\"):null==e?\"\":e:void\x200}}),S.extend({valHooks:{option:{get:function(e){var\x20t=S.find.attr(e,\"value\");return\x20null!=t?t:vt(S.text(e))}},select:{get:function(e){var\x20t,n,r,i=e.options,o=e.selectedIndex,a=\"select-one\"===e.type,s=a?null:[],u=a?o+1:i.length;for(r=o<0?u:a?o:0;r<u;r++)if(((n=i[r]).selected||r===o)&&!n.disabled&&(!n.parentNode.disabled||!A(n.parentNode,\"optgroup\"))){if(t=S(n).val(),a)return\x20t;s.push(t)}return\x20s},set:function(e,t){var\x20n,r,i=e.options,o=S.makeArray(t),a=i.length;while(a--)((r=i[a]).selected=-1<S.inArray(S.valHooks.option.get(r),o))&&(n=!0);return\x20n||(e.selectedIndex=-1),o}}}}),S.each([\"radio\",\"checkbox\"],function(){S.valHooks[this]={set:function(e,t){if(Array.isArray(t))return\x20e.checked=-1<S.inArray(S(e).val(),t)}},y.checkOn||(S.valHooks[this].get=function(e){return\x20null===e.getAttribute(\"value\")?\"on\":e.value})}),y.focusin=\"onfocusin\"in\x20C;var\x20bt=/^(?:focusinfocus|focusoutblur)$/,wt=function(e){e.stopPropagation()};S.extend(S.event,{trigger:function(e,t,n,r){var\x20i,o,a,s,u,l,c,f,p=[n||E],d=v.call(e,\"type\")?e.type:e,h=v.call(e,\"namespace\")?e.namespace.split(\".\"):[];if(o=f=a=n=n||E,3!==n.nodeType&&8!==n.nodeType&&!bt.test(d+S.event.triggered)&&(-1<d.indexOf(\".\")&&(d=(h=d.split(\".\")).shift(),h.sort()),u=d.indexOf(\":\")<0&&\"on\"+d,(e=e[S.expando]?e:new\x20S.Event(d,\"object\"==typeof\x20e&&e)).isTrigger=r?2:3,e.namespace=h.join(\".\"),e.rnamespace=e.namespace?new\x20RegExp(\"(^|\\\\.)\"+h.join(\"\\\\.(?:.*\\\\.|)\")+\"(\\\\.|$)\"):null,e.result=void\x200,e.target||(e.target=n),t=null==t?[e]:S.makeArray(t,[e]),c=S.event.special[d]||{},r||!c.trigger||!1!==c.trigger.apply(n,t))){if(!r&&!c.noBubble&&!x(n)){for(s=c.delegateType||d,bt.test(s+d)||(o=o.parentNode);o;o=o.parentNode)p.push(o),a=o;a===(n.ownerDocument||E)&&p.push(a.defaultView||a.parentWindow||C)}i=0;while((o=p[i++])&&!e.isPropagationStopped())f=o,e.type=1<i?s:c.bindType||d,(l=(Y.get(o,\"events\")||Object.create(null))[e.type]&&Y.get(o,\"handle\"))&&l.apply(o,t),(l=u&&o[u])&&l.apply&&V(o)&&(e.result=l.apply(o,t),!1===e.result&&e.preventDefault());return\x20e.type=d,r||e.isDefaultPrevented()||c._default&&!1!==c._default.apply(p.pop(),t)||!V(n)||u&&m(n[d])&&!x(n)&&((a=n[u])&&(n[u]=null),S.event.triggered=d,e.isPropagationStopped()&&f.addEventListener(d,wt),n[d](),e.isPropagationStopped()&&f.removeEventListener(d,wt),S.event.triggered=void\x200,a&&(n[u]=a)),e.result}},simulate:function(e,t,n){var\x20r=S.extend(new\x20S.Event,n,{type:e,isSimulated:!0});S.event.trigger(r,null,t)}}),S.fn.extend({trigger:function(e,t){return\x20this.each(function(){S.event.trigger(e,t,this)})},triggerHandler:function(e,t){var\x20n=this[0];if(n)return\x20S.event.trigger(e,t,n,!0)}}),y.focusin||S.each({focus:\"focusin\",blur:\"focusout\"},function(n,r){var\x20i=function(e){S.event.simulate(r,e.target,S.event.fix(e))};S.event.special[r]={setup:function(){var\x20e=this.ownerDocument||this.document||this,t=Y.access(e,r);t||e.addEventListener(n,i,!0),Y.access(e,r,(t||0)+1)},teardown:function(){var\x20e=this.ownerDocument||this.document||this,t=Y.access(e,r)-1;t?Y.access(e,r,t):(e.removeEventListener(n,i,!0),Y.remove(e,r))}}});var\x20Tt=C.location,Ct={guid:Date.now()},Et=/\\?/;S.parseXML=function(e){var\x20t;if(!e||\"string\"!=typeof\x20e)return\x20null;try{t=(new\x20C.DOMParser).parseFromString(e,\"text/xml\")}catch(e){t=void\x200}return\x20t&&!t.getElementsByTagName(\"parsererror\").length||S.error(\"Invalid\x20XML:\x20\"+e),t};var\x20St=/\\[\\]$/,kt=/\\r?\\n/g,At=/^(?:submit|button|image|reset|file)$/i,Nt=/^(?:input|select|textarea|keygen)/i;function\x20Dt(n,e,r,i){var\x20t;if(Array.isArray(e))S.each(e,function(e,t){r||St.test(n)?i(n,t):Dt(n+\"[\"+(\"object\"==typeof\x20t&&null!=t?e:\"\")+\"]\",t,r,i)});else\x20if(r||\"object\"!==w(e))i(n,e);else\x20for(t\x20in\x20e)Dt(n+\"[\"+t+\"]\",e[t],r,i)}S.param=function(e,t){var\x20n,r=[],i=function(e,t){var\x20n=m(t)?t():t;r[r.length]=encodeURIComponent(e)+\"=\"+encodeURIComponent(null==n?\"\":n)};if(null==e)return\"\";if(Array.isArray(e)||e.jquery&&!S.isPlainObject(e))S.each(e,function(){i(this.name,this.value)});else\x20for(n\x20in\x20e)Dt(n,e[n],t,i);return\x20r.join(\"&\")},S.fn.extend({serialize:function(){return\x20S.param(this.serializeArray())},serializeArray:function(){return\x20this.map(function(){var\x20e=S.prop(this,\"elements\");return\x20e?S.makeArray(e):this}).filter(function(){var\x20e=this.type;return\x20this.name&&!S(this).is(\":disabled\")&&Nt.test(this.nodeName)&&!At.test(e)&&(this.checked||!pe.test(e))}).map(function(e,t){var\x20n=S(this).val();return\x20null==n?null:Array.isArray(n)?S.map(n,function(e){return{name:t.name,value:e.replace(kt,\"\\r\\n\")}}):{name:t.name,value:n.replace(kt,\"\\r\\n\")}}).get()}});var\x20jt=/%20/g,qt=/#.*$/,Lt=/([?&])_=[^&]*/,Ht=/^(.*?):[\x20\\t]*([^\\r\\n]*)$/gm,Ot=/^(?:GET|HEAD)$/,Pt=/^\\/\\//,Rt={},Mt={},It=\"*/\".concat(\"*\"),Wt=E.createElement(\"a\");function\x20Ft(o){return\x20function(e,t){\"string\"!=typeof\x20e&&(t=e,e=\"*\");var\x20n,r=0,i=e.toLowerCase().match(P)||[];if(m(t))while(n=i[r++])\"+\"===n[0]?(n=n.slice(1)||\"*\",(o[n]=o[n]||[]).unshift(t)):(o[n]=o[n]||[]).push(t)}}function\x20Bt(t,i,o,a){var\x20s={},u=t===Mt;function\x20l(e){var\x20r;return\x20s[e]=!0,S.each(t[e]||[],function(e,t){var\x20n=t(i,o,a);return\"string\"!=typeof\x20n||u||s[n]?u?!(r=n):void\x200:(i.dataTypes.unshift(n),l(n),!1)}),r}return\x20l(i.dataTypes[0])||!s[\"*\"]&&l(\"*\")}function\x20$t(e,t){var\x20n,r,i=S.ajaxSettings.flatOptions||{};for(n\x20in\x20t)void\x200!==t[n]&&((i[n]?e:r||(r={}))[n]=t[n]);return\x20r&&S.extend(!0,e,r),e}Wt.href=Tt.href,S.extend({active:0,lastModified:{},etag:{},ajaxSettings:{url:Tt.href,type:\"GET\",isLocal:/^(?:about|app|app-storage|.+-extension|file|res|widget):$/.test(Tt.protocol),global:!0,processData:!0,async:!0,contentType:\"application/x-www-form-urlencoded;\x20charset=UTF-8\",accepts:{\"*\":It,text:\"text/plain\",html:\"text/html\",xml:\"application/xml,\x20text/xml\",json:\"application/json,\x20text/javascript\"},contents:{xml:/\\bxml\\b/,html:/\\bhtml/,json:/\\bjson\\b/},responseFields:{xml:\"responseXML\",text:\"responseText\",json:\"responseJSON\"},converters:{\"*\x20text\":String,\"text\x20html\":!0,\"text\x20json\":JSON.parse,\"text\x20xml\":S.parseXML},flatOptions:{url:!0,context:!0}},ajaxSetup:function(e,t){return\x20t?$t($t(e,S.ajaxSettings),t):$t(S.ajaxSettings,e)},ajaxPrefilter:Ft(Rt),ajaxTransport:Ft(Mt),ajax:function(e,t){\"object\"==typeof\x20e&&(t=e,e=void\x200),t=t||{};var\x20c,f,p,n,d,r,h,g,i,o,v=S.ajaxSetup({},t),y=v.context||v,m=v.context&&(y.nodeType||y.jquery)?S(y):S.event,x=S.Deferred(),b=S.Callbacks(\"once\x20memory\"),w=v.statusCode||{},a={},s={},u=\"canceled\",T={readyState:0,getResponseHeader:function(e){var\x20t;if(h){if(!n){n={};while(t=Ht.exec(p))n[t[1].toLowerCase()+\"\x20\"]=(n[t[1].toLowerCase()+\"\x20\"]||[]).concat(t[2])}t=n[e.toLowerCase()+\"\x20\"]}return\x20null==t?null:t.join(\",\x20\")},getAllResponseHeaders:function(){return\x20h?p:null},setRequestHeader:function(e,t){return\x20null==h&&(e=s[e.toLowerCase()]=s[e.toLowerCase()]||e,a[e]=t),this},overrideMimeType:function(e){return\x20null==h&&(v.mimeType=e),this},statusCode:function(e){var\x20t;if(e)if(h)T.always(e[T.status]);else\x20for(t\x20in\x20e)w[t]=[w[t],e[t]];return\x20this},abort:function(e){var\x20t=e||u;return\x20c&&c.abort(t),l(0,t),this}};if(x.promise(T),v.url=((e||v.url||Tt.href)+\"\").replace(Pt,Tt.protocol+\"//\"),v.type=t.method||t.type||v.method||v.type,v.dataTypes=(v.dataType||\"*\").toLowerCase().match(P)||[\"\"],null==v.crossDomain){r=E.createElement(\"a\");try{r.href=v.url,r.href=r.href,v.crossDomain=Wt.protocol+\"//\"+Wt.host!=r.protocol+\"//\"+r.host}catch(e){v.crossDomain=!0}}if(v.data&&v.processData&&\"string\"!=typeof\x20v.data&&(v.data=S.param(v.data,v.traditional)),Bt(Rt,v,t,T),h)return\x20T;for(i\x20in(g=S.event&&v.global)&&0==S.active++&&S.event.trigger(\"ajaxStart\"),v.type=v.type.toUpperCase(),v.hasContent=!Ot.test(v.type),f=v.url.replace(qt,\"\"),v.hasContent?v.data&&v.processData&&0===(v.contentType||\"\").indexOf(\"application/x-www-form-urlencoded\")&&(v.data=v.data.replace(jt,\"+\")):(o=v.url.slice(f.length),v.data&&(v.processData||\"string\"==typeof\x20v.data)&&(f+=(Et.test(f)?\"&\":\"?\")+v.data,delete\x20v.data),!1===v.cache&&(f=f.replace(Lt,\"$1\"),o=(Et.test(f)?\"&\":\"?\")+\"_=\"+Ct.guid+++o),v.url=f+o),v.ifModified&&(S.lastModified[f]&&T.setRequestHeader(\"If-Modified-Since\",S.lastModified[f]),S.etag[f]&&T.setRequestHeader(\"If-None-Match\",S.etag[f])),(v.data&&v.hasContent&&!1!==v.contentType||t.contentType)&&T.setRequestHeader(\"Content-Type\",v.contentType),T.setRequestHeader(\"Accept\",v.dataTypes[0]&&v.accepts[v.dataTypes[0]]?v.accepts[v.dataTypes[0]]+(\"*\"!==v.dataTypes[0]?\",\x20\"+It+\";\x20q=0.01\":\"\"):v.accepts[\"*\"]),v.headers)T.setRequestHeader(i,v.headers[i]);if(v.beforeSend&&(!1===v.beforeSend.call(y,T,v)||h))return\x20T.abort();if(u=\"abort\",b.add(v.complete),T.done(v.success),T.fail(v.error),c=Bt(Mt,v,t,T)){if(T.readyState=1,g&&m.trigger(\"ajaxSend\",[T,v]),h)return\x20T;v.async&&0<v.timeout&&(d=C.setTimeout(function(){T.abort(\"timeout\")},v.timeout));try{h=!1,c.send(a,l)}catch(e){if(h)throw\x20e;l(-1,e)}}else\x20l(-1,\"No\x20Transport\");function\x20l(e,t,n,r){var\x20i,o,a,s,u,l=t;h||(h=!0,d&&C.clearTimeout(d),c=void\x200,p=r||\"\",T.readyState=0<e?4:0,i=200<=e&&e<300||304===e,n&&(s=function(e,t,n){var\x20r,i,o,a,s=e.contents,u=e.dataTypes;while(\"*\"===u[0])u.shift(),void\x200===r&&(r=e.mimeType||t.getResponseHeader(\"Content-Type\"));if(r)for(i\x20in\x20s)if(s[i]&&s[i].test(r)){u.unshift(i);break}if(u[0]in\x20n)o=u[0];else{for(i\x20in\x20n){if(!u[0]||e.converters[i+\"\x20\"+u[0]]){o=i;break}a||(a=i)}o=o||a}if(o)return\x20o!==u[0]&&u.unshift(o),n[o]}(v,T,n)),!i&&-1<S.inArray(\"script\",v.dataTypes)&&(v.converters[\"text\x20script\"]=function(){}),s=function(e,t,n,r){var\x20i,o,a,s,u,l={},c=e.dataTypes.slice();if(c[1])for(a\x20in\x20e.converters)l[a.toLowerCase()]=e.converters[a];o=c.shift();while(o)if(e.responseFields[o]&&(n[e.responseFields[o]]=t),!u&&r&&e.dataFilter&&(t=e.dataFilter(t,e.dataType)),u=o,o=c.shift())if(\"*\"===o)o=u;else\x20if(\"*\"!==u&&u!==o){if(!(a=l[u+\"\x20\"+o]||l[\"*\x20\"+o]))for(i\x20in\x20l)if((s=i.split(\"\x20\"))[1]===o&&(a=l[u+\"\x20\"+s[0]]||l[\"*\x20\"+s[0]])){!0===a?a=l[i]:!0!==l[i]&&(o=s[0],c.unshift(s[1]));break}if(!0!==a)if(a&&e[\"throws\"])t=a(t);else\x20try{t=a(t)}catch(e){return{state:\"parsererror\",error:a?e:\"No\x20conversion\x20from\x20\"+u+\"\x20to\x20\"+o}}}return{state:\"success\",data:t}}(v,s,T,i),i?(v.ifModified&&((u=T.getResponseHeader(\"Last-Modified\"))&&(S.lastModified[f]=u),(u=T.getResponseHeader(\"etag\"))&&(S.etag[f]=u)),204===e||\"HEAD\"===v.type?l=\"nocontent\":304===e?l=\"notmodified\":(l=s.state,o=s.data,i=!(a=s.error))):(a=l,!e&&l||(l=\"error\",e<0&&(e=0))),T.status=e,T.statusText=(t||l)+\"\",i?x.resolveWith(y,[o,l,T]):x.rejectWith(y,[T,l,a]),T.statusCode(w),w=void\x200,g&&m.trigger(i?\"ajaxSuccess\":\"ajaxError\",[T,v,i?o:a]),b.fireWith(y,[T,l]),g&&(m.trigger(\"ajaxComplete\",[T,v]),--S.active||S.event.trigger(\"ajaxStop\")))}return\x20T},getJSON:function(e,t,n){return\x20S.get(e,t,n,\"json\")},getScript:function(e,t){return\x20S.get(e,void\x200,t,\"script\")}}),S.each([\"get\",\"post\"],function(e,i){S[i]=function(e,t,n,r){return\x20m(t)&&(r=r||n,n=t,t=void\x200),S.ajax(S.extend({url:e,type:i,dataType:r,data:t,success:n},S.isPlainObject(e)&&e))}}),S.ajaxPrefilter(function(e){var\x20t;for(t\x20in\x20e.headers)\"content-type\"===t.toLowerCase()&&(e.contentType=e.headers[t]||\"\")}),S._evalUrl=function(e,t,n){return\x20S.ajax({url:e,type:\"GET\",dataType:\"script\",cache:!0,async:!1,global:!1,converters:{\"text\x20script\":function(){}},dataFilter:function(e){S.globalEval(e,t,n)}})},S.fn.extend({wrapAll:function(e){var\x20t;return\x20this[0]&&(m(e)&&(e=e.call(this[0])),t=S(e,this[0].ownerDocument).eq(0).clone(!0),this[0].parentNode&&t.insertBefore(this[0]),t.map(function(){var\x20e=this;while(e.firstElementChild)e=e.firstElementChild;return\x20e}).append(this)),this},wrapInner:function(n){return\x20m(n)?this.each(function(e){S(this).wrapInner(n.call(this,e))}):this.each(function(){var\x20e=S(this),t=e.contents();t.length?t.wrapAll(n):e.append(n)})},wrap:function(t){var\x20n=m(t);return\x20this.each(function(e){S(this).wrapAll(n?t.call(this,e):t)})},unwrap:function(e){return\x20this.parent(e).not(\"body\").each(function(){S(this).replaceWith(this.childNodes)}),this}}),S.expr.pseudos.hidden=function(e){return!S.expr.pseudos.visible(e)},S.expr.pseudos.visible=function(e){return!!(e.offsetWidth||e.offsetHeight||e.getClientRects().length)},S.ajaxSettings.xhr=function(){try{return\x20new\x20C.XMLHttpRequest}catch(e){}};var\x20_t={0:200,1223:204},zt=S.ajaxSettings.xhr();y.cors=!!zt&&\"withCredentials\"in\x20zt,y.ajax=zt=!!zt,S.ajaxTransport(function(i){var\x20o,a;if(y.cors||zt&&!i.crossDomain)return{send:function(e,t){var\x20n,r=i.xhr();if(r.open(i.type,i.url,i.async,i.username,i.password),i.xhrFields)for(n\x20in\x20i.xhrFields)r[n]=i.xhrFields[n];for(n\x20in\x20i.mimeType&&r.overrideMimeType&&r.overrideMimeType(i.mimeType),i.crossDomain||e[\"X-Requested-With\"]||(e[\"X-Requested-With\"]=\"XMLHttpRequest\"),e)r.setRequestHeader(n,e[n]);o=function(e){return\x20function(){o&&(o=a=r.onload=r.onerror=r.onabort=r.ontimeout=r.onreadystatechange=null,\"abort\"===e?r.abort():\"error\"===e?\"number\"!=typeof\x20r.status?t(0,\"error\"):t(r.status,r.statusText):t(_t[r.status]||r.status,r.statusText,\"text\"!==(r.responseType||\"text\")||\"string\"!=typeof\x20r.responseText?{binary:r.response}:{text:r.responseText},r.getAllResponseHeaders()))}},r.onload=o(),a=r.onerror=r.ontimeout=o(\"error\"),void\x200!==r.onabort?r.onabort=a:r.onreadystatechange=function(){4===r.readyState&&C.setTimeout(function(){o&&a()})},o=o(\"abort\");try{r.send(i.hasContent&&i.data||null)}catch(e){if(o)throw\x20e}},abort:function(){o&&o()}}}),S.ajaxPrefilter(function(e){e.crossDomain&&(e.contents.script=!1)}),S.ajaxSetup({accepts:{script:\"text/javascript,\x20application/javascript,\x20application/ecmascript,\x20application/x-ecmascript\"},contents:{script:/\\b(?:java|ecma)script\\b/},converters:{\"text\x20script\":function(e){return\x20S.globalEval(e),e}}}),S.ajaxPrefilter(\"script\",function(e){void\x200===e.cache&&(e.cache=!1),e.crossDomain&&(e.type=\"GET\")}),S.ajaxTransport(\"script\",function(n){var\x20r,i;if(n.crossDomain||n.scriptAttrs)return{send:function(e,t){r=S(\"<script>\").attr(n.scriptAttrs||{}).prop({charset:n.scriptCharset,src:n.url}).on(\"load\x20error\",i=function(e){r.remove(),i=null,e&&t(\"error\"===e.type?404:200,e.type)}),E.head.appendChild(r[0])},abort:function(){i&&i()}}});var\x20Ut,Xt=[],Vt=/(=)\\?(?=&|$)|\\?\\?/;S.ajaxSetup({jsonp:\"callback\",jsonpCallback:function(){var\x20e=Xt.pop()||S.expando+\"_\"+Ct.guid++;return\x20this[e]=!0,e}}),S.ajaxPrefilter(\"json\x20jsonp\",function(e,t,n){var\x20r,i,o,a=!1!==e.jsonp&&(Vt.test(e.url)?\"url\":\"string\"==typeof\x20e.data&&0===(e.contentType||\"\").indexOf(\"application/x-www-form-urlencoded\")&&Vt.test(e.data)&&\"data\");if(a||\"jsonp\"===e.dataTypes[0])return\x20r=e.jsonpCallback=m(e.jsonpCallback)?e.jsonpCallback():e.jsonpCallback,a?e[a]=e[a].replace(Vt,\"$1\"+r):!1!==e.jsonp&&(e.url+=(Et.test(e.url)?\"&\":\"?\")+e.jsonp+\"=\"+r),e.converters[\"script\x20json\"]=function(){return\x20o||S.error(r+\"\x20was\x20not\x20called\"),o[0]},e.dataTypes[0]=\"json\",i=C[r],C[r]=function(){o=arguments},n.always(function(){void\x200===i?S(C).removeProp(r):C[r]=i,e[r]&&(e.jsonpCallback=t.jsonpCallback,Xt.push(r)),o&&m(i)&&i(o[0]),o=i=void\x200}),\"script\"}),y.createHTMLDocument=((Ut=E.implementation.createHTMLDocument(\"\").body).innerHTML=\"<form></form><form></form>\",2===Ut.childNodes.length),S.parseHTML=function(e,t,n){return\"string\"!=typeof\x20e?[]:(\"boolean\"==typeof\x20t&&(n=t,t=!1),t||(y.createHTMLDocument?((r=(t=E.implementation.createHTMLDocument(\"\")).createElement(\"base\")).href=E.location.href,t.head.appendChild(r)):t=E),o=!n&&[],(i=N.exec(e))?[t.createElement(i[1])]:(i=xe([e],t,o),o&&o.length&&S(o).remove(),S.merge([],i.childNodes)));var\x20r,i,o},S.fn.load=function(e,t,n){var\x20r,i,o,a=this,s=e.indexOf(\"\x20\");return-1<s&&(r=vt(e.slice(s)),e=e.slice(0,s)),m(t)?(n=t,t=void\x200):t&&\"object\"==typeof\x20t&&(i=\"POST\"),0<a.length&&S.ajax({url:e,type:i||\"GET\",dataType:\"html\",data:t}).done(function(e){o=arguments,a.html(r?S(\"<div>\").append(S.parseHTML(e)).find(r):e)}).always(n&&function(e,t){a.each(function(){n.apply(this,o||[e.responseText,t,e])})}),this},S.expr.pseudos.animated=function(t){return\x20S.grep(S.timers,function(e){return\x20t===e.elem}).length},S.offset={setOffset:function(e,t,n){var\x20r,i,o,a,s,u,l=S.css(e,\"position\"),c=S(e),f={};\"static\"===l&&(e.style.position=\"relative\"),s=c.offset(),o=S.css(e,\"top\"),u=S.css(e,\"left\"),(\"absolute\"===l||\"fixed\"===l)&&-1<(o+u).indexOf(\"auto\")?(a=(r=c.position()).top,i=r.left):(a=parseFloat(o)||0,i=parseFloat(u)||0),m(t)&&(t=t.call(e,n,S.extend({},s))),null!=t.top&&(f.top=t.top-s.top+a),null!=t.left&&(f.left=t.left-s.left+i),\"using\"in\x20t?t.using.call(e,f):(\"number\"==typeof\x20f.top&&(f.top+=\"px\"),\"number\"==typeof\x20f.left&&(f.left+=\"px\"),c.css(f))}},S.fn.extend({offset:function(t){if(arguments.length)return\x20void\x200===t?this:this.each(function(e){S.offset.setOffset(this,t,e)});var\x20e,n,r=this[0];return\x20r?r.getClientRects().length?(e=r.getBoundingClientRect(),n=r.ownerDocument.defaultView,{top:e.top+n.pageYOffset,left:e.left+n.pageXOffset}):{top:0,left:0}:void\x200},position:function(){if(this[0]){var\x20e,t,n,r=this[0],i={top:0,left:0};if(\"fixed\"===S.css(r,\"position\"))t=r.getBoundingClientRect();else{t=this.offset(),n=r.ownerDocument,e=r.offsetParent||n.documentElement;while(e&&(e===n.body||e===n.documentElement)&&\"static\"===S.css(e,\"position\"))e=e.parentNode;e&&e!==r&&1===e.nodeType&&((i=S(e).offset()).top+=S.css(e,\"borderTopWidth\",!0),i.left+=S.css(e,\"borderLeftWidth\",!0))}return{top:t.top-i.top-S.css(r,\"marginTop\",!0),left:t.left-i.left-S.css(r,\"marginLeft\",!0)}}},offsetParent:function(){return\x20this.map(function(){var\x20e=this.offsetParent;while(e&&\"static\"===S.css(e,\"position\"))e=e.offsetParent;return\x20e||re})}}),S.each({scrollLeft:\"pageXOffset\",scrollTop:\"pageYOffset\"},function(t,i){var\x20o=\"pageYOffset\"===i;S.fn[t]=function(e){return\x20$(this,function(e,t,n){var\x20r;if(x(e)?r=e:9===e.nodeType&&(r=e.defaultView),void\x200===n)return\x20r?r[i]:e[t];r?r.scrollTo(o?r.pageXOffset:n,o?n:r.pageYOffset):e[t]=n},t,e,arguments.length)}}),S.each([\"top\",\"left\"],function(e,n){S.cssHooks[n]=$e(y.pixelPosition,function(e,t){if(t)return\x20t=Be(e,n),Me.test(t)?S(e).position()[n]+\"px\":t})}),S.each({Height:\"height\",Width:\"width\"},function(a,s){S.each({padding:\"inner\"+a,content:s,\"\":\"outer\"+a},function(r,o){S.fn[o]=function(e,t){var\x20n=arguments.length&&(r||\"boolean\"!=typeof\x20e),i=r||(!0===e||!0===t?\"margin\":\"border\");return\x20$(this,function(e,t,n){var\x20r;return\x20x(e)?0===o.indexOf(\"outer\")?e[\"inner\"+a]:e.document.documentElement[\"client\"+a]:9===e.nodeType?(r=e.documentElement,Math.max(e.body[\"scroll\"+a],r[\"scroll\"+a],e.body[\"offset\"+a],r[\"offset\"+a],r[\"client\"+a])):void\x200===n?S.css(e,t,i):S.style(e,t,n,i)},s,n?e:void\x200,n)}})}),S.each([\"ajaxStart\",\"ajaxStop\",\"ajaxComplete\",\"ajaxError\",\"ajaxSuccess\",\"ajaxSend\"],function(e,t){S.fn[t]=function(e){return\x20this.on(t,e)}}),S.fn.extend({bind:function(e,t,n){return\x20this.on(e,null,t,n)},unbind:function(e,t){return\x20this.off(e,null,t)},delegate:function(e,t,n,r){return\x20this.on(t,e,n,r)},undelegate:function(e,t,n){return\x201===arguments.length?this.off(e,\"**\"):this.off(t,e||\"**\",n)},hover:function(e,t){return\x20this.mouseenter(e).mouseleave(t||e)}}),S.each(\"blur\x20focus\x20focusin\x20focusout\x20resize\x20scroll\x20click\x20dblclick\x20mousedown\x20mouseup\x20mousemove\x20mouseover\x20mouseout\x20mouseenter\x20mouseleave\x20change\x20select\x20submit\x20keydown\x20keypress\x20keyup\x20contextmenu\".split(\"\x20\"),function(e,n){S.fn[n]=function(e,t){return\x200<arguments.length?this.on(n,null,e,t):this.trigger(n)}});var\x20Gt=/^[\\s\\uFEFF\\xA0]+|[\\s\\uFEFF\\xA0]+$/g;S.proxy=function(e,t){var\x20n,r,i;if(\"string\"==typeof\x20t&&(n=e[t],t=e,e=n),m(e))return\x20r=s.call(arguments,2),(i=function(){return\x20e.apply(t||this,r.concat(s.call(arguments)))}).guid=e.guid=e.guid||S.guid++,i},S.holdReady=function(e){e?S.readyWait++:S.ready(!0)},S.isArray=Array.isArray,S.parseJSON=JSON.parse,S.nodeName=A,S.isFunction=m,S.isWindow=x,S.camelCase=X,S.type=w,S.now=Date.now,S.isNumeric=function(e){var\x20t=S.type(e);return(\"number\"===t||\"string\"===t)&&!isNaN(e-parseFloat(e))},S.trim=function(e){return\x20null==e?\"\":(e+\"\").replace(Gt,\"\")},\"function\"==typeof\x20define&&define.amd&&define(\"jquery\",[],function(){return\x20S});var\x20Yt=C.jQuery,Qt=C.$;return\x20S.noConflict=function(e){return\x20C.$===S&&(C.$=Qt),e&&C.jQuery===S&&(C.jQuery=Yt),S},\"undefined\"==typeof\x20e&&(C.jQuery=C.$=S),S});",
 
-	"layout.html": "<!DOCTYPE\x20html>\x0a<html>\x0a<head>\x0a\x20\x20<meta\x20http-equiv=\"Content-Type\"\x20content=\"text/html;\x20charset=utf-8\">\x0a\x20\x20<meta\x20name=\"viewport\"\x20content=\"width=device-width,\x20initial-scale=1\">\x0a\x20\x20<meta\x20name=\"theme-color\"\x20content=\"#375EAB\">\x0a\x20\x20{{\x20with\x20.Title\x20-}}\x0a\x20\x20<title>{{html\x20.}}\x20-\x20Go\x20Documentation\x20Server</title>\x0a\x20\x20{{-\x20else\x20-}}\x0a\x20\x20<title>Go\x20Documentation\x20Server</title>\x0a\x20\x20{{-\x20end\x20}}\x0a\x20\x20<link\x20type=\"text/css\"\x20rel=\"stylesheet\"\x20href=\"/_static/bootstrap-grid.min.css\">\x0a\x20\x20<link\x20type=\"text/css\"\x20rel=\"stylesheet\"\x20href=\"/_static/bootstrap-reboot.min.css\">\x0a\x20\x20<link\x20type=\"text/css\"\x20rel=\"stylesheet\"\x20href=\"/_static/bootstrap.min.css\">\x0a\x20\x20<link\x20type=\"text/css\"\x20rel=\"stylesheet\"\x20href=\"/_static/style.css\">\x0a\x20\x20<script\x20src=\"/_static/jquery.js\"></script>\x0a\x20\x20<script\x20src=\"/_static/popper.min.js\"></script>\x0a\x20\x20<script\x20src=\"/_static/bootstrap.bundle.min.js\"></script>\x0a\x20\x20<script\x20src=\"/_static/bootstrap.min.js\"></script>\x0a\x20\x20<script\x20src=\"/_static/godocs.js\"\x20defer></script>\x0a</head>\x0a<body>\x0a\x20\x20<aside\x20id=\"sidebar\">\x0a\x20\x20\x20\x20<div\x20class=\"brand\">\x0a\x20\x20\x20\x20\x20\x20<a\x20href=\"/\">Go\x20Documentation</a>\x0a\x20\x20\x20\x20</div>\x0a\x0a\x20\x20\x20\x20{{-\x20printf\x20\"%s\"\x20.Sidebar\x20|\x20unescaped\x20-}}\x20{{-\x20/*\x20Sidebar\x20is\x20HTML-escaped\x20elsewhere\x20*/\x20-}}\x0a\x20\x20</aside>\x0a\x0a\x20\x20<main\x20id=\"main-column\">\x0a\x20\x20\x20\x20<div\x20id=\"documentation\"\x20class=\"markdown-body\">\x0a\x20\x20\x20\x20\x20\x20<button\x20class=\"btn\x20btn-link\x20btn-sm\"\x20id=\"btn-printer\"\x20data-toggle=\"tooltip\"\x20data-placement=\"top\"\x20title=\"Print\x20this\x20documentation\">\x0a\x20\x20\x20\x20\x20\x20\x20\x20<svg\x20width=\"1em\"\x20height=\"1em\"\x20viewBox=\"0\x200\x2016\x2016\"\x20class=\"bi\x20bi-printer\"\x20fill=\"currentColor\"\x20xmlns=\"http://www.w3.org/2000/svg\">\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<path\x20d=\"M11\x202H5a1\x201\x200\x200\x200-1\x201v2H3V3a2\x202\x200\x200\x201\x202-2h6a2\x202\x200\x200\x201\x202\x202v2h-1V3a1\x201\x200\x200\x200-1-1zm3\x204H2a1\x201\x200\x200\x200-1\x201v3a1\x201\x200\x200\x200\x201\x201h1v1H2a2\x202\x200\x200\x201-2-2V7a2\x202\x200\x200\x201\x202-2h12a2\x202\x200\x200\x201\x202\x202v3a2\x202\x200\x200\x201-2\x202h-1v-1h1a1\x201\x200\x200\x200\x201-1V7a1\x201\x200\x200\x200-1-1z\"/>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<path\x20fill-rule=\"evenodd\"\x20d=\"M11\x209H5a1\x201\x200\x200\x200-1\x201v3a1\x201\x200\x200\x200\x201\x201h6a1\x201\x200\x200\x200\x201-1v-3a1\x201\x200\x200\x200-1-1zM5\x208a2\x202\x200\x200\x200-2\x202v3a2\x202\x200\x200\x200\x202\x202h6a2\x202\x200\x200\x200\x202-2v-3a2\x202\x200\x200\x200-2-2H5z\"/>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<path\x20d=\"M3\x207.5a.5.5\x200\x201\x201-1\x200\x20.5.5\x200\x200\x201\x201\x200z\"/>\x0a\x20\x20\x20\x20\x20\x20\x20\x20</svg>\x0a\x20\x20\x20\x20\x20\x20</button>\x0a\x0a\x20\x20\x20\x20\x20\x20{{-\x20printf\x20\"%s\"\x20.Body\x20|\x20unescaped\x20-}}\x20{{-\x20/*\x20Body\x20is\x20HTML-escaped\x20elsewhere\x20*/\x20-}}\x0a\x20\x20\x20\x20</div>\x0a\x0a\x20\x20\x20\x20<div\x20id=\"footer\">Made\x20by\x20gsd</div>\x0a\x20\x20</main>\x0a</body>\x0a</html>",
+	"layout.html": "<!DOCTYPE\x20html>\x0a<html\x20data-theme=\"{{\x20.Corpus.Theme\x20}}\">\x0a<head>\x0a\x20\x20<meta\x20http-equiv=\"Content-Type\"\x20content=\"text/html;\x20charset=utf-8\">\x0a\x20\x20<meta\x20name=\"viewport\"\x20content=\"width=device-width,\x20initial-scale=1\">\x0a\x20\x20<meta\x20name=\"theme-color\"\x20content=\"#375EAB\">\x0a\x20\x20{{\x20with\x20.Title\x20-}}\x0a\x20\x20<title>{{html\x20.}}\x20-\x20Go\x20Documentation\x20Server</title>\x0a\x20\x20{{-\x20else\x20-}}\x0a\x20\x20<title>Go\x20Documentation\x20Server</title>\x0a\x20\x20{{-\x20end\x20}}\x0a\x20\x20{{-\x20if\x20.Bundle\x20}}\x0a\x20\x20<style>{{\x20static_file\x20\"bootstrap-grid.min.css\"\x20}}</style>\x0a\x20\x20<style>{{\x20static_file\x20\"bootstrap-reboot.min.css\"\x20}}</style>\x0a\x20\x20<style>{{\x20static_file\x20\"bootstrap.min.css\"\x20}}</style>\x0a\x20\x20<style>{{\x20static_file\x20\"style.css\"\x20}}</style>\x0a\x20\x20<script>{{\x20static_file\x20\"jquery.js\"\x20}}</script>\x0a\x20\x20<script>{{\x20static_file\x20\"popper.min.js\"\x20}}</script>\x0a\x20\x20<script>{{\x20static_file\x20\"bootstrap.bundle.min.js\"\x20}}</script>\x0a\x20\x20<script>{{\x20static_file\x20\"bootstrap.min.js\"\x20}}</script>\x0a\x20\x20<script\x20type=\"application/json\"\x20id=\"search-index\">{{\x20search_index\x20}}</script>\x0a\x20\x20{{-\x20else\x20}}\x0a\x20\x20<link\x20type=\"text/css\"\x20rel=\"stylesheet\"\x20href=\"{{\x20static_url\x20\"bootstrap-grid.min.css\"\x20}}\">\x0a\x20\x20<link\x20type=\"text/css\"\x20rel=\"stylesheet\"\x20href=\"{{\x20static_url\x20\"bootstrap-reboot.min.css\"\x20}}\">\x0a\x20\x20<link\x20type=\"text/css\"\x20rel=\"stylesheet\"\x20href=\"{{\x20static_url\x20\"bootstrap.min.css\"\x20}}\">\x0a\x20\x20<link\x20type=\"text/css\"\x20rel=\"stylesheet\"\x20href=\"{{\x20static_url\x20\"style.css\"\x20}}\">\x0a\x20\x20<script\x20src=\"{{\x20static_url\x20\"jquery.js\"\x20}}\"></script>\x0a\x20\x20<script\x20src=\"{{\x20static_url\x20\"popper.min.js\"\x20}}\"></script>\x0a\x20\x20<script\x20src=\"{{\x20static_url\x20\"bootstrap.bundle.min.js\"\x20}}\"></script>\x0a\x20\x20<script\x20src=\"{{\x20static_url\x20\"bootstrap.min.js\"\x20}}\"></script>\x0a\x20\x20<script\x20src=\"{{\x20static_url\x20\"godocs.js\"\x20}}\"\x20defer></script>\x0a\x20\x20{{-\x20end\x20}}\x0a</head>\x0a<body\x20{{-\x20with\x20.Package\x20}}\x20data-import-path=\"{{\x20.ImportPath\x20}}\"{{\x20end\x20}}\x20{{-\x20if\x20not\x20.Static\x20}}\x20data-live-reload=\"{{\x20abs_url\x20\"/_events\"\x20}}\"{{\x20end\x20}}>\x0a\x20\x20<aside\x20id=\"sidebar\">\x0a\x20\x20\x20\x20<div\x20class=\"brand\">\x0a\x20\x20\x20\x20\x20\x20<a\x20href=\"{{\x20if\x20.Bundle\x20}}#{{\x20else\x20}}{{\x20abs_url\x20\"/\"\x20}}{{\x20end\x20}}\">Go\x20Documentation</a>\x0a\x20\x20\x20\x20</div>\x0a\x0a\x20\x20\x20\x20<form\x20class=\"search-box\"\x20action=\"{{\x20abs_url\x20\"/search\"\x20}}\"\x20method=\"GET\"\x20{{-\x20if\x20.Bundle\x20}}\x20data-search-index=\"#search-index\"{{\x20else\x20if\x20.Static\x20}}\x20data-search-index=\"{{\x20static_url\x20\"search-index.json\"\x20}}\"{{\x20end\x20}}>\x0a\x20\x20\x20\x20\x20\x20<input\x20type=\"search\"\x20class=\"form-control\x20form-control-sm\"\x20name=\"q\"\x20value=\"{{-\x20.Query\x20-}}\"\x20placeholder=\"Search\"\x20aria-label=\"Search\"\x20autocomplete=\"off\">\x0a\x20\x20\x20\x20\x20\x20<ul\x20class=\"search-dropdown\"></ul>\x0a\x20\x20\x20\x20</form>\x0a\x0a\x20\x20\x20\x20{{-\x20printf\x20\"%s\"\x20.Sidebar\x20|\x20unescaped\x20-}}\x20{{-\x20/*\x20Sidebar\x20is\x20HTML-escaped\x20elsewhere\x20*/\x20-}}\x0a\x20\x20</aside>\x0a\x0a\x20\x20<main\x20id=\"main-column\">\x0a\x20\x20\x20\x20<div\x20id=\"reload-error\"\x20class=\"alert\x20alert-danger\x20d-none\"\x20role=\"alert\"></div>\x0a\x0a\x20\x20\x20\x20<div\x20id=\"documentation\"\x20class=\"markdown-body\">\x0a\x20\x20\x20\x20\x20\x20<button\x20class=\"btn\x20btn-link\x20btn-sm\"\x20id=\"btn-printer\"\x20data-toggle=\"tooltip\"\x20data-placement=\"top\"\x20title=\"Print\x20this\x20documentation\">\x0a\x20\x20\x20\x20\x20\x20\x20\x20<svg\x20width=\"1em\"\x20height=\"1em\"\x20viewBox=\"0\x200\x2016\x2016\"\x20class=\"bi\x20bi-printer\"\x20fill=\"currentColor\"\x20xmlns=\"http://www.w3.org/2000/svg\">\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<path\x20d=\"M11\x202H5a1\x201\x200\x200\x200-1\x201v2H3V3a2\x202\x200\x200\x201\x202-2h6a2\x202\x200\x200\x201\x202\x202v2h-1V3a1\x201\x200\x200\x200-1-1zm3\x204H2a1\x201\x200\x200\x200-1\x201v3a1\x201\x200\x200\x200\x201\x201h1v1H2a2\x202\x200\x200\x201-2-2V7a2\x202\x200\x200\x201\x202-2h12a2\x202\x200\x200\x201\x202\x202v3a2\x202\x200\x200\x201-2\x202h-1v-1h1a1\x201\x200\x200\x200\x201-1V7a1\x201\x200\x200\x200-1-1z\"/>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<path\x20fill-rule=\"evenodd\"\x20d=\"M11\x209H5a1\x201\x200\x200\x200-1\x201v3a1\x201\x200\x200\x200\x201\x201h6a1\x201\x200\x200\x200\x201-1v-3a1\x201\x200\x200\x200-1-1zM5\x208a2\x202\x200\x200\x200-2\x202v3a2\x202\x200\x200\x200\x202\x202h6a2\x202\x200\x200\x200\x202-2v-3a2\x202\x200\x200\x200-2-2H5z\"/>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<path\x20d=\"M3\x207.5a.5.5\x200\x201\x201-1\x200\x20.5.5\x200\x200\x201\x201\x200z\"/>\x0a\x20\x20\x20\x20\x20\x20\x20\x20</svg>\x0a\x20\x20\x20\x20\x20\x20</button>\x0a\x0a\x20\x20\x20\x20\x20\x20{{-\x20printf\x20\"%s\"\x20.Body\x20|\x20unescaped\x20-}}\x20{{-\x20/*\x20Body\x20is\x20HTML-escaped\x20elsewhere\x20*/\x20-}}\x0a\x20\x20\x20\x20</div>\x0a\x0a\x20\x20\x20\x20<div\x20id=\"footer\">Made\x20by\x20gsd</div>\x0a\x20\x20</main>\x0a\x20\x20{{-\x20if\x20.Bundle\x20}}\x0a\x20\x20<script>{{\x20static_file\x20\"godocs.js\"\x20}}</script>\x0a\x20\x20{{-\x20end\x20}}\x0a</body>\x0a</html>",
 
-	"package.html": "<!--\x20package.html\x20-->\x0a{{-\x20with\x20.Package\x20-}}\x0a\x0a\x20\x20{{-\x20$package\x20:=\x20.\x20-}}\x0a\x0a\x20\x20<h1\x20id=\"pkg-title-{{\x20.Name\x20}}\">Package\x20{{\x20.Name\x20}}</h1>\x0a\x0a\x20\x20<pre>import\x20\"{{-\x20.ImportPath\x20-}}\"</pre>\x0a\x0a\x20\x20{{\x20if\x20or\x20.Doc\x20.ImportComment\x20}}\x0a\x20\x20<h2>Overview</h2>\x0a\x20\x20<div\x20class=\"doc\">\x0a\x20\x20\x20\x20{{\x20comment_html\x20.Doc\x20|\x20unescaped\x20}}\x0a\x20\x20\x20\x20{{\x20comment_html\x20.ImportComment\x20|\x20unescaped\x20}}\x0a\x20\x20</div>\x0a\x20\x20{{-\x20end\x20}}\x0a\x0a\x0a\x20\x20<!--\x20{{-\x20/*\x20.Imports\x20*/\x20-}}\x20-->\x0a\x0a\x0a\x20\x20{{-\x20if\x20.Examples\x20}}\x0a\x20\x20<div\x20id=\"pkg-examples\">\x0a\x20\x20\x20\x20<h2>Examples</h2>\x0a\x20\x20\x20\x20<div\x20class=\"js-expandAll\x20expandAll\x20collapsed\">(Expand\x20All)</div>\x0a\x20\x20\x20\x20<dl>\x0a\x20\x20\x20\x20\x20\x20{{range\x20.Examples}}\x0a\x20\x20\x20\x20\x20\x20<dd><a\x20class=\"exampleLink\"\x20href=\"#example_{{-\x20.Name\x20-}}\">{{-\x20example_name\x20.Name\x20-}}</a></dd>\x0a\x20\x20\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20</dl>\x0a\x20\x20</div>\x0a\x20\x20{{-\x20end\x20}}\x0a\x0a\x0a\x20\x20<!--\x20Global\x20constants\x20-->\x0a\x20\x20{{-\x20if\x20indent_filter\x20.Consts\x20}}\x0a\x20\x20<h2\x20id=\"pkg-constants\">Constants</h2>\x0a\x20\x20{{-\x20range\x20indent_filter\x20.Consts\x20}}\x0a\x20\x20{{-\x20comment_html\x20.Doc\x20|\x20unescaped\x20}}\x0a\x20\x20<pre>{{-\x20node_html\x20$package\x20.Decl\x20true\x20|\x20unescaped\x20-}}</pre>\x0a\x20\x20{{-\x20end\x20}}\x0a\x20\x20{{-\x20end\x20}}\x0a\x0a\x0a\x20\x20<!--\x20Global\x20variables\x20-->\x0a\x20\x20{{-\x20if\x20indent_filter\x20.Vars\x20}}\x0a\x20\x20<h2\x20id=\"pkg-variables\">Variables</h2>\x0a\x20\x20{{-\x20range\x20indent_filter\x20.Vars\x20}}\x0a\x20\x20{{-\x20comment_html\x20.Doc\x20|\x20unescaped\x20}}\x0a\x20\x20<pre>{{-\x20node_html\x20$package\x20.Decl\x20true\x20|\x20unescaped\x20-}}</pre>\x0a\x20\x20{{-\x20end\x20}}\x0a\x20\x20{{-\x20end\x20}}\x0a\x0a\x0a\x20\x20<!--\x20Global\x20funcs\x20-->\x0a\x20\x20{{\x20range\x20indent_filter\x20.Funcs\x20}}\x0a\x20\x20{{-\x20/*\x20Name\x20is\x20a\x20string\x20-\x20no\x20need\x20for\x20FSet\x20*/\x20-}}\x0a\x20\x20{{-\x20$name_html\x20:=\x20html\x20.Name\x20-}}\x0a\x20\x20<div\x20class=\"funcs\x20my-5\">\x0a\x20\x20\x20\x20<h2\x20id=\"{{-\x20$name_html\x20-}}\">func\x20<a\x20href=\"{{-\x20posLink_url\x20$package\x20.Decl\x20-}}\">{{-\x20$name_html\x20-}}</a>\x0a\x20\x20\x20\x20\x20\x20<a\x20class=\"permalink\"\x20href=\"#{{-\x20$name_html\x20-}}\">&#xb6;</a>\x0a\x20\x20\x20\x20</h2>\x0a\x20\x20\x20\x20<pre>{{node_html\x20$package\x20.Decl\x20true\x20|\x20unescaped}}</pre>\x0a\x20\x20\x20\x20<div\x20class=\"doc\">{{comment_html\x20.Doc\x20|\x20unescaped}}</div>\x0a\x20\x20\x20\x20<div\x20class=\"example\">{{example_html\x20$package\x20.Name}}</div>\x0a\x20\x20</div>\x0a\x20\x20{{-\x20end\x20}}\x0a\x0a\x0a\x20\x20<!--\x20all\x20types\x20-->\x0a\x20\x20{{\x20$types\x20:=\x20indent_filter\x20.Types\x20}}\x0a\x20\x20{{\x20if\x20gt\x20(len\x20$types)\x200\x20}}\x0a\x20\x20\x20\x20<table>\x0a\x20\x20\x20\x20\x20\x20<thead>\x0a\x20\x20\x20\x20\x20\x20\x20\x20<tr>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<th>Type</th>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<th>Description</th>\x0a\x20\x20\x20\x20\x20\x20\x20\x20</tr>\x0a\x20\x20\x20\x20\x20\x20</thead>\x0a\x20\x20\x20\x20\x20\x20<tbody>\x0a\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20range\x20$types}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20<tr>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<td>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20$type_name_html\x20:=\x20.Name\x20-}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<a\x20href=\"/{{-\x20$package.ImportPath\x20-}}/{{-\x20$type_name_html\x20-}}.html\"\x20title=\"{{-\x20$type_name_html\x20-}}\">{{-\x20.Name\x20-}}</a>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20</td>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<td>{{-\x20.Documentation.Summary.Text\x20-}}</td>\x0a\x20\x20\x20\x20\x20\x20\x20\x20</tr>\x0a\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20\x20\x20</tbody>\x0a\x20\x20\x20\x20</table>\x0a\x20\x20{{\x20end\x20}}\x0a\x20\x20<!--\x20end\x20all\x20types\x20-->\x0a\x0a\x0a\x20\x20{{\x20with\x20$package.Notes\x20}}\x0a\x20\x20{{\x20range\x20$marker,\x20$content\x20:=\x20.\x20}}\x0a\x20\x20<h2\x20id=\"pkg-note-{{-\x20$marker\x20-}}\">{{-\x20noteTitle\x20$marker\x20|\x20html\x20-}}s</h2>\x0a\x20\x20<ul\x20style=\"list-style:\x20none;\x20padding:\x200;\">\x0a\x20\x20\x20\x20{{\x20range\x20.\x20}}\x0a\x20\x20\x20\x20<li>\x0a\x20\x20\x20\x20\x20\x20<a\x20href=\"{{-\x20posLink_url\x20$package\x20.\x20-}}\"\x20style=\"float:\x20left;\">&#x261e;</a>\x0a\x20\x20\x20\x20\x20\x20{{-\x20comment_html\x20.Body\x20|\x20unescaped\x20-}}\x0a\x20\x20\x20\x20</li>\x0a\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20</ul>\x0a\x20\x20{{-\x20end\x20}}\x0a\x20\x20{{-\x20end\x20}}\x0a\x0a{{-\x20end\x20}}\x0a<!--\x20end\x20package.html\x20-->",
+	"package.html": "<!--\x20package.html\x20-->\x0a{{-\x20with\x20.Package\x20-}}\x0a\x0a\x20\x20{{-\x20$package\x20:=\x20.\x20-}}\x0a\x0a\x20\x20<h1\x20id=\"pkg-title-{{\x20.Name\x20}}\">Package\x20{{\x20.Name\x20}}</h1>\x0a\x0a\x20\x20<pre>import\x20\"{{-\x20.ImportPath\x20-}}\"</pre>\x0a\x0a\x20\x20{{-\x20if\x20.Err\x20}}\x0a\x20\x20<div\x20class=\"alert\x20alert-danger\x20package-error\"\x20role=\"alert\">\x0a\x20\x20\x20\x20<h4\x20class=\"alert-heading\">The\x20package\x20could\x20not\x20be\x20documented</h4>\x0a\x20\x20\x20\x20<pre>{{\x20.Err\x20}}</pre>\x0a\x20\x20</div>\x0a\x20\x20{{-\x20end\x20}}\x0a\x0a\x20\x20{{\x20if\x20or\x20.Doc\x20.ImportComment\x20}}\x0a\x20\x20<h2>Overview</h2>\x0a\x20\x20<div\x20class=\"doc\">\x0a\x20\x20\x20\x20{{\x20comment_html\x20.Doc\x20|\x20unescaped\x20}}\x0a\x20\x20\x20\x20{{\x20comment_html\x20.ImportComment\x20|\x20unescaped\x20}}\x0a\x20\x20</div>\x0a\x20\x20{{-\x20end\x20}}\x0a\x0a\x0a\x20\x20{{-\x20if\x20.Examples\x20}}\x0a\x20\x20<div\x20id=\"pkg-examples\">\x0a\x20\x20\x20\x20<h2>Examples</h2>\x0a\x20\x20\x20\x20<div\x20class=\"js-expandAll\x20expandAll\x20collapsed\">(Expand\x20All)</div>\x0a\x20\x20\x20\x20<dl>\x0a\x20\x20\x20\x20\x20\x20{{range\x20.Examples}}\x0a\x20\x20\x20\x20\x20\x20<dd><a\x20class=\"exampleLink\"\x20href=\"#example_{{-\x20.Name\x20-}}\">{{-\x20example_name\x20.Name\x20-}}</a></dd>\x0a\x20\x20\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20</dl>\x0a\x20\x20</div>\x0a\x20\x20{{-\x20end\x20}}\x0a\x0a\x0a\x20\x20<!--\x20Global\x20constants\x20-->\x0a\x20\x20{{-\x20if\x20indent_filter\x20.Consts\x20}}\x0a\x20\x20<h2\x20id=\"pkg-constants\">Constants</h2>\x0a\x20\x20{{-\x20range\x20indent_filter\x20.Consts\x20}}\x0a\x20\x20{{-\x20comment_html\x20.Doc\x20|\x20unescaped\x20}}\x0a\x20\x20<pre>{{-\x20node_html\x20$package\x20.Decl\x20true\x20|\x20unescaped\x20-}}</pre>\x0a\x20\x20{{-\x20end\x20}}\x0a\x20\x20{{-\x20end\x20}}\x0a\x0a\x0a\x20\x20<!--\x20Global\x20variables\x20-->\x0a\x20\x20{{-\x20if\x20indent_filter\x20.Vars\x20}}\x0a\x20\x20<h2\x20id=\"pkg-variables\">Variables</h2>\x0a\x20\x20{{-\x20range\x20indent_filter\x20.Vars\x20}}\x0a\x20\x20{{-\x20comment_html\x20.Doc\x20|\x20unescaped\x20}}\x0a\x20\x20<pre>{{-\x20node_html\x20$package\x20.Decl\x20true\x20|\x20unescaped\x20-}}</pre>\x0a\x20\x20{{-\x20end\x20}}\x0a\x20\x20{{-\x20end\x20}}\x0a\x0a\x0a\x20\x20<!--\x20Global\x20funcs\x20-->\x0a\x20\x20{{\x20range\x20indent_filter\x20.Funcs\x20}}\x0a\x20\x20{{-\x20/*\x20Name\x20is\x20a\x20string\x20-\x20no\x20need\x20for\x20FSet\x20*/\x20-}}\x0a\x20\x20{{-\x20$name_html\x20:=\x20html\x20.Name\x20-}}\x0a\x20\x20<div\x20class=\"funcs\x20my-5\">\x0a\x20\x20\x20\x20{{-\x20if\x20$.Bundle\x20}}\x0a\x20\x20\x20\x20<a\x20class=\"bundle-anchor\"\x20id=\"{{-\x20func_url\x20$package.ImportPath\x20\"\"\x20.Name\x20|\x20anchor\x20-}}\"></a>\x0a\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20<h2\x20id=\"{{-\x20$name_html\x20-}}\">func\x20{{\x20with\x20posLink_url\x20$package\x20.Decl\x20}}<a\x20href=\"{{-\x20.\x20-}}\">{{-\x20$name_html\x20-}}</a>{{\x20else\x20}}{{-\x20$name_html\x20-}}{{\x20end\x20}}\x0a\x20\x20\x20\x20\x20\x20{{-\x20with\x20since\x20\"func\"\x20\"\"\x20.Name\x20$package.ImportPath\x20}}\x20<span\x20class=\"badge\x20badge-since\"\x20title=\"Added\x20in\x20{{\x20.\x20}}\">since\x20{{\x20.\x20}}</span>{{\x20end\x20}}\x0a\x20\x20\x20\x20\x20\x20<a\x20class=\"permalink\"\x20href=\"#{{-\x20$name_html\x20-}}\">&#xb6;</a>\x0a\x20\x20\x20\x20</h2>\x0a\x20\x20\x20\x20<pre>{{node_html\x20$package\x20.Decl\x20true\x20|\x20unescaped}}</pre>\x0a\x20\x20\x20\x20<div\x20class=\"doc\">{{comment_html\x20.Doc\x20|\x20unescaped}}</div>\x0a\x20\x20\x20\x20<div\x20class=\"example\">{{example_html\x20$package\x20.Name}}</div>\x0a\x20\x20\x20\x20{{-\x20with\x20calls\x20$package.ImportPath\x20\"\"\x20.Name\x20}}{{\x20template\x20\"calls\"\x20.\x20}}{{\x20end\x20}}\x0a\x20\x20</div>\x0a\x20\x20{{-\x20end\x20}}\x0a\x0a\x0a\x20\x20<!--\x20all\x20types\x20-->\x0a\x20\x20{{\x20$types\x20:=\x20indent_filter\x20.Types\x20}}\x0a\x20\x20{{\x20if\x20gt\x20(len\x20$types)\x200\x20}}\x0a\x20\x20\x20\x20<table>\x0a\x20\x20\x20\x20\x20\x20<thead>\x0a\x20\x20\x20\x20\x20\x20\x20\x20<tr>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<th>Type</th>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<th>Description</th>\x0a\x20\x20\x20\x20\x20\x20\x20\x20</tr>\x0a\x20\x20\x20\x20\x20\x20</thead>\x0a\x20\x20\x20\x20\x20\x20<tbody>\x0a\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20range\x20$types}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20<tr>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<td>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20$type_name_html\x20:=\x20.Name\x20-}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<a\x20href=\"{{-\x20type_url\x20$package.ImportPath\x20.Name\x20-}}\"\x20title=\"{{-\x20$type_name_html\x20-}}\">{{-\x20.Name\x20-}}</a>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20with\x20since\x20\"type\"\x20\"\"\x20.Name\x20$package.ImportPath\x20}}\x20<span\x20class=\"badge\x20badge-since\"\x20title=\"Added\x20in\x20{{\x20.\x20}}\">since\x20{{\x20.\x20}}</span>{{\x20end\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20</td>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<td>{{-\x20.Documentation.Summary.Text\x20-}}</td>\x0a\x20\x20\x20\x20\x20\x20\x20\x20</tr>\x0a\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20\x20\x20</tbody>\x0a\x20\x20\x20\x20</table>\x0a\x20\x20{{\x20end\x20}}\x0a\x20\x20<!--\x20end\x20all\x20types\x20-->\x0a\x0a\x0a\x20\x20{{\x20with\x20$package.Notes\x20}}\x0a\x20\x20{{\x20range\x20$marker,\x20$content\x20:=\x20.\x20}}\x0a\x20\x20<h2\x20id=\"pkg-note-{{-\x20$marker\x20-}}\">{{-\x20noteTitle\x20$marker\x20|\x20html\x20-}}s</h2>\x0a\x20\x20<ul\x20style=\"list-style:\x20none;\x20padding:\x200;\">\x0a\x20\x20\x20\x20{{\x20range\x20.\x20}}\x0a\x20\x20\x20\x20<li>\x0a\x20\x20\x20\x20\x20\x20{{-\x20with\x20posLink_url\x20$package\x20.\x20}}\x0a\x20\x20\x20\x20\x20\x20<a\x20href=\"{{-\x20.\x20-}}\"\x20style=\"float:\x20left;\">&#x261e;</a>\x0a\x20\x20\x20\x20\x20\x20{{-\x20else\x20}}\x0a\x20\x20\x20\x20\x20\x20<span\x20style=\"float:\x20left;\">&#x261e;</span>\x0a\x20\x20\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20\x20\x20{{-\x20comment_html\x20.Body\x20|\x20unescaped\x20-}}\x0a\x20\x20\x20\x20</li>\x0a\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20</ul>\x0a\x20\x20{{-\x20end\x20}}\x0a\x20\x20{{-\x20end\x20}}\x0a\x0a\x0a\x20\x20<!--\x20test\x20API\x20-->\x0a\x20\x20{{-\x20with\x20.TestAPI\x20}}\x0a\x20\x20<h2\x20id=\"pkg-test-api\">Test\x20API</h2>\x0a\x20\x20{{-\x20range\x20.\x20}}\x0a\x20\x20{{-\x20$test\x20:=\x20.\x20}}\x0a\x20\x20<div\x20class=\"test-api\">\x0a\x20\x20\x20\x20<h3\x20id=\"pkg-test-api-{{\x20.Name\x20}}\">package\x20{{\x20.Name\x20}}</h3>\x0a\x20\x20\x20\x20{{-\x20range\x20indent_filter\x20.Consts\x20}}\x0a\x20\x20\x20\x20{{-\x20comment_html\x20.Doc\x20|\x20unescaped\x20}}\x0a\x20\x20\x20\x20<pre>{{-\x20node_html\x20$test\x20.Decl\x20false\x20|\x20unescaped\x20-}}</pre>\x0a\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20{{-\x20range\x20indent_filter\x20.Vars\x20}}\x0a\x20\x20\x20\x20{{-\x20comment_html\x20.Doc\x20|\x20unescaped\x20}}\x0a\x20\x20\x20\x20<pre>{{-\x20node_html\x20$test\x20.Decl\x20false\x20|\x20unescaped\x20-}}</pre>\x0a\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20{{-\x20range\x20indent_filter\x20.Funcs\x20}}\x0a\x20\x20\x20\x20<h4>func\x20{{\x20with\x20.Recv\x20}}({{\x20.\x20}})\x20{{\x20end\x20}}{{\x20.Name\x20}}</h4>\x0a\x20\x20\x20\x20<pre>{{-\x20node_html\x20$test\x20.Decl\x20false\x20|\x20unescaped\x20-}}</pre>\x0a\x20\x20\x20\x20{{-\x20comment_html\x20.Doc\x20|\x20unescaped\x20}}\x0a\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20{{-\x20range\x20indent_filter\x20.Types\x20}}\x0a\x20\x20\x20\x20<h4>type\x20{{\x20.Name\x20}}</h4>\x0a\x20\x20\x20\x20<pre>{{-\x20node_html\x20$test\x20.Decl\x20false\x20|\x20unescaped\x20-}}</pre>\x0a\x20\x20\x20\x20{{-\x20comment_html\x20.Doc\x20|\x20unescaped\x20}}\x0a\x20\x20\x20\x20{{-\x20range\x20indent_filter\x20.Consts\x20}}\x0a\x20\x20\x20\x20<pre>{{-\x20node_html\x20$test\x20.Decl\x20false\x20|\x20unescaped\x20-}}</pre>\x0a\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20{{-\x20range\x20indent_filter\x20.Vars\x20}}\x0a\x20\x20\x20\x20<pre>{{-\x20node_html\x20$test\x20.Decl\x20false\x20|\x20unescaped\x20-}}</pre>\x0a\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20{{-\x20range\x20indent_filter\x20.Funcs\x20}}\x0a\x20\x20\x20\x20{{-\x20if\x20.Decl\x20}}\x0a\x20\x20\x20\x20<pre>{{-\x20node_html\x20$test\x20.Decl\x20false\x20|\x20unescaped\x20-}}</pre>\x0a\x20\x20\x20\x20{{-\x20comment_html\x20.Doc\x20|\x20unescaped\x20}}\x0a\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20{{-\x20range\x20indent_filter\x20.Methods\x20}}\x0a\x20\x20\x20\x20<pre>{{-\x20node_html\x20$test\x20.Decl\x20false\x20|\x20unescaped\x20-}}</pre>\x0a\x20\x20\x20\x20{{-\x20comment_html\x20.Doc\x20|\x20unescaped\x20}}\x0a\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20</div>\x0a\x20\x20{{-\x20end\x20}}\x0a\x20\x20{{-\x20end\x20}}\x0a\x0a\x0a\x20\x20<!--\x20imports\x20-->\x0a\x20\x20{{-\x20with\x20import_graph\x20}}\x0a\x20\x20{{-\x20with\x20.Imports\x20$package.ImportPath\x20}}\x0a\x20\x20<h2\x20id=\"pkg-imports\">Imports</h2>\x0a\x20\x20<ul\x20class=\"list-imports\">\x0a\x20\x20\x20\x20{{-\x20range\x20.\x20}}\x0a\x20\x20\x20\x20<li><a\x20href=\"{{\x20docLink\x20.To\x20\"\"\x20}}\">{{\x20.To\x20}}</a>\x0a\x20\x20\x20\x20\x20\x20{{-\x20if\x20.Cycle\x20}}\x20<span\x20class=\"badge\x20badge-danger\"\x20title=\"Import\x20cycle\">cycle</span>{{\x20end\x20}}\x0a\x20\x20\x20\x20\x20\x20{{-\x20with\x20.Violation\x20}}\x20<span\x20class=\"badge\x20badge-warning\"\x20title=\"Layering\x20violation\">{{\x20.\x20}}</span>{{\x20end\x20}}</li>\x0a\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20</ul>\x0a\x20\x20{{-\x20end\x20}}\x0a\x0a\x20\x20{{-\x20with\x20.ImportedBy\x20$package.ImportPath\x20}}\x0a\x20\x20<h2\x20id=\"pkg-imported-by\">Imported\x20by</h2>\x0a\x20\x20<ul\x20class=\"list-imports\">\x0a\x20\x20\x20\x20{{-\x20range\x20.\x20}}\x0a\x20\x20\x20\x20<li><a\x20href=\"{{\x20package_url\x20.From\x20}}\">{{\x20.From\x20}}</a>\x0a\x20\x20\x20\x20\x20\x20{{-\x20if\x20.Cycle\x20}}\x20<span\x20class=\"badge\x20badge-danger\"\x20title=\"Import\x20cycle\">cycle</span>{{\x20end\x20}}\x0a\x20\x20\x20\x20\x20\x20{{-\x20with\x20.Violation\x20}}\x20<span\x20class=\"badge\x20badge-warning\"\x20title=\"Layering\x20violation\">{{\x20.\x20}}</span>{{\x20end\x20}}</li>\x0a\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20</ul>\x0a\x20\x20{{-\x20end\x20}}\x0a\x0a\x20\x20{{-\x20if\x20not\x20$.Bundle\x20}}\x0a\x20\x20<p><a\x20href=\"{{\x20abs_url\x20\"/_graph/\"\x20}}\">Import\x20graph</a></p>\x0a\x20\x20{{-\x20end\x20}}\x0a\x20\x20{{-\x20end\x20}}\x0a\x20\x20<!--\x20end\x20imports\x20-->\x0a\x0a{{-\x20end\x20}}\x0a<!--\x20end\x20package.html\x20-->",
 
 	"popper.min.js": "/*\x0a\x20Copyright\x20(C)\x20Federico\x20Zivolo\x202020\x0a\x20Distributed\x20under\x20the\x20MIT\x20License\x20(license\x20terms\x20are\x20at\x20http://opensource.org/licenses/MIT).\x0a\x20*/\x0a(function(e,t){'object'==typeof\x20exports&&'undefined'!=typeof\x20module?module.exports=t():'function'==typeof\x20define&&define.amd?define(t):e.Popper=t()})(this,function(){'use\x20strict';function\x20e(e){return\x20e&&'[object\x20Function]'==={}.toString.call(e)}function\x20t(e,t){if(1!==e.nodeType)return[];var\x20o=e.ownerDocument.defaultView,n=o.getComputedStyle(e,null);return\x20t?n[t]:n}function\x20o(e){return'HTML'===e.nodeName?e:e.parentNode||e.host}function\x20n(e){if(!e)return\x20document.body;switch(e.nodeName){case'HTML':case'BODY':return\x20e.ownerDocument.body;case'#document':return\x20e.body;}var\x20i=t(e),r=i.overflow,p=i.overflowX,s=i.overflowY;return\x20/(auto|scroll|overlay)/.test(r+s+p)?e:n(o(e))}function\x20i(e){return\x20e&&e.referenceNode?e.referenceNode:e}function\x20r(e){return\x2011===e?re:10===e?pe:re||pe}function\x20p(e){if(!e)return\x20document.documentElement;for(var\x20o=r(10)?document.body:null,n=e.offsetParent||null;n===o&&e.nextElementSibling;)n=(e=e.nextElementSibling).offsetParent;var\x20i=n&&n.nodeName;return\x20i&&'BODY'!==i&&'HTML'!==i?-1!==['TH','TD','TABLE'].indexOf(n.nodeName)&&'static'===t(n,'position')?p(n):n:e?e.ownerDocument.documentElement:document.documentElement}function\x20s(e){var\x20t=e.nodeName;return'BODY'!==t&&('HTML'===t||p(e.firstElementChild)===e)}function\x20d(e){return\x20null===e.parentNode?e:d(e.parentNode)}function\x20a(e,t){if(!e||!e.nodeType||!t||!t.nodeType)return\x20document.documentElement;var\x20o=e.compareDocumentPosition(t)&Node.DOCUMENT_POSITION_FOLLOWING,n=o?e:t,i=o?t:e,r=document.createRange();r.setStart(n,0),r.setEnd(i,0);var\x20l=r.commonAncestorContainer;if(e!==l&&t!==l||n.contains(i))return\x20s(l)?l:p(l);var\x20f=d(e);return\x20f.host?a(f.host,t):a(e,d(t).host)}function\x20l(e){var\x20t=1<arguments.length&&void\x200!==arguments[1]?arguments[1]:'top',o='top'===t?'scrollTop':'scrollLeft',n=e.nodeName;if('BODY'===n||'HTML'===n){var\x20i=e.ownerDocument.documentElement,r=e.ownerDocument.scrollingElement||i;return\x20r[o]}return\x20e[o]}function\x20f(e,t){var\x20o=2<arguments.length&&void\x200!==arguments[2]&&arguments[2],n=l(t,'top'),i=l(t,'left'),r=o?-1:1;return\x20e.top+=n*r,e.bottom+=n*r,e.left+=i*r,e.right+=i*r,e}function\x20m(e,t){var\x20o='x'===t?'Left':'Top',n='Left'==o?'Right':'Bottom';return\x20parseFloat(e['border'+o+'Width'])+parseFloat(e['border'+n+'Width'])}function\x20h(e,t,o,n){return\x20ee(t['offset'+e],t['scroll'+e],o['client'+e],o['offset'+e],o['scroll'+e],r(10)?parseInt(o['offset'+e])+parseInt(n['margin'+('Height'===e?'Top':'Left')])+parseInt(n['margin'+('Height'===e?'Bottom':'Right')]):0)}function\x20c(e){var\x20t=e.body,o=e.documentElement,n=r(10)&&getComputedStyle(o);return{height:h('Height',t,o,n),width:h('Width',t,o,n)}}function\x20g(e){return\x20le({},e,{right:e.left+e.width,bottom:e.top+e.height})}function\x20u(e){var\x20o={};try{if(r(10)){o=e.getBoundingClientRect();var\x20n=l(e,'top'),i=l(e,'left');o.top+=n,o.left+=i,o.bottom+=n,o.right+=i}else\x20o=e.getBoundingClientRect()}catch(t){}var\x20p={left:o.left,top:o.top,width:o.right-o.left,height:o.bottom-o.top},s='HTML'===e.nodeName?c(e.ownerDocument):{},d=s.width||e.clientWidth||p.width,a=s.height||e.clientHeight||p.height,f=e.offsetWidth-d,h=e.offsetHeight-a;if(f||h){var\x20u=t(e);f-=m(u,'x'),h-=m(u,'y'),p.width-=f,p.height-=h}return\x20g(p)}function\x20b(e,o){var\x20i=2<arguments.length&&void\x200!==arguments[2]&&arguments[2],p=r(10),s='HTML'===o.nodeName,d=u(e),a=u(o),l=n(e),m=t(o),h=parseFloat(m.borderTopWidth),c=parseFloat(m.borderLeftWidth);i&&s&&(a.top=ee(a.top,0),a.left=ee(a.left,0));var\x20b=g({top:d.top-a.top-h,left:d.left-a.left-c,width:d.width,height:d.height});if(b.marginTop=0,b.marginLeft=0,!p&&s){var\x20w=parseFloat(m.marginTop),y=parseFloat(m.marginLeft);b.top-=h-w,b.bottom-=h-w,b.left-=c-y,b.right-=c-y,b.marginTop=w,b.marginLeft=y}return(p&&!i?o.contains(l):o===l&&'BODY'!==l.nodeName)&&(b=f(b,o)),b}function\x20w(e){var\x20t=1<arguments.length&&void\x200!==arguments[1]&&arguments[1],o=e.ownerDocument.documentElement,n=b(e,o),i=ee(o.clientWidth,window.innerWidth||0),r=ee(o.clientHeight,window.innerHeight||0),p=t?0:l(o),s=t?0:l(o,'left'),d={top:p-n.top+n.marginTop,left:s-n.left+n.marginLeft,width:i,height:r};return\x20g(d)}function\x20y(e){var\x20n=e.nodeName;if('BODY'===n||'HTML'===n)return!1;if('fixed'===t(e,'position'))return!0;var\x20i=o(e);return!!i&&y(i)}function\x20E(e){if(!e||!e.parentElement||r())return\x20document.documentElement;for(var\x20o=e.parentElement;o&&'none'===t(o,'transform');)o=o.parentElement;return\x20o||document.documentElement}function\x20v(e,t,r,p){var\x20s=4<arguments.length&&void\x200!==arguments[4]&&arguments[4],d={top:0,left:0},l=s?E(e):a(e,i(t));if('viewport'===p)d=w(l,s);else{var\x20f;'scrollParent'===p?(f=n(o(t)),'BODY'===f.nodeName&&(f=e.ownerDocument.documentElement)):'window'===p?f=e.ownerDocument.documentElement:f=p;var\x20m=b(f,l,s);if('HTML'===f.nodeName&&!y(l)){var\x20h=c(e.ownerDocument),g=h.height,u=h.width;d.top+=m.top-m.marginTop,d.bottom=g+m.top,d.left+=m.left-m.marginLeft,d.right=u+m.left}else\x20d=m}r=r||0;var\x20v='number'==typeof\x20r;return\x20d.left+=v?r:r.left||0,d.top+=v?r:r.top||0,d.right-=v?r:r.right||0,d.bottom-=v?r:r.bottom||0,d}function\x20x(e){var\x20t=e.width,o=e.height;return\x20t*o}function\x20O(e,t,o,n,i){var\x20r=5<arguments.length&&void\x200!==arguments[5]?arguments[5]:0;if(-1===e.indexOf('auto'))return\x20e;var\x20p=v(o,n,r,i),s={top:{width:p.width,height:t.top-p.top},right:{width:p.right-t.right,height:p.height},bottom:{width:p.width,height:p.bottom-t.bottom},left:{width:t.left-p.left,height:p.height}},d=Object.keys(s).map(function(e){return\x20le({key:e},s[e],{area:x(s[e])})}).sort(function(e,t){return\x20t.area-e.area}),a=d.filter(function(e){var\x20t=e.width,n=e.height;return\x20t>=o.clientWidth&&n>=o.clientHeight}),l=0<a.length?a[0].key:d[0].key,f=e.split('-')[1];return\x20l+(f?'-'+f:'')}function\x20L(e,t,o){var\x20n=3<arguments.length&&void\x200!==arguments[3]?arguments[3]:null,r=n?E(t):a(t,i(o));return\x20b(o,r,n)}function\x20S(e){var\x20t=e.ownerDocument.defaultView,o=t.getComputedStyle(e),n=parseFloat(o.marginTop||0)+parseFloat(o.marginBottom||0),i=parseFloat(o.marginLeft||0)+parseFloat(o.marginRight||0),r={width:e.offsetWidth+i,height:e.offsetHeight+n};return\x20r}function\x20T(e){var\x20t={left:'right',right:'left',bottom:'top',top:'bottom'};return\x20e.replace(/left|right|bottom|top/g,function(e){return\x20t[e]})}function\x20C(e,t,o){o=o.split('-')[0];var\x20n=S(e),i={width:n.width,height:n.height},r=-1!==['right','left'].indexOf(o),p=r?'top':'left',s=r?'left':'top',d=r?'height':'width',a=r?'width':'height';return\x20i[p]=t[p]+t[d]/2-n[d]/2,i[s]=o===s?t[s]-n[a]:t[T(s)],i}function\x20D(e,t){return\x20Array.prototype.find?e.find(t):e.filter(t)[0]}function\x20N(e,t,o){if(Array.prototype.findIndex)return\x20e.findIndex(function(e){return\x20e[t]===o});var\x20n=D(e,function(e){return\x20e[t]===o});return\x20e.indexOf(n)}function\x20P(t,o,n){var\x20i=void\x200===n?t:t.slice(0,N(t,'name',n));return\x20i.forEach(function(t){t['function']&&console.warn('`modifier.function`\x20is\x20deprecated,\x20use\x20`modifier.fn`!');var\x20n=t['function']||t.fn;t.enabled&&e(n)&&(o.offsets.popper=g(o.offsets.popper),o.offsets.reference=g(o.offsets.reference),o=n(o,t))}),o}function\x20k(){if(!this.state.isDestroyed){var\x20e={instance:this,styles:{},arrowStyles:{},attributes:{},flipped:!1,offsets:{}};e.offsets.reference=L(this.state,this.popper,this.reference,this.options.positionFixed),e.placement=O(this.options.placement,e.offsets.reference,this.popper,this.reference,this.options.modifiers.flip.boundariesElement,this.options.modifiers.flip.padding),e.originalPlacement=e.placement,e.positionFixed=this.options.positionFixed,e.offsets.popper=C(this.popper,e.offsets.reference,e.placement),e.offsets.popper.position=this.options.positionFixed?'fixed':'absolute',e=P(this.modifiers,e),this.state.isCreated?this.options.onUpdate(e):(this.state.isCreated=!0,this.options.onCreate(e))}}function\x20W(e,t){return\x20e.some(function(e){var\x20o=e.name,n=e.enabled;return\x20n&&o===t})}function\x20B(e){for(var\x20t=[!1,'ms','Webkit','Moz','O'],o=e.charAt(0).toUpperCase()+e.slice(1),n=0;n<t.length;n++){var\x20i=t[n],r=i?''+i+o:e;if('undefined'!=typeof\x20document.body.style[r])return\x20r}return\x20null}function\x20H(){return\x20this.state.isDestroyed=!0,W(this.modifiers,'applyStyle')&&(this.popper.removeAttribute('x-placement'),this.popper.style.position='',this.popper.style.top='',this.popper.style.left='',this.popper.style.right='',this.popper.style.bottom='',this.popper.style.willChange='',this.popper.style[B('transform')]=''),this.disableEventListeners(),this.options.removeOnDestroy&&this.popper.parentNode.removeChild(this.popper),this}function\x20A(e){var\x20t=e.ownerDocument;return\x20t?t.defaultView:window}function\x20M(e,t,o,i){var\x20r='BODY'===e.nodeName,p=r?e.ownerDocument.defaultView:e;p.addEventListener(t,o,{passive:!0}),r||M(n(p.parentNode),t,o,i),i.push(p)}function\x20F(e,t,o,i){o.updateBound=i,A(e).addEventListener('resize',o.updateBound,{passive:!0});var\x20r=n(e);return\x20M(r,'scroll',o.updateBound,o.scrollParents),o.scrollElement=r,o.eventsEnabled=!0,o}function\x20I(){this.state.eventsEnabled||(this.state=F(this.reference,this.options,this.state,this.scheduleUpdate))}function\x20R(e,t){return\x20A(e).removeEventListener('resize',t.updateBound),t.scrollParents.forEach(function(e){e.removeEventListener('scroll',t.updateBound)}),t.updateBound=null,t.scrollParents=[],t.scrollElement=null,t.eventsEnabled=!1,t}function\x20U(){this.state.eventsEnabled&&(cancelAnimationFrame(this.scheduleUpdate),this.state=R(this.reference,this.state))}function\x20Y(e){return''!==e&&!isNaN(parseFloat(e))&&isFinite(e)}function\x20V(e,t){Object.keys(t).forEach(function(o){var\x20n='';-1!==['width','height','top','right','bottom','left'].indexOf(o)&&Y(t[o])&&(n='px'),e.style[o]=t[o]+n})}function\x20j(e,t){Object.keys(t).forEach(function(o){var\x20n=t[o];!1===n?e.removeAttribute(o):e.setAttribute(o,t[o])})}function\x20q(e,t){var\x20o=e.offsets,n=o.popper,i=o.reference,r=$,p=function(e){return\x20e},s=r(i.width),d=r(n.width),a=-1!==['left','right'].indexOf(e.placement),l=-1!==e.placement.indexOf('-'),f=t?a||l||s%2==d%2?r:Z:p,m=t?r:p;return{left:f(1==s%2&&1==d%2&&!l&&t?n.left-1:n.left),top:m(n.top),bottom:m(n.bottom),right:f(n.right)}}function\x20K(e,t,o){var\x20n=D(e,function(e){var\x20o=e.name;return\x20o===t}),i=!!n&&e.some(function(e){return\x20e.name===o&&e.enabled&&e.order<n.order});if(!i){var\x20r='`'+t+'`';console.warn('`'+o+'`'+'\x20modifier\x20is\x20required\x20by\x20'+r+'\x20modifier\x20in\x20order\x20to\x20work,\x20be\x20sure\x20to\x20include\x20it\x20before\x20'+r+'!')}return\x20i}function\x20z(e){return'end'===e?'start':'start'===e?'end':e}function\x20G(e){var\x20t=1<arguments.length&&void\x200!==arguments[1]&&arguments[1],o=he.indexOf(e),n=he.slice(o+1).concat(he.slice(0,o));return\x20t?n.reverse():n}function\x20_(e,t,o,n){var\x20i=e.match(/((?:\\-|\\+)?\\d*\\.?\\d*)(.*)/),r=+i[1],p=i[2];if(!r)return\x20e;if(0===p.indexOf('%')){var\x20s;switch(p){case'%p':s=o;break;case'%':case'%r':default:s=n;}var\x20d=g(s);return\x20d[t]/100*r}if('vh'===p||'vw'===p){var\x20a;return\x20a='vh'===p?ee(document.documentElement.clientHeight,window.innerHeight||0):ee(document.documentElement.clientWidth,window.innerWidth||0),a/100*r}return\x20r}function\x20X(e,t,o,n){var\x20i=[0,0],r=-1!==['right','left'].indexOf(n),p=e.split(/(\\+|\\-)/).map(function(e){return\x20e.trim()}),s=p.indexOf(D(p,function(e){return-1!==e.search(/,|\\s/)}));p[s]&&-1===p[s].indexOf(',')&&console.warn('Offsets\x20separated\x20by\x20white\x20space(s)\x20are\x20deprecated,\x20use\x20a\x20comma\x20(,)\x20instead.');var\x20d=/\\s*,\\s*|\\s+/,a=-1===s?[p]:[p.slice(0,s).concat([p[s].split(d)[0]]),[p[s].split(d)[1]].concat(p.slice(s+1))];return\x20a=a.map(function(e,n){var\x20i=(1===n?!r:r)?'height':'width',p=!1;return\x20e.reduce(function(e,t){return''===e[e.length-1]&&-1!==['+','-'].indexOf(t)?(e[e.length-1]=t,p=!0,e):p?(e[e.length-1]+=t,p=!1,e):e.concat(t)},[]).map(function(e){return\x20_(e,i,t,o)})}),a.forEach(function(e,t){e.forEach(function(o,n){Y(o)&&(i[t]+=o*('-'===e[n-1]?-1:1))})}),i}function\x20J(e,t){var\x20o,n=t.offset,i=e.placement,r=e.offsets,p=r.popper,s=r.reference,d=i.split('-')[0];return\x20o=Y(+n)?[+n,0]:X(n,p,s,d),'left'===d?(p.top+=o[0],p.left-=o[1]):'right'===d?(p.top+=o[0],p.left+=o[1]):'top'===d?(p.left+=o[0],p.top-=o[1]):'bottom'===d&&(p.left+=o[0],p.top+=o[1]),e.popper=p,e}var\x20Q=Math.min,Z=Math.floor,$=Math.round,ee=Math.max,te='undefined'!=typeof\x20window&&'undefined'!=typeof\x20document&&'undefined'!=typeof\x20navigator,oe=function(){for(var\x20e=['Edge','Trident','Firefox'],t=0;t<e.length;t+=1)if(te&&0<=navigator.userAgent.indexOf(e[t]))return\x201;return\x200}(),ne=te&&window.Promise,ie=ne?function(e){var\x20t=!1;return\x20function(){t||(t=!0,window.Promise.resolve().then(function(){t=!1,e()}))}}:function(e){var\x20t=!1;return\x20function(){t||(t=!0,setTimeout(function(){t=!1,e()},oe))}},re=te&&!!(window.MSInputMethodContext&&document.documentMode),pe=te&&/MSIE\x2010/.test(navigator.userAgent),se=function(e,t){if(!(e\x20instanceof\x20t))throw\x20new\x20TypeError('Cannot\x20call\x20a\x20class\x20as\x20a\x20function')},de=function(){function\x20e(e,t){for(var\x20o,n=0;n<t.length;n++)o=t[n],o.enumerable=o.enumerable||!1,o.configurable=!0,'value'in\x20o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}return\x20function(t,o,n){return\x20o&&e(t.prototype,o),n&&e(t,n),t}}(),ae=function(e,t,o){return\x20t\x20in\x20e?Object.defineProperty(e,t,{value:o,enumerable:!0,configurable:!0,writable:!0}):e[t]=o,e},le=Object.assign||function(e){for(var\x20t,o=1;o<arguments.length;o++)for(var\x20n\x20in\x20t=arguments[o],t)Object.prototype.hasOwnProperty.call(t,n)&&(e[n]=t[n]);return\x20e},fe=te&&/Firefox/i.test(navigator.userAgent),me=['auto-start','auto','auto-end','top-start','top','top-end','right-start','right','right-end','bottom-end','bottom','bottom-start','left-end','left','left-start'],he=me.slice(3),ce={FLIP:'flip',CLOCKWISE:'clockwise',COUNTERCLOCKWISE:'counterclockwise'},ge=function(){function\x20t(o,n){var\x20i=this,r=2<arguments.length&&void\x200!==arguments[2]?arguments[2]:{};se(this,t),this.scheduleUpdate=function(){return\x20requestAnimationFrame(i.update)},this.update=ie(this.update.bind(this)),this.options=le({},t.Defaults,r),this.state={isDestroyed:!1,isCreated:!1,scrollParents:[]},this.reference=o&&o.jquery?o[0]:o,this.popper=n&&n.jquery?n[0]:n,this.options.modifiers={},Object.keys(le({},t.Defaults.modifiers,r.modifiers)).forEach(function(e){i.options.modifiers[e]=le({},t.Defaults.modifiers[e]||{},r.modifiers?r.modifiers[e]:{})}),this.modifiers=Object.keys(this.options.modifiers).map(function(e){return\x20le({name:e},i.options.modifiers[e])}).sort(function(e,t){return\x20e.order-t.order}),this.modifiers.forEach(function(t){t.enabled&&e(t.onLoad)&&t.onLoad(i.reference,i.popper,i.options,t,i.state)}),this.update();var\x20p=this.options.eventsEnabled;p&&this.enableEventListeners(),this.state.eventsEnabled=p}return\x20de(t,[{key:'update',value:function(){return\x20k.call(this)}},{key:'destroy',value:function(){return\x20H.call(this)}},{key:'enableEventListeners',value:function(){return\x20I.call(this)}},{key:'disableEventListeners',value:function(){return\x20U.call(this)}}]),t}();return\x20ge.Utils=('undefined'==typeof\x20window?global:window).PopperUtils,ge.placements=me,ge.Defaults={placement:'bottom',positionFixed:!1,eventsEnabled:!0,removeOnDestroy:!1,onCreate:function(){},onUpdate:function(){},modifiers:{shift:{order:100,enabled:!0,fn:function(e){var\x20t=e.placement,o=t.split('-')[0],n=t.split('-')[1];if(n){var\x20i=e.offsets,r=i.reference,p=i.popper,s=-1!==['bottom','top'].indexOf(o),d=s?'left':'top',a=s?'width':'height',l={start:ae({},d,r[d]),end:ae({},d,r[d]+r[a]-p[a])};e.offsets.popper=le({},p,l[n])}return\x20e}},offset:{order:200,enabled:!0,fn:J,offset:0},preventOverflow:{order:300,enabled:!0,fn:function(e,t){var\x20o=t.boundariesElement||p(e.instance.popper);e.instance.reference===o&&(o=p(o));var\x20n=B('transform'),i=e.instance.popper.style,r=i.top,s=i.left,d=i[n];i.top='',i.left='',i[n]='';var\x20a=v(e.instance.popper,e.instance.reference,t.padding,o,e.positionFixed);i.top=r,i.left=s,i[n]=d,t.boundaries=a;var\x20l=t.priority,f=e.offsets.popper,m={primary:function(e){var\x20o=f[e];return\x20f[e]<a[e]&&!t.escapeWithReference&&(o=ee(f[e],a[e])),ae({},e,o)},secondary:function(e){var\x20o='right'===e?'left':'top',n=f[o];return\x20f[e]>a[e]&&!t.escapeWithReference&&(n=Q(f[o],a[e]-('right'===e?f.width:f.height))),ae({},o,n)}};return\x20l.forEach(function(e){var\x20t=-1===['left','top'].indexOf(e)?'secondary':'primary';f=le({},f,m[t](e))}),e.offsets.popper=f,e},priority:['left','right','top','bottom'],padding:5,boundariesElement:'scrollParent'},keepTogether:{order:400,enabled:!0,fn:function(e){var\x20t=e.offsets,o=t.popper,n=t.reference,i=e.placement.split('-')[0],r=Z,p=-1!==['top','bottom'].indexOf(i),s=p?'right':'bottom',d=p?'left':'top',a=p?'width':'height';return\x20o[s]<r(n[d])&&(e.offsets.popper[d]=r(n[d])-o[a]),o[d]>r(n[s])&&(e.offsets.popper[d]=r(n[s])),e}},arrow:{order:500,enabled:!0,fn:function(e,o){var\x20n;if(!K(e.instance.modifiers,'arrow','keepTogether'))return\x20e;var\x20i=o.element;if('string'==typeof\x20i){if(i=e.instance.popper.querySelector(i),!i)return\x20e;}else\x20if(!e.instance.popper.contains(i))return\x20console.warn('WARNING:\x20`arrow.element`\x20must\x20be\x20child\x20of\x20its\x20popper\x20element!'),e;var\x20r=e.placement.split('-')[0],p=e.offsets,s=p.popper,d=p.reference,a=-1!==['left','right'].indexOf(r),l=a?'height':'width',f=a?'Top':'Left',m=f.toLowerCase(),h=a?'left':'top',c=a?'bottom':'right',u=S(i)[l];d[c]-u<s[m]&&(e.offsets.popper[m]-=s[m]-(d[c]-u)),d[m]+u>s[c]&&(e.offsets.popper[m]+=d[m]+u-s[c]),e.offsets.popper=g(e.offsets.popper);var\x20b=d[m]+d[l]/2-u/2,w=t(e.instance.popper),y=parseFloat(w['margin'+f]),E=parseFloat(w['border'+f+'Width']),v=b-e.offsets.popper[m]-y-E;return\x20v=ee(Q(s[l]-u,v),0),e.arrowElement=i,e.offsets.arrow=(n={},ae(n,m,$(v)),ae(n,h,''),n),e},element:'[x-arrow]'},flip:{order:600,enabled:!0,fn:function(e,t){if(W(e.instance.modifiers,'inner'))return\x20e;if(e.flipped&&e.placement===e.originalPlacement)return\x20e;var\x20o=v(e.instance.popper,e.instance.reference,t.padding,t.boundariesElement,e.positionFixed),n=e.placement.split('-')[0],i=T(n),r=e.placement.split('-')[1]||'',p=[];switch(t.behavior){case\x20ce.FLIP:p=[n,i];break;case\x20ce.CLOCKWISE:p=G(n);break;case\x20ce.COUNTERCLOCKWISE:p=G(n,!0);break;default:p=t.behavior;}return\x20p.forEach(function(s,d){if(n!==s||p.length===d+1)return\x20e;n=e.placement.split('-')[0],i=T(n);var\x20a=e.offsets.popper,l=e.offsets.reference,f=Z,m='left'===n&&f(a.right)>f(l.left)||'right'===n&&f(a.left)<f(l.right)||'top'===n&&f(a.bottom)>f(l.top)||'bottom'===n&&f(a.top)<f(l.bottom),h=f(a.left)<f(o.left),c=f(a.right)>f(o.right),g=f(a.top)<f(o.top),u=f(a.bottom)>f(o.bottom),b='left'===n&&h||'right'===n&&c||'top'===n&&g||'bottom'===n&&u,w=-1!==['top','bottom'].indexOf(n),y=!!t.flipVariations&&(w&&'start'===r&&h||w&&'end'===r&&c||!w&&'start'===r&&g||!w&&'end'===r&&u),E=!!t.flipVariationsByContent&&(w&&'start'===r&&c||w&&'end'===r&&h||!w&&'start'===r&&u||!w&&'end'===r&&g),v=y||E;(m||b||v)&&(e.flipped=!0,(m||b)&&(n=p[d+1]),v&&(r=z(r)),e.placement=n+(r?'-'+r:''),e.offsets.popper=le({},e.offsets.popper,C(e.instance.popper,e.offsets.reference,e.placement)),e=P(e.instance.modifiers,e,'flip'))}),e},behavior:'flip',padding:5,boundariesElement:'viewport',flipVariations:!1,flipVariationsByContent:!1},inner:{order:700,enabled:!1,fn:function(e){var\x20t=e.placement,o=t.split('-')[0],n=e.offsets,i=n.popper,r=n.reference,p=-1!==['left','right'].indexOf(o),s=-1===['top','left'].indexOf(o);return\x20i[p?'left':'top']=r[o]-(s?i[p?'width':'height']:0),e.placement=T(t),e.offsets.popper=g(i),e}},hide:{order:800,enabled:!0,fn:function(e){if(!K(e.instance.modifiers,'hide','preventOverflow'))return\x20e;var\x20t=e.offsets.reference,o=D(e.instance.modifiers,function(e){return'preventOverflow'===e.name}).boundaries;if(t.bottom<o.top||t.left>o.right||t.top>o.bottom||t.right<o.left){if(!0===e.hide)return\x20e;e.hide=!0,e.attributes['x-out-of-boundaries']=''}else{if(!1===e.hide)return\x20e;e.hide=!1,e.attributes['x-out-of-boundaries']=!1}return\x20e}},computeStyle:{order:850,enabled:!0,fn:function(e,t){var\x20o=t.x,n=t.y,i=e.offsets.popper,r=D(e.instance.modifiers,function(e){return'applyStyle'===e.name}).gpuAcceleration;void\x200!==r&&console.warn('WARNING:\x20`gpuAcceleration`\x20option\x20moved\x20to\x20`computeStyle`\x20modifier\x20and\x20will\x20not\x20be\x20supported\x20in\x20future\x20versions\x20of\x20Popper.js!');var\x20s,d,a=void\x200===r?t.gpuAcceleration:r,l=p(e.instance.popper),f=u(l),m={position:i.position},h=q(e,2>window.devicePixelRatio||!fe),c='bottom'===o?'top':'bottom',g='right'===n?'left':'right',b=B('transform');if(d='bottom'==c?'HTML'===l.nodeName?-l.clientHeight+h.bottom:-f.height+h.bottom:h.top,s='right'==g?'HTML'===l.nodeName?-l.clientWidth+h.right:-f.width+h.right:h.left,a&&b)m[b]='translate3d('+s+'px,\x20'+d+'px,\x200)',m[c]=0,m[g]=0,m.willChange='transform';else{var\x20w='bottom'==c?-1:1,y='right'==g?-1:1;m[c]=d*w,m[g]=s*y,m.willChange=c+',\x20'+g}var\x20E={\"x-placement\":e.placement};return\x20e.attributes=le({},E,e.attributes),e.styles=le({},m,e.styles),e.arrowStyles=le({},e.offsets.arrow,e.arrowStyles),e},gpuAcceleration:!0,x:'bottom',y:'right'},applyStyle:{order:900,enabled:!0,fn:function(e){return\x20V(e.instance.popper,e.styles),j(e.instance.popper,e.attributes),e.arrowElement&&Object.keys(e.arrowStyles).length&&V(e.arrowElement,e.arrowStyles),e},onLoad:function(e,t,o,n,i){var\x20r=L(i,t,e,o.positionFixed),p=O(o.placement,r,t,e,o.modifiers.flip.boundariesElement,o.modifiers.flip.padding);return\x20t.setAttribute('x-placement',p),V(t,{position:o.positionFixed?'fixed':'absolute'}),o},gpuAcceleration:void\x200}}},ge});\x0a",
 
-	"sidebar.html": "<!--\x20sidebar.html\x20-->\x0a<div\x20class=\"sphinxsidebar\">\x0a\x0a\x20\x20{{-\x20define\x20\"package\"\x20-}}\x0a\x0a\x20\x20{{-\x20range\x20.\x20}}\x0a\x20\x20<li>\x0a\x20\x20\x20\x20{{\x20$package\x20:=\x20.\x20}}\x0a\x20\x20\x20\x20{{-\x20$ImportPath\x20:=\x20.ImportPath\x20-}}\x0a\x20\x20\x20\x20{{-\x20$pkg_name_html\x20:=\x20html\x20.Name\x20-}}\x0a\x0a\x20\x20\x20\x20<div\x20class=\"reference\x20reference-package\">\x0a\x20\x20\x20\x20\x20\x20<a\x20href=\"/{{-\x20$ImportPath\x20-}}\"\x20title=\"{{-\x20$ImportPath\x20-}}\">{{-\x20.Name\x20-}}</a>\x0a\x0a\x20\x20\x20\x20\x20\x20{{-\x20if\x20gt\x20(len\x20(indent_filter\x20.Types))\x200\x20}}\x0a\x20\x20\x20\x20\x20\x20<button\x20class=\"btn\x20btn-link\x20expand-icon\x20collapsed\x20docs-expand-arrow\"\x20data-toggle=\"collapse\"\x20data-target=\"#pkg-{{-\x20$pkg_name_html\x20-}}\"></button>\x0a\x20\x20\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20</div>\x0a\x0a\x20\x20\x20\x20{{-\x20if\x20gt\x20(len\x20(indent_filter\x20.Types))\x200\x20}}\x0a\x20\x20\x20\x20<ul\x20class=\"list-types\x20collapse\x20multi-collapse\"\x20id=\"pkg-{{\x20$pkg_name_html\x20}}\">\x0a\x0a\x20\x20\x20\x20\x20\x20{{-\x20range\x20(indent_filter\x20.Types)}}\x0a\x20\x20\x20\x20\x20\x20<li>\x0a\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20$type_name_html\x20:=\x20html\x20.Name\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20<div\x20class=\"reference\x20reference-type\"\x20id=\"reference-type-{{-\x20$type_name_html\x20-}}\">\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<a\x20href=\"/{{-\x20$ImportPath\x20-}}/{{-\x20$type_name_html\x20-}}.html\"\x20title=\"{{-\x20$type_name_html\x20-}}\">{{-\x20$type_name_html\x20-}}</a>\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20if\x20or\x20(indent_filter\x20.Funcs)\x20(indent_filter\x20.Methods)\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<button\x20class=\"btn\x20btn-link\x20expand-icon\x20collapsed\x20docs-expand-arrow\"\x20data-toggle=\"collapse\"\x20data-target=\"#type-{{-\x20$type_name_html\x20-}}\"></button>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20</div>\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20if\x20or\x20(indent_filter\x20.Funcs)\x20(indent_filter\x20.Methods)\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20<ul\x20class=\"list-methods\x20collapse\x20multi-collapse\"\x20id=\"type-{{-\x20$type_name_html\x20-}}\">\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20range\x20(indent_filter\x20.Funcs)}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20$name_html\x20:=\x20html\x20.Name\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<li>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<div\x20class=\"reference\x20reference-func\">\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<a\x20href=\"/{{-\x20$ImportPath\x20-}}/{{-\x20$type_name_html\x20-}}.{{-\x20$name_html\x20-}}.html\"\x20title=\"{{-\x20$name_html\x20-}}\">{{-\x20$name_html\x20-}}</a>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20</div>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20</li>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20range\x20(indent_filter\x20.Methods)}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20$name_html\x20:=\x20html\x20.Name\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<li>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<div\x20class=\"reference\x20reference-method\">\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<a\x20href=\"/{{-\x20$ImportPath\x20-}}/{{-\x20$type_name_html\x20-}}.{{-\x20$name_html\x20-}}.html\"\x20title=\"{{-\x20$name_html\x20-}}\">{{-\x20$name_html\x20-}}</a>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20</div>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20</li>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20</ul>\x0a\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20\x20\x20</li>\x0a\x20\x20\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20</ul>\x0a\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x0a\x20\x20\x20\x20{{-\x20if\x20gt\x20(len\x20.SubPackages)\x200\x20}}\x0a\x20\x20\x20\x20<ul\x20class=\"list-subpackages\">\x0a\x20\x20\x20\x20\x20\x20{{-\x20template\x20\"package\"\x20.SubPackages\x20}}\x0a\x20\x20\x20\x20</ul>\x0a\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x0a\x20\x20</li>\x0a\x20\x20{{-\x20end\x20-}}\x0a\x20\x20{{-\x20end\x20-}}\x0a\x0a\x20\x20{{with\x20.Corpus}}\x0a\x20\x20<ul\x20class=\"list-packages\">\x0a\x20\x20\x20\x20{{-\x20template\x20\"package\"\x20.Tree\x20}}\x0a\x20\x20</ul>\x0a\x20\x20{{-\x20end\x20}}\x0a</div>\x0a<!--\x20end\x20sidebar.html\x20-->\x0a",
+	"references.html": "<!--\x20references.html\x20-->\x0a{{-\x20$name\x20:=\x20.Name\x20-}}\x0a{{-\x20with\x20.Groups\x20}}\x0a<h2\x20id=\"uses\">Uses</h2>\x0a<div\x20class=\"references\">\x0a\x20\x20{{-\x20range\x20.\x20}}\x0a\x20\x20<details\x20class=\"references-group\"\x20{{-\x20if\x20le\x20(len\x20.Spots)\x2010\x20}}\x20open{{\x20end\x20}}>\x0a\x20\x20\x20\x20<summary>{{\x20.Kind.Name\x20}}\x20<span\x20class=\"badge\x20badge-light\">{{\x20len\x20.Spots\x20}}</span></summary>\x0a\x20\x20\x20\x20<ul\x20class=\"list-references\">\x0a\x20\x20\x20\x20\x20\x20{{-\x20range\x20.Spots\x20}}\x0a\x20\x20\x20\x20\x20\x20{{-\x20$spot\x20:=\x20.\x20}}\x0a\x20\x20\x20\x20\x20\x20<li>{{\x20with\x20queryLink\x20.File\x20$name\x20.Line\x20}}<a\x20href=\"{{\x20.\x20}}\">{{\x20$spot.File\x20}}:{{\x20$spot.Line\x20}}</a>{{\x20else\x20}}{{\x20.File\x20}}:{{\x20.Line\x20}}{{\x20end\x20}}</li>\x0a\x20\x20\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20</ul>\x0a\x20\x20</details>\x0a\x20\x20{{-\x20end\x20}}\x0a</div>\x0a{{-\x20end\x20}}\x0a",
+
+	"search.html": "<!--\x20search.html\x20-->\x0a<h1\x20id=\"search-title\">Search</h1>\x0a\x0a<form\x20class=\"search-form\"\x20action=\"{{\x20abs_url\x20\"/search\"\x20}}\"\x20method=\"GET\">\x0a\x20\x20<input\x20type=\"search\"\x20class=\"form-control\"\x20name=\"q\"\x20value=\"{{-\x20.Query\x20-}}\"\x20placeholder=\"Search\x20packages\x20and\x20identifiers\"\x20autofocus>\x0a</form>\x0a\x0a{{-\x20if\x20.Query\x20}}\x0a\x20\x20{{-\x20with\x20.SearchResults\x20}}\x0a\x20\x20<p\x20class=\"search-summary\">{{\x20len\x20.\x20}}\x20results\x20for\x20<code>{{\x20$.Query\x20}}</code></p>\x0a\x0a\x20\x20<ul\x20class=\"search-results\">\x0a\x20\x20\x20\x20{{-\x20range\x20.\x20}}\x0a\x20\x20\x20\x20<li\x20class=\"search-result\">\x0a\x20\x20\x20\x20\x20\x20<div\x20class=\"search-result-title\">\x0a\x20\x20\x20\x20\x20\x20\x20\x20<span\x20class=\"badge\x20badge-light\x20search-result-kind\">{{-\x20.Kind\x20-}}</span>\x0a\x20\x20\x20\x20\x20\x20\x20\x20<a\x20href=\"{{-\x20.URL\x20-}}\">{{-\x20.Name\x20-}}</a>\x0a\x20\x20\x20\x20\x20\x20\x20\x20<span\x20class=\"search-result-path\">{{-\x20.ImportPath\x20-}}</span>\x0a\x20\x20\x20\x20\x20\x20</div>\x0a\x20\x20\x20\x20\x20\x20{{-\x20with\x20.Snippet\x20}}\x0a\x20\x20\x20\x20\x20\x20<div\x20class=\"search-result-snippet\">{{-\x20.\x20-}}</div>\x0a\x20\x20\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20</li>\x0a\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20</ul>\x0a\x20\x20{{-\x20else\x20}}\x0a\x20\x20<p\x20class=\"search-summary\">No\x20results\x20for\x20<code>{{\x20.Query\x20}}</code></p>\x0a\x20\x20{{-\x20end\x20}}\x0a{{-\x20end\x20}}\x0a<!--\x20end\x20search.html\x20-->\x0a",
+
+	"sidebar.html": "<!--\x20sidebar.html\x20-->\x0a<div\x20class=\"sphinxsidebar\">\x0a\x0a\x20\x20{{-\x20define\x20\"package\"\x20-}}\x0a\x0a\x20\x20{{-\x20range\x20.\x20}}\x0a\x20\x20<li>\x0a\x20\x20\x20\x20{{\x20$package\x20:=\x20.\x20}}\x0a\x20\x20\x20\x20{{-\x20$ImportPath\x20:=\x20.ImportPath\x20-}}\x0a\x20\x20\x20\x20{{-\x20$pkg_name_html\x20:=\x20html\x20.Name\x20-}}\x0a\x0a\x20\x20\x20\x20<div\x20class=\"reference\x20reference-package\">\x0a\x20\x20\x20\x20\x20\x20<a\x20href=\"{{-\x20package_url\x20$ImportPath\x20-}}\"\x20title=\"{{-\x20$ImportPath\x20-}}\">{{-\x20.Name\x20-}}</a>\x0a\x0a\x20\x20\x20\x20\x20\x20{{-\x20if\x20gt\x20(len\x20(indent_filter\x20.Types))\x200\x20}}\x0a\x20\x20\x20\x20\x20\x20<button\x20class=\"btn\x20btn-link\x20expand-icon\x20collapsed\x20docs-expand-arrow\"\x20data-toggle=\"collapse\"\x20data-target=\"#pkg-{{-\x20$pkg_name_html\x20-}}\"></button>\x0a\x20\x20\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20</div>\x0a\x0a\x20\x20\x20\x20{{-\x20if\x20gt\x20(len\x20(indent_filter\x20.Types))\x200\x20}}\x0a\x20\x20\x20\x20<ul\x20class=\"list-types\x20collapse\x20multi-collapse\"\x20id=\"pkg-{{\x20$pkg_name_html\x20}}\">\x0a\x0a\x20\x20\x20\x20\x20\x20{{-\x20range\x20(indent_filter\x20.Types)}}\x0a\x20\x20\x20\x20\x20\x20<li>\x0a\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20$type_name_html\x20:=\x20html\x20.Name\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20<div\x20class=\"reference\x20reference-type\"\x20id=\"reference-type-{{-\x20$type_name_html\x20-}}\">\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<a\x20href=\"{{-\x20type_url\x20$ImportPath\x20.Name\x20-}}\"\x20title=\"{{-\x20$type_name_html\x20-}}\">{{-\x20$type_name_html\x20-}}</a>\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20if\x20or\x20(indent_filter\x20.Funcs)\x20(indent_filter\x20.Methods)\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<button\x20class=\"btn\x20btn-link\x20expand-icon\x20collapsed\x20docs-expand-arrow\"\x20data-toggle=\"collapse\"\x20data-target=\"#type-{{-\x20$type_name_html\x20-}}\"></button>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20</div>\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20if\x20or\x20(indent_filter\x20.Funcs)\x20(indent_filter\x20.Methods)\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20<ul\x20class=\"list-methods\x20collapse\x20multi-collapse\"\x20id=\"type-{{-\x20$type_name_html\x20-}}\">\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20range\x20(indent_filter\x20.Funcs)}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20$name_html\x20:=\x20html\x20.Name\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<li>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<div\x20class=\"reference\x20reference-func\">\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<a\x20href=\"{{-\x20func_url\x20$ImportPath\x20$type_name_html\x20.Name\x20-}}\"\x20title=\"{{-\x20$name_html\x20-}}\">{{-\x20$name_html\x20-}}</a>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20</div>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20</li>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20range\x20(indent_filter\x20.Methods)}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20$name_html\x20:=\x20html\x20.Name\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<li>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<div\x20class=\"reference\x20reference-method\">\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<a\x20href=\"{{-\x20func_url\x20$ImportPath\x20$type_name_html\x20.Name\x20-}}\"\x20title=\"{{-\x20$name_html\x20-}}\">{{-\x20$name_html\x20-}}</a>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20</div>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20</li>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20</ul>\x0a\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20\x20\x20</li>\x0a\x20\x20\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20</ul>\x0a\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x0a\x20\x20\x20\x20{{-\x20if\x20gt\x20(len\x20.SubPackages)\x200\x20}}\x0a\x20\x20\x20\x20<ul\x20class=\"list-subpackages\">\x0a\x20\x20\x20\x20\x20\x20{{-\x20template\x20\"package\"\x20.SubPackages\x20}}\x0a\x20\x20\x20\x20</ul>\x0a\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x0a\x20\x20</li>\x0a\x20\x20{{-\x20end\x20-}}\x0a\x20\x20{{-\x20end\x20-}}\x0a\x0a\x20\x20{{with\x20.Snapshot}}\x0a\x20\x20<ul\x20class=\"list-packages\">\x0a\x20\x20\x20\x20{{-\x20template\x20\"package\"\x20.Tree\x20}}\x0a\x20\x20</ul>\x0a\x20\x20{{-\x20end\x20}}\x0a</div>\x0a<!--\x20end\x20sidebar.html\x20-->\x0a",
+
+	"source.html": "<!--\x20source.html\x20-->\x0a<h1\x20id=\"source-title\">{{-\x20.Title\x20-}}</h1>\x0a\x0a<p\x20class=\"source-package\">Package\x20{{\x20unescaped\x20(srcToPkgLink\x20.SourcePath)\x20}}</p>\x0a\x0a<pre\x20class=\"source\"\x20{{-\x20if\x20.Static\x20}}\x20data-highlight-query{{\x20end\x20}}>{{\x20unescaped\x20.Source\x20}}</pre>\x0a",
 
-	"style.css": "body\x20{\x0a\x20\x20display:\x20flex\x20!important;\x0a\x20\x20margin:\x200;\x0a\x20\x20font-family:\x20-apple-system,BlinkMacSystemFont,\"Segoe\x20UI\",Helvetica,Arial,sans-serif,\"Apple\x20Color\x20Emoji\",\"Segoe\x20UI\x20Emoji\";\x0a\x20\x20font-size:\x2014px;\x0a\x20\x20line-height:\x201.5;\x0a\x20\x20color:\x20#24292e;\x0a\x20\x20background-color:\x20#fff;\x20}\x0a\x0a.collapsing\x20{\x0a\x20\x20position:\x20relative;\x0a\x20\x20height:\x200;\x0a\x20\x20overflow:\x20hidden;\x0a\x20\x20-webkit-transition:\x20height\x20.05s\x20ease;\x0a\x20\x20-o-transition:\x20height\x20.05s\x20ease;\x0a\x20\x20transition:\x20height\x20.05s\x20ease;\x20}\x0a\x0a.table-responsive\x20.table\x20{\x0a\x20\x20margin-bottom:\x200;\x20}\x0a\x0a.table-hover\x20tbody\x20tr:hover\x20{\x0a\x20\x20background-color:\x20rgba(0,\x200,\x200,\x200.025);\x20}\x0a\x0a.callout\x20{\x0a\x20\x20padding:\x201.25rem;\x0a\x20\x20margin-top:\x201.25rem;\x0a\x20\x20margin-bottom:\x201.25rem;\x0a\x20\x20border:\x201px\x20solid\x20#eee;\x0a\x20\x20border-left-width:\x20.25rem;\x0a\x20\x20border-radius:\x20.25rem;\x20}\x0a\x20\x20.callout\x20h4\x20{\x0a\x20\x20\x20\x20margin-top:\x200;\x0a\x20\x20\x20\x20margin-bottom:\x20.25rem;\x20}\x0a\x20\x20.callout\x20p:last-child\x20{\x0a\x20\x20\x20\x20margin-bottom:\x200;\x20}\x0a\x20\x20.callout\x20code\x20{\x0a\x20\x20\x20\x20border-radius:\x20.25rem;\x20}\x0a\x20\x20.callout\x20+\x20.callout\x20{\x0a\x20\x20\x20\x20margin-top:\x20-.25rem;\x20}\x0a\x20\x20.callout\x20>\x20*:last-child\x20{\x0a\x20\x20\x20\x20margin-bottom:\x200;\x20}\x0a\x20\x20.callout.callout-info\x20{\x0a\x20\x20\x20\x20border-left-color:\x20\"#5bc0de\";\x20}\x0a\x20\x20\x20\x20.callout.callout-info\x20h4\x20{\x0a\x20\x20\x20\x20\x20\x20color:\x20\"#5bc0de\";\x20}\x0a\x20\x20.callout.callout-warning\x20{\x0a\x20\x20\x20\x20border-left-color:\x20\"#f0ad4e\";\x20}\x0a\x20\x20\x20\x20.callout.callout-warning\x20h4\x20{\x0a\x20\x20\x20\x20\x20\x20color:\x20\"#f0ad4e\";\x20}\x0a\x20\x20.callout.callout-danger\x20{\x0a\x20\x20\x20\x20border-left-color:\x20\"#d9534f\";\x20}\x0a\x20\x20\x20\x20.callout.callout-danger\x20h4\x20{\x0a\x20\x20\x20\x20\x20\x20color:\x20\"#d9534f\";\x20}\x0a\x0atextarea\x20{\x0a\x20\x20/*\x20Inherit\x20text\x20color\x20from\x20body\x20avoiding\x20illegible\x20text\x20in\x20the\x20case\x20where\x20the\x0a\x20\x09*\x20user\x20has\x20inverted\x20the\x20browsers\x20custom\x20text\x20and\x20background\x20colors.\x20*/\x0a\x20\x20color:\x20inherit;\x20}\x0a\x0apre\x20{\x0a\x20\x20background:\x20#efefef;\x0a\x20\x20padding:\x200.625rem;\x0a\x20\x20border-radius:\x200.3125rem;\x20}\x0a\x0apre,\x0acode\x20{\x0a\x20\x20font-family:\x20Menlo,\x20monospace;\x0a\x20\x20font-size:\x200.875rem;\x20}\x0a\x0apre\x20{\x0a\x20\x20line-height:\x201.4;\x0a\x20\x20overflow-x:\x20auto;\x20}\x0a\x0apre\x20.comment\x20{\x0a\x20\x20color:\x20#006600;\x20}\x0a\x0apre\x20.highlight,\x0apre\x20.highlight-comment,\x0apre\x20.selection-highlight,\x0apre\x20.selection-highlight-comment\x20{\x0a\x20\x20background:\x20#FFFF00;\x20}\x0a\x0apre\x20.selection,\x0apre\x20.selection-comment\x20{\x0a\x20\x20background:\x20#FF9632;\x20}\x0a\x0apre\x20.ln\x20{\x0a\x20\x20color:\x20#999;\x0a\x20\x20background:\x20#efefef;\x20}\x0a\x0a.ln\x20{\x0a\x20\x20-webkit-user-select:\x20none;\x0a\x20\x20-moz-user-select:\x20none;\x0a\x20\x20-ms-user-select:\x20none;\x0a\x20\x20user-select:\x20none;\x0a\x20\x20/*\x20Ensure\x208\x20characters\x20in\x20the\x20document\x20-\x20which\x20due\x20to\x20floating\x0a\x20\x20\x20*\x20point\x20rendering\x20issues,\x20might\x20have\x20a\x20width\x20of\x20less\x20than\x201\x20each\x20-\x20are\x208\x0a\x20\x20\x20*\x20characters\x20wide,\x20so\x20a\x20tab\x20in\x20the\x209th\x20position\x20indents\x20properly.\x20See\x0a\x20\x20\x20*\x20https://github.com/webcompat/web-bugs/issues/17530#issuecomment-402675091\x0a\x20\x20\x20*\x20for\x20more\x20information.\x20*/\x0a\x20\x20display:\x20inline-block;\x0a\x20\x20width:\x208ch;\x20}\x0a\x0a@media\x20print\x20{\x0a\x20\x20#sidebar\x20{\x0a\x20\x20\x20\x20display:\x20none\x20!important;\x20}\x0a\x20\x20#btn-printer\x20{\x0a\x20\x20\x20\x20display:\x20none\x20!important;\x20}\x20}\x0a\x0a#sidebar\x20{\x0a\x20\x20width:\x20280px;\x0a\x20\x20display:\x20block;\x0a\x20\x20background-color:\x20#05264c;\x0a\x20\x20color:\x20#FFF;\x0a\x20\x20position:\x20sticky;\x0a\x20\x20top:\x200;\x0a\x20\x20padding-bottom:\x2032px;\x0a\x20\x20overflow-y:\x20auto;\x0a\x20\x20height:\x20100vh;\x0a\x20\x20flex-shrink:\x200;\x20}\x0a\x20\x20#sidebar\x20.brand\x20{\x0a\x20\x20\x20\x20padding:\x2024px\x20!important;\x0a\x20\x20\x20\x20text-align:\x20center;\x20}\x0a\x20\x20\x20\x20#sidebar\x20.brand\x20a\x20{\x0a\x20\x20\x20\x20\x20\x20color:\x20#FFF;\x20}\x0a\x20\x20#sidebar\x20ul,\x0a\x20\x20#sidebar\x20li\x20{\x0a\x20\x20\x20\x20list-style:\x20none;\x0a\x20\x20\x20\x20margin:\x200;\x0a\x20\x20\x20\x20padding:\x200;\x20}\x0a\x20\x20#sidebar\x20a\x20{\x0a\x20\x20\x20\x20display:\x20inline-block;\x0a\x20\x20\x20\x20width:\x20100%;\x0a\x20\x20\x20\x20padding:\x204px\x201rem;\x0a\x20\x20\x20\x20line-height:\x201.4;\x0a\x20\x20\x20\x20color:\x20#c8e1ff;\x0a\x20\x20\x20\x20overflow:\x20hidden;\x0a\x20\x20\x20\x20text-overflow:\x20ellipsis;\x0a\x20\x20\x20\x20white-space:\x20nowrap;\x20}\x0a\x20\x20\x20\x20#sidebar\x20a::before\x20{\x0a\x20\x20\x20\x20\x20\x20content:\x20\"\";\x0a\x20\x20\x20\x20\x20\x20display:\x20inline-block;\x0a\x20\x20\x20\x20\x20\x20width:\x2012px;\x0a\x20\x20\x20\x20\x20\x20height:\x2012px;\x0a\x20\x20\x20\x20\x20\x20vertical-align:\x20middle;\x0a\x20\x20\x20\x20\x20\x20margin-right:\x200.2rem;\x0a\x20\x20\x20\x20\x20\x20background-repeat:\x20no-repeat;\x0a\x20\x20\x20\x20\x20\x20background-position:\x20center;\x0a\x20\x20\x20\x20\x20\x20background-size:\x2012px;\x0a\x20\x20\x20\x20\x20\x20background-color:\x20transparent;\x20}\x0a\x20\x20\x20\x20#sidebar\x20a:hover\x20{\x0a\x20\x20\x20\x20\x20\x20color:\x20#79b8ff;\x0a\x20\x20\x20\x20\x20\x20text-decoration:\x20none;\x0a\x20\x20\x20\x20\x20\x20font-weight:\x20normal;\x20}\x0a\x20\x20\x20\x20#sidebar\x20a.current\x20{\x0a\x20\x20\x20\x20\x20\x20font-weight:\x20500\x20!important;\x0a\x20\x20\x20\x20\x20\x20color:\x20#79b8ff;\x0a\x20\x20\x20\x20\x20\x20background-color:\x20#032f62;\x20}\x0a\x20\x20#sidebar\x20.sphinxsidebar\x20{\x0a\x20\x20\x20\x20overflow-y:\x20auto;\x20}\x0a\x20\x20#sidebar\x20.reference\x20{\x0a\x20\x20\x20\x20width:\x20100%;\x0a\x20\x20\x20\x20position:\x20relative;\x20}\x0a\x20\x20\x20\x20#sidebar\x20.reference.reference-package\x20a\x20{\x0a\x20\x20\x20\x20\x20\x20font-size:\x2016px;\x0a\x20\x20\x20\x20\x20\x20padding-left:\x200.5rem;\x20}\x0a\x20\x20\x20\x20\x20\x20#sidebar\x20.reference.reference-package\x20a::before\x20{\x0a\x20\x20\x20\x20\x20\x20\x20\x20background-image:\x20url(\"data:image/svg+xml,<svg\x20width='1em'\x20height='1em'\x20viewBox='0\x200\x2016\x2016'\x20class='bi\x20bi-folder'\x20fill='%23c8e1ff'\x20xmlns='http://www.w3.org/2000/svg'><path\x20d='M9.828\x204a3\x203\x200\x200\x201-2.12-.879l-.83-.828A1\x201\x200\x200\x200\x206.173\x202H2.5a1\x201\x200\x200\x200-1\x20.981L1.546\x204h-1L.5\x203a2\x202\x200\x200\x201\x202-2h3.672a2\x202\x200\x200\x201\x201.414.586l.828.828A2\x202\x200\x200\x200\x209.828\x203v1z'/><path\x20fill-rule='evenodd'\x20d='M13.81\x204H2.19a1\x201\x200\x200\x200-.996\x201.09l.637\x207a1\x201\x200\x200\x200\x20.995.91h10.348a1\x201\x200\x200\x200\x20.995-.91l.637-7A1\x201\x200\x200\x200\x2013.81\x204zM2.19\x203A2\x202\x200\x200\x200\x20.198\x205.181l.637\x207A2\x202\x200\x200\x200\x202.826\x2014h10.348a2\x202\x200\x200\x200\x201.991-1.819l.637-7A2\x202\x200\x200\x200\x2013.81\x203H2.19z'/></svg>\");\x20}\x0a\x20\x20\x20\x20#sidebar\x20.reference.reference-type\x20a\x20{\x0a\x20\x20\x20\x20\x20\x20padding-left:\x201.2rem;\x20}\x0a\x20\x20\x20\x20\x20\x20#sidebar\x20.reference.reference-type\x20a::before\x20{\x0a\x20\x20\x20\x20\x20\x20\x20\x20background-image:\x20url(\"data:image/svg+xml,<svg\x20width='1em'\x20height='1em'\x20viewBox='0\x200\x2016\x2016'\x20class='bi\x20bi-bezier'\x20fill='%23c8e1ff'\x20xmlns='http://www.w3.org/2000/svg'><path\x20d='M0,9.5\x20C-1.01453063e-16,8.67157288\x200.671572875,8\x201.5,8\x20L4.5,8\x20C4.89782473,8\x205.2793556,8.15803526\x205.56066017,8.43933983\x20C5.84196474,8.7206444\x206,9.10217527\x206,9.5\x20L6,12.5\x20C6,13.3284271\x205.32842712,14\x204.5,14\x20L1.5,14\x20C0.671572875,14\x201.01453063e-16,13.3284271\x200,12.5\x20L0,9.5\x20Z\x20M1.5,9\x20C1.22385763,9\x201,9.22385763\x201,9.5\x20L1,12.5\x20C1,12.7761424\x201.22385763,13\x201.5,13\x20L4.5,13\x20C4.77614237,13\x205,12.7761424\x205,12.5\x20L5,9.5\x20C5,9.22385763\x204.77614237,9\x204.5,9\x20L1.5,9\x20Z\x20M10,9.5\x20C10,8.67157288\x2010.6715729,8\x2011.5,8\x20L14.5,8\x20C15.3284271,8\x2016,8.67157288\x2016,9.5\x20L16,12.5\x20C16,13.3284271\x2015.3284271,14\x2014.5,14\x20L11.5,14\x20C10.6715729,14\x2010,13.3284271\x2010,12.5\x20L10,9.5\x20Z\x20M11.5,9\x20C11.2238576,9\x2011,9.22385763\x2011,9.5\x20L11,12.5\x20C11,12.7761424\x2011.2238576,13\x2011.5,13\x20L14.5,13\x20C14.7761424,13\x2015,12.7761424\x2015,12.5\x20L15,9.5\x20C15,9.22385763\x2014.7761424,9\x2014.5,9\x20L11.5,9\x20Z\x20M0,1.5\x20C0,0.671572875\x200.671572875,0\x201.5,0\x20L14.5,0\x20C15.3284271,0\x2016,0.671572875\x2016,1.5\x20L16,4.5\x20C16,5.32842712\x2015.3284271,6\x2014.5,6\x20L1.5,6\x20C0.671572875,6\x200,5.32842712\x200,4.5\x20L0,1.5\x20Z\x20M1.5,1\x20C1.22385763,1\x201,1.22385763\x201,1.5\x20L1,4.5\x20C1,4.77614237\x201.22385763,5\x201.5,5\x20L14.5,5\x20C14.7761424,5\x2015,4.77614237\x2015,4.5\x20L15,1.5\x20C15,1.22385763\x2014.7761424,1\x2014.5,1\x20L1.5,1\x20Z'></path></svg>\");\x20}\x0a\x20\x20\x20\x20#sidebar\x20.reference.reference-func,\x20#sidebar\x20.reference.reference-method\x20{\x0a\x20\x20\x20\x20\x20\x20border-bottom:\x20none;\x20}\x0a\x20\x20\x20\x20\x20\x20#sidebar\x20.reference.reference-func\x20a,\x20#sidebar\x20.reference.reference-method\x20a\x20{\x0a\x20\x20\x20\x20\x20\x20\x20\x20padding-left:\x202rem;\x20}\x0a\x20\x20\x20\x20\x20\x20\x20\x20#sidebar\x20.reference.reference-func\x20a::before,\x20#sidebar\x20.reference.reference-method\x20a::before\x20{\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20background-image:\x20url(\"data:image/svg+xml,<svg\x20width='1em'\x20height='1em'\x20viewBox='0\x200\x2016\x2016'\x20class='bi\x20bi-box'\x20fill='%23c8e1ff'\x20xmlns='http://www.w3.org/2000/svg'><path\x20fill-rule='evenodd'\x20d='M8.186\x201.113a.5.5\x200\x200\x200-.372\x200L1.846\x203.5\x208\x205.961\x2014.154\x203.5\x208.186\x201.113zM15\x204.239l-6.5\x202.6v7.922l6.5-2.6V4.24zM7.5\x2014.762V6.838L1\x204.239v7.923l6.5\x202.6zM7.443.184a1.5\x201.5\x200\x200\x201\x201.114\x200l7.129\x202.852A.5.5\x200\x200\x201\x2016\x203.5v8.662a1\x201\x200\x200\x201-.629.928l-7.185\x202.874a.5.5\x200\x200\x201-.372\x200L.63\x2013.09a1\x201\x200\x200\x201-.63-.928V3.5a.5.5\x200\x200\x201\x20.314-.464L7.443.184z'/></svg>\");\x20}\x0a\x20\x20#sidebar\x20.expand-icon\x20{\x0a\x20\x20\x20\x20display:\x20inline-block;\x0a\x20\x20\x20\x20width:\x202.5rem;\x0a\x20\x20\x20\x20top:\x200;\x0a\x20\x20\x20\x20bottom:\x200;\x0a\x20\x20\x20\x20right:\x200;\x0a\x20\x20\x20\x20position:\x20absolute;\x0a\x20\x20\x20\x20background-image:\x20url(\"data:image/svg+xml,<svg\x20width='1em'\x20height='1em'\x20viewBox='0\x200\x2016\x2016'\x20class='bi\x20bi-chevron-down'\x20fill='%23c8e1ff'\x20xmlns='http://www.w3.org/2000/svg'><path\x20fill-rule='evenodd'\x20d='M1.646\x204.646a.5.5\x200\x200\x201\x20.708\x200L8\x2010.293l5.646-5.647a.5.5\x200\x200\x201\x20.708.708l-6\x206a.5.5\x200\x200\x201-.708\x200l-6-6a.5.5\x200\x200\x201\x200-.708z'/></svg>\");\x0a\x20\x20\x20\x20background-repeat:\x20no-repeat;\x0a\x20\x20\x20\x20background-position:\x20center;\x0a\x20\x20\x20\x20background-size:\x2012px;\x0a\x20\x20\x20\x20border-radius:\x203px;\x0a\x20\x20\x20\x20opacity:\x20.75;\x20}\x0a\x20\x20\x20\x20#sidebar\x20.expand-icon.collapsed\x20{\x0a\x20\x20\x20\x20\x20\x20background-image:\x20url(\"data:image/svg+xml,<svg\x20width='1em'\x20height='1em'\x20viewBox='0\x200\x2016\x2016'\x20class='bi\x20bi-chevron-right'\x20fill='%23c8e1ff'\x20xmlns='http://www.w3.org/2000/svg'><path\x20fill-rule='evenodd'\x20d='M4.646\x201.646a.5.5\x200\x200\x201\x20.708\x200l6\x206a.5.5\x200\x200\x201\x200\x20.708l-6\x206a.5.5\x200\x200\x201-.708-.708L10.293\x208\x204.646\x202.354a.5.5\x200\x200\x201\x200-.708z'/></svg>\");\x20}\x0a\x20\x20\x20\x20#sidebar\x20.expand-icon:focus\x20{\x0a\x20\x20\x20\x20\x20\x20outline:\x200;\x0a\x20\x20\x20\x20\x20\x20box-shadow:\x20none;\x0a\x20\x20\x20\x20\x20\x20border:\x202px\x20solid;\x20}\x0a\x0a#main-column\x20{\x0a\x20\x20width:\x20100%\x20!important;\x0a\x20\x20margin-top:\x2025px;\x0a\x20\x20padding-left:\x2025px;\x0a\x20\x20padding-right:\x2025px;\x20}\x0a\x0a#footer\x20{\x0a\x20\x20margin-top:\x2050px;\x0a\x20\x20margin-bottom:\x2020px;\x0a\x20\x20text-align:\x20center;\x20}\x0a\x0a.permalink\x20{\x0a\x20\x20display:\x20none;\x20}\x0a\x0a:hover\x20>\x20.permalink\x20{\x0a\x20\x20display:\x20inline;\x20}\x0a\x0a#documentation\x20{\x0a\x20\x20max-width:\x201280px;\x0a\x20\x20margin-right:\x20auto;\x0a\x20\x20margin-left:\x20auto;\x0a\x20\x20position:\x20relative;\x20}\x0a\x20\x20#documentation\x20#btn-printer\x20{\x0a\x20\x20\x20\x20position:\x20absolute;\x0a\x20\x20\x20\x20right:\x200;\x0a\x20\x20\x20\x20top:\x2015px;\x0a\x20\x20\x20\x20color:\x20#24292e;\x20}\x0a\x20\x20\x20\x20#documentation\x20#btn-printer:hover\x20{\x0a\x20\x20\x20\x20\x20\x20color:\x20#007bff;\x20}\x0a\x0a.markdown-body\x20{\x0a\x20\x20font-family:\x20-apple-system,\x20BlinkMacSystemFont,\x20\"Segoe\x20UI\",\x20Helvetica,\x20Arial,\x20sans-serif,\x20\"Apple\x20Color\x20Emoji\",\x20\"Segoe\x20UI\x20Emoji\";\x0a\x20\x20font-size:\x2016px;\x0a\x20\x20line-height:\x201.5;\x0a\x20\x20word-wrap:\x20break-word;\x20}\x0a\x20\x20.markdown-body\x20kbd\x20{\x0a\x20\x20\x20\x20display:\x20inline-block;\x0a\x20\x20\x20\x20padding:\x203px\x205px;\x0a\x20\x20\x20\x20font:\x2011px\x20\"SFMono-Regular\",\x20Consolas,\x20\"Liberation\x20Mono\",\x20Menlo,\x20monospace;\x0a\x20\x20\x20\x20line-height:\x2010px;\x0a\x20\x20\x20\x20color:\x20#444d56;\x0a\x20\x20\x20\x20vertical-align:\x20middle;\x0a\x20\x20\x20\x20background-color:\x20#fafbfc;\x0a\x20\x20\x20\x20border:\x20solid\x201px\x20#d1d5da;\x0a\x20\x20\x20\x20border-bottom-color:\x20#d1d5da;\x0a\x20\x20\x20\x20border-radius:\x206px;\x0a\x20\x20\x20\x20box-shadow:\x20inset\x200\x20-1px\x200\x20#d1d5da;\x20}\x0a\x20\x20.markdown-body\x20>\x20::before\x20{\x0a\x20\x20\x20\x20display:\x20table;\x0a\x20\x20\x20\x20content:\x20\"\";\x20}\x0a\x20\x20.markdown-body\x20>\x20::after\x20{\x0a\x20\x20\x20\x20display:\x20table;\x0a\x20\x20\x20\x20clear:\x20both;\x0a\x20\x20\x20\x20content:\x20\"\";\x20}\x0a\x20\x20.markdown-body\x20>\x20*:first-child\x20{\x0a\x20\x20\x20\x20margin-top:\x200\x20!important;\x20}\x0a\x20\x20.markdown-body\x20>\x20*:last-child\x20{\x0a\x20\x20\x20\x20margin-bottom:\x200\x20!important;\x20}\x0a\x20\x20.markdown-body\x20a:not([href])\x20{\x0a\x20\x20\x20\x20color:\x20inherit;\x0a\x20\x20\x20\x20text-decoration:\x20none;\x20}\x0a\x20\x20.markdown-body\x20.absent\x20{\x0a\x20\x20\x20\x20color:\x20#cb2431;\x20}\x0a\x20\x20.markdown-body\x20.anchor\x20{\x0a\x20\x20\x20\x20float:\x20left;\x0a\x20\x20\x20\x20padding-right:\x204px;\x0a\x20\x20\x20\x20margin-left:\x20-20px;\x0a\x20\x20\x20\x20line-height:\x201;\x20}\x0a\x20\x20.markdown-body\x20.anchor:focus\x20{\x0a\x20\x20\x20\x20outline:\x20none;\x20}\x0a\x20\x20.markdown-body\x20p,\x0a\x20\x20.markdown-body\x20blockquote,\x0a\x20\x20.markdown-body\x20ul,\x0a\x20\x20.markdown-body\x20ol,\x0a\x20\x20.markdown-body\x20dl,\x0a\x20\x20.markdown-body\x20table,\x0a\x20\x20.markdown-body\x20pre,\x0a\x20\x20.markdown-body\x20details\x20{\x0a\x20\x20\x20\x20margin-top:\x200;\x0a\x20\x20\x20\x20margin-bottom:\x2016px;\x20}\x0a\x20\x20.markdown-body\x20hr\x20{\x0a\x20\x20\x20\x20height:\x20.25em;\x0a\x20\x20\x20\x20padding:\x200;\x0a\x20\x20\x20\x20margin:\x2024px\x200;\x0a\x20\x20\x20\x20background-color:\x20#e1e4e8;\x0a\x20\x20\x20\x20border:\x200;\x20}\x0a\x20\x20.markdown-body\x20blockquote\x20{\x0a\x20\x20\x20\x20padding:\x200\x201em;\x0a\x20\x20\x20\x20color:\x20#6a737d;\x0a\x20\x20\x20\x20border-left:\x20.25em\x20solid\x20#dfe2e5;\x20}\x0a\x20\x20.markdown-body\x20blockquote\x20>\x20:first-child\x20{\x0a\x20\x20\x20\x20margin-top:\x200;\x20}\x0a\x20\x20.markdown-body\x20blockquote\x20>\x20:last-child\x20{\x0a\x20\x20\x20\x20margin-bottom:\x200;\x20}\x0a\x20\x20.markdown-body\x20h1,\x0a\x20\x20.markdown-body\x20h2,\x0a\x20\x20.markdown-body\x20h3,\x0a\x20\x20.markdown-body\x20h4,\x0a\x20\x20.markdown-body\x20h5,\x0a\x20\x20.markdown-body\x20h6\x20{\x0a\x20\x20\x20\x20margin-top:\x2024px;\x0a\x20\x20\x20\x20margin-bottom:\x2016px;\x0a\x20\x20\x20\x20font-weight:\x20600;\x0a\x20\x20\x20\x20line-height:\x201.25;\x20}\x0a\x20\x20.markdown-body\x20h1\x20.octicon-link,\x0a\x20\x20.markdown-body\x20h2\x20.octicon-link,\x0a\x20\x20.markdown-body\x20h3\x20.octicon-link,\x0a\x20\x20.markdown-body\x20h4\x20.octicon-link,\x0a\x20\x20.markdown-body\x20h5\x20.octicon-link,\x0a\x20\x20.markdown-body\x20h6\x20.octicon-link\x20{\x0a\x20\x20\x20\x20color:\x20#1b1f23;\x0a\x20\x20\x20\x20vertical-align:\x20middle;\x0a\x20\x20\x20\x20visibility:\x20hidden;\x20}\x0a\x20\x20.markdown-body\x20h1:hover\x20.anchor,\x0a\x20\x20.markdown-body\x20h2:hover\x20.anchor,\x0a\x20\x20.markdown-body\x20h3:hover\x20.anchor,\x0a\x20\x20.markdown-body\x20h4:hover\x20.anchor,\x0a\x20\x20.markdown-body\x20h5:hover\x20.anchor,\x0a\x20\x20.markdown-body\x20h6:hover\x20.anchor\x20{\x0a\x20\x20\x20\x20text-decoration:\x20none;\x20}\x0a\x20\x20.markdown-body\x20h1:hover\x20.anchor\x20.octicon-link,\x0a\x20\x20.markdown-body\x20h2:hover\x20.anchor\x20.octicon-link,\x0a\x20\x20.markdown-body\x20h3:hover\x20.anchor\x20.octicon-link,\x0a\x20\x20.markdown-body\x20h4:hover\x20.anchor\x20.octicon-link,\x0a\x20\x20.markdown-body\x20h5:hover\x20.anchor\x20.octicon-link,\x0a\x20\x20.markdown-body\x20h6:hover\x20.anchor\x20.octicon-link\x20{\x0a\x20\x20\x20\x20visibility:\x20visible;\x20}\x0a\x20\x20.markdown-body\x20h1\x20tt,\x0a\x20\x20.markdown-body\x20h1\x20code,\x0a\x20\x20.markdown-body\x20h2\x20tt,\x0a\x20\x20.markdown-body\x20h2\x20code,\x0a\x20\x20.markdown-body\x20h3\x20tt,\x0a\x20\x20.markdown-body\x20h3\x20code,\x0a\x20\x20.markdown-body\x20h4\x20tt,\x0a\x20\x20.markdown-body\x20h4\x20code,\x0a\x20\x20.markdown-body\x20h5\x20tt,\x0a\x20\x20.markdown-body\x20h5\x20code,\x0a\x20\x20.markdown-body\x20h6\x20tt,\x0a\x20\x20.markdown-body\x20h6\x20code\x20{\x0a\x20\x20\x20\x20font-size:\x20inherit;\x20}\x0a\x20\x20.markdown-body\x20h1\x20{\x0a\x20\x20\x20\x20padding-bottom:\x20.3em;\x0a\x20\x20\x20\x20font-size:\x202em;\x0a\x20\x20\x20\x20border-bottom:\x201px\x20solid\x20#eaecef;\x20}\x0a\x20\x20.markdown-body\x20h2\x20{\x0a\x20\x20\x20\x20padding-bottom:\x20.3em;\x0a\x20\x20\x20\x20font-size:\x201.5em;\x0a\x20\x20\x20\x20border-bottom:\x201px\x20solid\x20#eaecef;\x20}\x0a\x20\x20.markdown-body\x20h3\x20{\x0a\x20\x20\x20\x20font-size:\x201.25em;\x20}\x0a\x20\x20.markdown-body\x20h4\x20{\x0a\x20\x20\x20\x20font-size:\x201em;\x20}\x0a\x20\x20.markdown-body\x20h5\x20{\x0a\x20\x20\x20\x20font-size:\x20.875em;\x20}\x0a\x20\x20.markdown-body\x20h6\x20{\x0a\x20\x20\x20\x20font-size:\x20.85em;\x0a\x20\x20\x20\x20color:\x20#6a737d;\x20}\x0a\x20\x20.markdown-body\x20ul,\x0a\x20\x20.markdown-body\x20ol\x20{\x0a\x20\x20\x20\x20padding-left:\x202em;\x20}\x0a\x20\x20.markdown-body\x20ul.field-names\x20{\x0a\x20\x20\x20\x20margin:\x200;\x0a\x20\x20\x20\x20padding:\x200;\x0a\x20\x20\x20\x20list-style:\x20none;\x20}\x0a\x20\x20\x20\x20.markdown-body\x20ul.field-names\x20span.field-name\x20{\x0a\x20\x20\x20\x20\x20\x20font-weight:\x20500;\x20}\x0a\x20\x20.markdown-body\x20ul.no-list,\x0a\x20\x20.markdown-body\x20ol.no-list\x20{\x0a\x20\x20\x20\x20padding:\x200;\x0a\x20\x20\x20\x20list-style-type:\x20none;\x20}\x0a\x20\x20.markdown-body\x20ul\x20ul,\x0a\x20\x20.markdown-body\x20ul\x20ol,\x0a\x20\x20.markdown-body\x20ol\x20ol,\x0a\x20\x20.markdown-body\x20ol\x20ul\x20{\x0a\x20\x20\x20\x20margin-top:\x200;\x0a\x20\x20\x20\x20margin-bottom:\x200;\x20}\x0a\x20\x20.markdown-body\x20li\x20{\x0a\x20\x20\x20\x20word-wrap:\x20break-all;\x20}\x0a\x20\x20.markdown-body\x20li\x20>\x20p\x20{\x0a\x20\x20\x20\x20margin-top:\x2016px;\x20}\x0a\x20\x20.markdown-body\x20li\x20+\x20li\x20{\x0a\x20\x20\x20\x20margin-top:\x20.25em;\x20}\x0a\x20\x20.markdown-body\x20dl\x20{\x0a\x20\x20\x20\x20padding:\x200;\x20}\x0a\x20\x20.markdown-body\x20dl\x20dt\x20{\x0a\x20\x20\x20\x20padding:\x200;\x0a\x20\x20\x20\x20margin-top:\x2016px;\x0a\x20\x20\x20\x20font-size:\x201em;\x0a\x20\x20\x20\x20font-style:\x20italic;\x0a\x20\x20\x20\x20font-weight:\x20600;\x20}\x0a\x20\x20.markdown-body\x20dl\x20dd\x20{\x0a\x20\x20\x20\x20padding:\x200\x2016px;\x0a\x20\x20\x20\x20margin-bottom:\x2016px;\x20}\x0a\x20\x20.markdown-body\x20img\x20{\x0a\x20\x20\x20\x20max-width:\x20100%;\x0a\x20\x20\x20\x20box-sizing:\x20content-box;\x0a\x20\x20\x20\x20background-color:\x20#fff;\x20}\x0a\x20\x20.markdown-body\x20img[align=right]\x20{\x0a\x20\x20\x20\x20padding-left:\x2020px;\x20}\x0a\x20\x20.markdown-body\x20img[align=left]\x20{\x0a\x20\x20\x20\x20padding-right:\x2020px;\x20}\x0a\x20\x20.markdown-body\x20.emoji\x20{\x0a\x20\x20\x20\x20max-width:\x20none;\x0a\x20\x20\x20\x20vertical-align:\x20text-top;\x0a\x20\x20\x20\x20background-color:\x20transparent;\x20}\x0a\x20\x20.markdown-body\x20span.frame\x20{\x0a\x20\x20\x20\x20display:\x20block;\x0a\x20\x20\x20\x20overflow:\x20hidden;\x20}\x0a\x20\x20.markdown-body\x20span.frame\x20>\x20span\x20{\x0a\x20\x20\x20\x20display:\x20block;\x0a\x20\x20\x20\x20float:\x20left;\x0a\x20\x20\x20\x20width:\x20auto;\x0a\x20\x20\x20\x20padding:\x207px;\x0a\x20\x20\x20\x20margin:\x2013px\x200\x200;\x0a\x20\x20\x20\x20overflow:\x20hidden;\x0a\x20\x20\x20\x20border:\x201px\x20solid\x20#dfe2e5;\x20}\x0a\x20\x20.markdown-body\x20span.frame\x20span\x20img\x20{\x0a\x20\x20\x20\x20display:\x20block;\x0a\x20\x20\x20\x20float:\x20left;\x20}\x0a\x20\x20.markdown-body\x20span.frame\x20span\x20span\x20{\x0a\x20\x20\x20\x20display:\x20block;\x0a\x20\x20\x20\x20padding:\x205px\x200\x200;\x0a\x20\x20\x20\x20clear:\x20both;\x0a\x20\x20\x20\x20color:\x20#24292e;\x20}\x0a\x20\x20.markdown-body\x20span.align-center\x20{\x0a\x20\x20\x20\x20display:\x20block;\x0a\x20\x20\x20\x20overflow:\x20hidden;\x0a\x20\x20\x20\x20clear:\x20both;\x20}\x0a\x20\x20.markdown-body\x20span.align-center\x20>\x20span\x20{\x0a\x20\x20\x20\x20display:\x20block;\x0a\x20\x20\x20\x20margin:\x2013px\x20auto\x200;\x0a\x20\x20\x20\x20overflow:\x20hidden;\x0a\x20\x20\x20\x20text-align:\x20center;\x20}\x0a\x20\x20.markdown-body\x20span.align-center\x20span\x20img\x20{\x0a\x20\x20\x20\x20margin:\x200\x20auto;\x0a\x20\x20\x20\x20text-align:\x20center;\x20}\x0a\x20\x20.markdown-body\x20span.align-right\x20{\x0a\x20\x20\x20\x20display:\x20block;\x0a\x20\x20\x20\x20overflow:\x20hidden;\x0a\x20\x20\x20\x20clear:\x20both;\x20}\x0a\x20\x20.markdown-body\x20span.align-right\x20>\x20span\x20{\x0a\x20\x20\x20\x20display:\x20block;\x0a\x20\x20\x20\x20margin:\x2013px\x200\x200;\x0a\x20\x20\x20\x20overflow:\x20hidden;\x0a\x20\x20\x20\x20text-align:\x20right;\x20}\x0a\x20\x20.markdown-body\x20span.align-right\x20span\x20img\x20{\x0a\x20\x20\x20\x20margin:\x200;\x0a\x20\x20\x20\x20text-align:\x20right;\x20}\x0a\x20\x20.markdown-body\x20span.float-left\x20{\x0a\x20\x20\x20\x20display:\x20block;\x0a\x20\x20\x20\x20float:\x20left;\x0a\x20\x20\x20\x20margin-right:\x2013px;\x0a\x20\x20\x20\x20overflow:\x20hidden;\x20}\x0a\x20\x20.markdown-body\x20span.float-left\x20span\x20{\x0a\x20\x20\x20\x20margin:\x2013px\x200\x200;\x20}\x0a\x20\x20.markdown-body\x20span.float-right\x20{\x0a\x20\x20\x20\x20display:\x20block;\x0a\x20\x20\x20\x20float:\x20right;\x0a\x20\x20\x20\x20margin-left:\x2013px;\x0a\x20\x20\x20\x20overflow:\x20hidden;\x20}\x0a\x20\x20.markdown-body\x20span.float-right\x20>\x20span\x20{\x0a\x20\x20\x20\x20display:\x20block;\x0a\x20\x20\x20\x20margin:\x2013px\x20auto\x200;\x0a\x20\x20\x20\x20overflow:\x20hidden;\x0a\x20\x20\x20\x20text-align:\x20right;\x20}\x0a\x20\x20.markdown-body\x20code,\x0a\x20\x20.markdown-body\x20tt\x20{\x0a\x20\x20\x20\x20padding:\x20.2em\x20.4em;\x0a\x20\x20\x20\x20margin:\x200;\x0a\x20\x20\x20\x20font-size:\x2085%;\x0a\x20\x20\x20\x20background-color:\x20rgba(27,\x2031,\x2035,\x200.05);\x0a\x20\x20\x20\x20border-radius:\x206px;\x0a\x20\x20\x20\x20color:\x20inherit;\x20}\x0a\x20\x20.markdown-body\x20code\x20br,\x0a\x20\x20.markdown-body\x20tt\x20br\x20{\x0a\x20\x20\x20\x20display:\x20none;\x20}\x0a\x20\x20.markdown-body\x20del\x20code\x20{\x0a\x20\x20\x20\x20text-decoration:\x20inherit;\x20}\x0a\x20\x20.markdown-body\x20pre\x20{\x0a\x20\x20\x20\x20word-wrap:\x20normal;\x20}\x0a\x20\x20\x20\x20.markdown-body\x20pre\x20>\x20code\x20{\x0a\x20\x20\x20\x20\x20\x20padding:\x200;\x0a\x20\x20\x20\x20\x20\x20margin:\x200;\x0a\x20\x20\x20\x20\x20\x20font-size:\x20100%;\x0a\x20\x20\x20\x20\x20\x20word-break:\x20normal;\x0a\x20\x20\x20\x20\x20\x20white-space:\x20pre;\x0a\x20\x20\x20\x20\x20\x20background:\x20transparent;\x0a\x20\x20\x20\x20\x20\x20border:\x200;\x20}\x0a\x20\x20.markdown-body\x20.highlight\x20{\x0a\x20\x20\x20\x20margin-bottom:\x2016px;\x20}\x0a\x20\x20.markdown-body\x20.highlight\x20pre\x20{\x0a\x20\x20\x20\x20margin-bottom:\x200;\x0a\x20\x20\x20\x20word-break:\x20normal;\x20}\x0a\x20\x20.markdown-body\x20.highlight\x20pre,\x0a\x20\x20.markdown-body\x20pre\x20{\x0a\x20\x20\x20\x20padding:\x2016px;\x0a\x20\x20\x20\x20overflow:\x20auto;\x0a\x20\x20\x20\x20font-size:\x2085%;\x0a\x20\x20\x20\x20line-height:\x201.45;\x0a\x20\x20\x20\x20background-color:\x20#f6f8fa;\x0a\x20\x20\x20\x20border-radius:\x206px;\x20}\x0a\x20\x20.markdown-body\x20pre\x20code,\x0a\x20\x20.markdown-body\x20pre\x20tt\x20{\x0a\x20\x20\x20\x20display:\x20inline;\x0a\x20\x20\x20\x20max-width:\x20auto;\x0a\x20\x20\x20\x20padding:\x200;\x0a\x20\x20\x20\x20margin:\x200;\x0a\x20\x20\x20\x20overflow:\x20visible;\x0a\x20\x20\x20\x20line-height:\x20inherit;\x0a\x20\x20\x20\x20word-wrap:\x20normal;\x0a\x20\x20\x20\x20background-color:\x20transparent;\x0a\x20\x20\x20\x20border:\x200;\x20}\x0a\x20\x20.markdown-body\x20.csv-data\x20td,\x0a\x20\x20.markdown-body\x20.csv-data\x20th\x20{\x0a\x20\x20\x20\x20padding:\x205px;\x0a\x20\x20\x20\x20overflow:\x20hidden;\x0a\x20\x20\x20\x20font-size:\x2012px;\x0a\x20\x20\x20\x20line-height:\x201;\x0a\x20\x20\x20\x20text-align:\x20left;\x0a\x20\x20\x20\x20white-space:\x20nowrap;\x20}\x0a\x20\x20.markdown-body\x20.csv-data\x20.blob-num\x20{\x0a\x20\x20\x20\x20padding:\x2010px\x208px\x209px;\x0a\x20\x20\x20\x20text-align:\x20right;\x0a\x20\x20\x20\x20background:\x20#fff;\x0a\x20\x20\x20\x20border:\x200;\x20}\x0a\x20\x20.markdown-body\x20.csv-data\x20tr\x20{\x0a\x20\x20\x20\x20border-top:\x200;\x20}\x0a\x20\x20.markdown-body\x20.csv-data\x20th\x20{\x0a\x20\x20\x20\x20font-weight:\x20600;\x0a\x20\x20\x20\x20background:\x20#f6f8fa;\x0a\x20\x20\x20\x20border-top:\x200;\x20}\x0a\x20\x20.markdown-body\x20summary\x20{\x0a\x20\x20\x20\x20outline:\x20none;\x20}\x0a\x20\x20.markdown-body\x20summary\x20h1,\x0a\x20\x20.markdown-body\x20summary\x20h2,\x0a\x20\x20.markdown-body\x20summary\x20h3,\x0a\x20\x20.markdown-body\x20summary\x20h4,\x0a\x20\x20.markdown-body\x20summary\x20h5,\x0a\x20\x20.markdown-body\x20summary\x20h6\x20{\x0a\x20\x20\x20\x20display:\x20inline-block;\x0a\x20\x20\x20\x20margin-top:\x2010px;\x0a\x20\x20\x20\x20margin-bottom:\x2010px;\x20}\x0a\x20\x20.markdown-body\x20summary\x20h1\x20p,\x0a\x20\x20.markdown-body\x20summary\x20h2\x20p,\x0a\x20\x20.markdown-body\x20summary\x20h3\x20p,\x0a\x20\x20.markdown-body\x20summary\x20h4\x20p,\x0a\x20\x20.markdown-body\x20summary\x20h5\x20p,\x0a\x20\x20.markdown-body\x20summary\x20h6\x20p\x20{\x0a\x20\x20\x20\x20margin:\x200;\x0a\x20\x20\x20\x20padding:\x200;\x20}\x0a\x20\x20.markdown-body\x20.highlight\x20pre,\x0a\x20\x20.markdown-body\x20pre\x20{\x0a\x20\x20\x20\x20margin-top:\x2010px;\x20}\x0a\x20\x20.markdown-body\x20.height-constrained-code-block\x20pre\x20{\x0a\x20\x20\x20\x20max-height:\x20500px;\x0a\x20\x20\x20\x20overflow:\x20auto;\x20}\x0a\x20\x20.markdown-body\x20.breadcrumbs\x20a:not(:last-child)::after\x20{\x0a\x20\x20\x20\x20content:\x20\"/\";\x0a\x20\x20\x20\x20color:\x20#959da5;\x0a\x20\x20\x20\x20padding-right:\x204px;\x0a\x20\x20\x20\x20padding-left:\x208px;\x0a\x20\x20\x20\x20display:\x20inline-block;\x20}\x0a\x20\x20.markdown-body\x20ol\x20{\x0a\x20\x20\x20\x20counter-reset:\x20li;\x0a\x20\x20\x20\x20list-style:\x20none;\x0a\x20\x20\x20\x20position:\x20relative;\x0a\x20\x20\x20\x20padding-bottom:\x2010px;\x0a\x20\x20\x20\x20padding-left:\x200;\x20}\x0a\x20\x20.markdown-body\x20ol\x20>\x20li\x20{\x0a\x20\x20\x20\x20padding:\x2015px\x200\x2015px\x2055px;\x0a\x20\x20\x20\x20position:\x20relative;\x0a\x20\x20\x20\x20margin-bottom:\x205px;\x0a\x20\x20\x20\x20border-top:\x203px\x20solid\x20#eee;\x20}\x0a\x20\x20.markdown-body\x20ol\x20>\x20li:before\x20{\x0a\x20\x20\x20\x20content:\x20counter(li);\x0a\x20\x20\x20\x20counter-increment:\x20li;\x0a\x20\x20\x20\x20position:\x20absolute;\x0a\x20\x20\x20\x20top:\x2010px;\x0a\x20\x20\x20\x20left:\x200;\x0a\x20\x20\x20\x20height:\x20100%;\x0a\x20\x20\x20\x20width:\x2030px;\x0a\x20\x20\x20\x20padding:\x200\x2010px\x200\x200;\x0a\x20\x20\x20\x20color:\x20#999;\x0a\x20\x20\x20\x20font-size:\x2022px;\x0a\x20\x20\x20\x20font-weight:\x20bold;\x0a\x20\x20\x20\x20line-height:\x2035px;\x0a\x20\x20\x20\x20text-align:\x20right;\x20}\x0a\x20\x20.markdown-body\x20ol\x20>\x20li\x20>\x20p\x20{\x0a\x20\x20\x20\x20margin:\x200;\x20}\x0a\x20\x20.markdown-body\x20ol\x20>\x20li\x20>\x20p:first-child\x20{\x0a\x20\x20\x20\x20margin-top:\x200;\x20}\x0a\x20\x20.markdown-body\x20ol\x20>\x20li:after\x20{\x0a\x20\x20\x20\x20content:\x20\".\";\x0a\x20\x20\x20\x20display:\x20block;\x0a\x20\x20\x20\x20clear:\x20both;\x0a\x20\x20\x20\x20visibility:\x20hidden;\x0a\x20\x20\x20\x20line-height:\x200;\x0a\x20\x20\x20\x20height:\x200;\x20}\x0a\x20\x20.markdown-body\x20h1,\x0a\x20\x20.markdown-body\x20h2,\x0a\x20\x20.markdown-body\x20h3,\x0a\x20\x20.markdown-body\x20h4,\x0a\x20\x20.markdown-body\x20h5,\x0a\x20\x20.markdown-body\x20h6\x20{\x0a\x20\x20\x20\x20font-family:\x20Inter,\x20-apple-system,\x20BlinkMacSystemFont,\x20\"Segoe\x20UI\",\x20Helvetica,\x20Arial,\x20sans-serif,\x20\"Apple\x20Color\x20Emoji\",\x20\"Segoe\x20UI\x20Emoji\",\x20\"Segoe\x20UI\x20Symbol\";\x0a\x20\x20\x20\x20font-weight:\x20500;\x0a\x20\x20\x20\x20padding-top:\x2016px;\x20}\x0a\x20\x20.markdown-body\x20ol\x20>\x20li\x20{\x0a\x20\x20\x20\x20padding:\x208px\x200\x208px\x2048px;\x0a\x20\x20\x20\x20border:\x200;\x20}\x0a\x20\x20.markdown-body\x20ol\x20>\x20li:before\x20{\x0a\x20\x20\x20\x20top:\x202px;\x0a\x20\x20\x20\x20font-size:\x2016px;\x0a\x20\x20\x20\x20width:\x2024px;\x20}\x0a\x20\x20.markdown-body\x20ol\x20>\x20li\x20p:not(:first-child)\x20{\x0a\x20\x20\x20\x20margin-top:\x2015px;\x20}\x0a\x20\x20.markdown-body\x20ol\x20>\x20li\x20.extended-markdown\x20{\x0a\x20\x20\x20\x20margin-top:\x2015px;\x20}\x0a\x20\x20.markdown-body\x20ul\x20ul,\x0a\x20\x20.markdown-body\x20ul\x20ol,\x0a\x20\x20.markdown-body\x20ol\x20ol,\x0a\x20\x20.markdown-body\x20ol\x20ul\x20{\x0a\x20\x20\x20\x20margin-top:\x2015px;\x0a\x20\x20\x20\x20margin-bottom:\x2015px;\x20}\x0a\x20\x20.markdown-body\x20.lead-mktg\x20p\x20{\x0a\x20\x20\x20\x20color:\x20#24292e;\x20}\x0a\x20\x20.markdown-body\x20table\x20{\x0a\x20\x20\x20\x20display:\x20block;\x0a\x20\x20\x20\x20width:\x20100%;\x0a\x20\x20\x20\x20width:\x20max-content;\x0a\x20\x20\x20\x20max-width:\x20100%;\x0a\x20\x20\x20\x20overflow:\x20auto;\x20}\x0a\x20\x20.markdown-body\x20table\x20th\x20{\x0a\x20\x20\x20\x20font-weight:\x20600;\x20}\x0a\x20\x20.markdown-body\x20table\x20th,\x0a\x20\x20.markdown-body\x20table\x20td\x20{\x0a\x20\x20\x20\x20padding:\x206px\x2013px;\x0a\x20\x20\x20\x20border:\x201px\x20solid\x20#dfe2e5;\x20}\x0a\x20\x20.markdown-body\x20table\x20tr\x20{\x0a\x20\x20\x20\x20background-color:\x20#fff;\x0a\x20\x20\x20\x20border-top:\x201px\x20solid\x20#c6cbd1;\x20}\x0a\x20\x20.markdown-body\x20table\x20tr:nth-child(2n)\x20{\x0a\x20\x20\x20\x20background-color:\x20#f6f8fa;\x20}\x0a\x20\x20.markdown-body\x20table\x20img\x20{\x0a\x20\x20\x20\x20background-color:\x20transparent;\x20}\x0a\x20\x20.markdown-body\x20table\x20{\x0a\x20\x20\x20\x20display:\x20table;\x0a\x20\x20\x20\x20table-layout:\x20fixed;\x0a\x20\x20\x20\x20line-height:\x201.5;\x20}\x0a\x20\x20.markdown-body\x20div.featured-links\x20{\x0a\x20\x20\x20\x20padding-bottom:\x2030px;\x20}\x0a\x20\x20.markdown-body\x20div.featured-links-heading\x20{\x0a\x20\x20\x20\x20padding-top:\x2024px;\x20}\x0a\x20\x20.markdown-body\x20div.featured-links\x20p.link-with-intro-intro\x20{\x0a\x20\x20\x20\x20margin-bottom:\x205px;\x20}\x0a\x20\x20.markdown-body\x20div.featured-links\x20h4.link-with-intro-title\x20{\x0a\x20\x20\x20\x20margin-top:\x200;\x20}\x0a\x20\x20.markdown-body\x20.bg-blue-light\x20blockquote\x20{\x0a\x20\x20\x20\x20color:\x20#586069;\x20}\x0a\x20\x20.markdown-body\x20table\x20{\x0a\x20\x20\x20\x20border-collapse:\x20collapse;\x0a\x20\x20\x20\x20position:\x20relative;\x0a\x20\x20\x20\x20font-size:\x2090%;\x0a\x20\x20\x20\x20table-layout:\x20auto;\x20}\x0a\x20\x20.markdown-body\x20table\x20code\x20{\x0a\x20\x20\x20\x20font-size:\x20100%;\x0a\x20\x20\x20\x20background:\x20none;\x0a\x20\x20\x20\x20padding:\x200;\x0a\x20\x20\x20\x20font-weight:\x20bold;\x20}\x0a\x20\x20.markdown-body\x20table\x20thead\x20tr\x20{\x0a\x20\x20\x20\x20border:\x20none;\x20}\x0a\x20\x20.markdown-body\x20table\x20th\x20{\x0a\x20\x20\x20\x20font-weight:\x20normal;\x0a\x20\x20\x20\x20text-align:\x20left;\x0a\x20\x20\x20\x20position:\x20sticky;\x0a\x20\x20\x20\x20vertical-align:\x20top;\x0a\x20\x20\x20\x20z-index:\x201;\x0a\x20\x20\x20\x20top:\x200;\x0a\x20\x20\x20\x20background:\x20#fff;\x0a\x20\x20\x20\x20box-shadow:\x200\x203px\x200\x200\x20#959da5;\x0a\x20\x20\x20\x20padding:\x2012px\x208px;\x0a\x20\x20\x20\x20border:\x200px;\x20}\x0a\x20\x20.markdown-body\x20table\x20th:first-child,\x0a\x20\x20.markdown-body\x20table\x20td:first-child\x20{\x0a\x20\x20\x20\x20padding-left:\x200;\x20}\x0a\x20\x20.markdown-body\x20table\x20td\x20{\x0a\x20\x20\x20\x20padding:\x2010px\x208px;\x0a\x20\x20\x20\x20border:\x200px;\x0a\x20\x20\x20\x20vertical-align:\x20top;\x20}\x0a\x20\x20.markdown-body\x20table\x20td\x20p\x20{\x0a\x20\x20\x20\x20padding:\x200;\x0a\x20\x20\x20\x20margin:\x200;\x20}\x0a\x20\x20.markdown-body\x20table\x20tr:nth-child(2n)\x20{\x0a\x20\x20\x20\x20background:\x20none;\x20}\x0a\x20\x20.markdown-body\x20table\x20td.has-nested-table\x20{\x0a\x20\x20\x20\x20width:\x20100%;\x20}\x0a\x20\x20.markdown-body\x20table\x20td.has-nested-table\x20table.slim\x20{\x0a\x20\x20\x20\x20table-layout:\x20auto;\x20}\x0a\x0a.marker\x20{\x0a\x20\x20min-height:\x2017px;\x0a\x20\x20margin:\x2010px\x200\x2016px;\x0a\x20\x20padding:\x2016px;\x0a\x20\x20overflow:\x20auto;\x0a\x20\x20font-size:\x2090%;\x0a\x20\x20line-height:\x201.45;\x0a\x20\x20color:\x20#586069;\x0a\x20\x20background-color:\x20#f6f8fa;\x0a\x20\x20border:\x201px\x20solid\x20#e1e4e8;\x0a\x20\x20border-radius:\x206px;\x20}\x0a\x20\x20.marker::before\x20{\x0a\x20\x20\x20\x20content:\x20\"\";\x0a\x20\x20\x20\x20display:\x20block;\x0a\x20\x20\x20\x20float:\x20left;\x0a\x20\x20\x20\x20width:\x2014px;\x0a\x20\x20\x20\x20height:\x2014px;\x0a\x20\x20\x20\x20margin:\x203px\x205px\x200\x200;\x20}\x0a\x20\x20.marker\x20>\x20*:last-child\x20{\x0a\x20\x20\x20\x20margin-bottom:\x200;\x20}\x0a\x20\x20.marker.marker-ignore\x20{\x0a\x20\x20\x20\x20display:\x20none\x20!important;\x20}\x0a\x20\x20.marker.marker-note\x20{\x0a\x20\x20\x20\x20border-color:\x20#0366d6\x20!important;\x0a\x20\x20\x20\x20background-color:\x20#f1f8ff\x20!important;\x20}\x0a\x20\x20\x20\x20.marker.marker-note::before\x20{\x0a\x20\x20\x20\x20\x20\x20background-image:\x20url('data:image/svg+xml,<svg\x20width=\"1em\"\x20height=\"1em\"\x20viewBox=\"0\x200\x2016\x2016\"\x20class=\"bi\x20bi-question-circle\"\x20fill=\"%230366d6\"\x20xmlns=\"http://www.w3.org/2000/svg\"><path\x20fill-rule=\"evenodd\"\x20d=\"M8\x2015A7\x207\x200\x201\x200\x208\x201a7\x207\x200\x200\x200\x200\x2014zm0\x201A8\x208\x200\x201\x200\x208\x200a8\x208\x200\x200\x200\x200\x2016z\"/><path\x20d=\"M5.255\x205.786a.237.237\x200\x200\x200\x20.241.247h.825c.138\x200\x20.248-.113.266-.25.09-.656.54-1.134\x201.342-1.134.686\x200\x201.314.343\x201.314\x201.168\x200\x20.635-.374.927-.965\x201.371-.673.489-1.206\x201.06-1.168\x201.987l.003.217a.25.25\x200\x200\x200\x20.25.246h.811a.25.25\x200\x200\x200\x20.25-.25v-.105c0-.718.273-.927\x201.01-1.486.609-.463\x201.244-.977\x201.244-2.056\x200-1.511-1.276-2.241-2.673-2.241-1.267\x200-2.655.59-2.75\x202.286zm1.557\x205.763c0\x20.533.425.927\x201.01.927.609\x200\x201.028-.394\x201.028-.927\x200-.552-.42-.94-1.029-.94-.584\x200-1.009.388-1.009.94z\"/></svg>');\x0a\x20\x20\x20\x20\x20\x20background-repeat:\x20no-repeat;\x0a\x20\x20\x20\x20\x20\x20background-position:\x20center;\x0a\x20\x20\x20\x20\x20\x20background-size:\x2014px;\x20}\x0a",
+	"style.css": "body\x20{\x0a\x20\x20display:\x20flex\x20!important;\x0a\x20\x20margin:\x200;\x0a\x20\x20font-family:\x20-apple-system,BlinkMacSystemFont,\"Segoe\x20UI\",Helvetica,Arial,sans-serif,\"Apple\x20Color\x20Emoji\",\"Segoe\x20UI\x20Emoji\";\x0a\x20\x20font-size:\x2014px;\x0a\x20\x20line-height:\x201.5;\x0a\x20\x20color:\x20#24292e;\x0a\x20\x20background-color:\x20#fff;\x20}\x0a\x0a.collapsing\x20{\x0a\x20\x20position:\x20relative;\x0a\x20\x20height:\x200;\x0a\x20\x20overflow:\x20hidden;\x0a\x20\x20-webkit-transition:\x20height\x20.05s\x20ease;\x0a\x20\x20-o-transition:\x20height\x20.05s\x20ease;\x0a\x20\x20transition:\x20height\x20.05s\x20ease;\x20}\x0a\x0a.table-responsive\x20.table\x20{\x0a\x20\x20margin-bottom:\x200;\x20}\x0a\x0a.table-hover\x20tbody\x20tr:hover\x20{\x0a\x20\x20background-color:\x20rgba(0,\x200,\x200,\x200.025);\x20}\x0a\x0a.callout\x20{\x0a\x20\x20padding:\x201.25rem;\x0a\x20\x20margin-top:\x201.25rem;\x0a\x20\x20margin-bottom:\x201.25rem;\x0a\x20\x20border:\x201px\x20solid\x20#eee;\x0a\x20\x20border-left-width:\x20.25rem;\x0a\x20\x20border-radius:\x20.25rem;\x20}\x0a\x20\x20.callout\x20h4\x20{\x0a\x20\x20\x20\x20margin-top:\x200;\x0a\x20\x20\x20\x20margin-bottom:\x20.25rem;\x20}\x0a\x20\x20.callout\x20p:last-child\x20{\x0a\x20\x20\x20\x20margin-bottom:\x200;\x20}\x0a\x20\x20.callout\x20code\x20{\x0a\x20\x20\x20\x20border-radius:\x20.25rem;\x20}\x0a\x20\x20.callout\x20+\x20.callout\x20{\x0a\x20\x20\x20\x20margin-top:\x20-.25rem;\x20}\x0a\x20\x20.callout\x20>\x20*:last-child\x20{\x0a\x20\x20\x20\x20margin-bottom:\x200;\x20}\x0a\x20\x20.callout.callout-info\x20{\x0a\x20\x20\x20\x20border-left-color:\x20\"#5bc0de\";\x20}\x0a\x20\x20\x20\x20.callout.callout-info\x20h4\x20{\x0a\x20\x20\x20\x20\x20\x20color:\x20\"#5bc0de\";\x20}\x0a\x20\x20.callout.callout-warning\x20{\x0a\x20\x20\x20\x20border-left-color:\x20\"#f0ad4e\";\x20}\x0a\x20\x20\x20\x20.callout.callout-warning\x20h4\x20{\x0a\x20\x20\x20\x20\x20\x20color:\x20\"#f0ad4e\";\x20}\x0a\x20\x20.callout.callout-danger\x20{\x0a\x20\x20\x20\x20border-left-color:\x20\"#d9534f\";\x20}\x0a\x20\x20\x20\x20.callout.callout-danger\x20h4\x20{\x0a\x20\x20\x20\x20\x20\x20color:\x20\"#d9534f\";\x20}\x0a\x0atextarea\x20{\x0a\x20\x20/*\x20Inherit\x20text\x20color\x20from\x20body\x20avoiding\x20illegible\x20text\x20in\x20the\x20case\x20where\x20the\x0a\x20\x09*\x20user\x20has\x20inverted\x20the\x20browsers\x20custom\x20text\x20and\x20background\x20colors.\x20*/\x0a\x20\x20color:\x20inherit;\x20}\x0a\x0apre\x20{\x0a\x20\x20background:\x20#efefef;\x0a\x20\x20padding:\x200.625rem;\x0a\x20\x20border-radius:\x200.3125rem;\x20}\x0a\x0apre,\x0acode\x20{\x0a\x20\x20font-family:\x20Menlo,\x20monospace;\x0a\x20\x20font-size:\x200.875rem;\x20}\x0a\x0apre\x20{\x0a\x20\x20line-height:\x201.4;\x0a\x20\x20overflow-x:\x20auto;\x20}\x0a\x0apre\x20.comment\x20{\x0a\x20\x20color:\x20#006600;\x20}\x0a\x0apre\x20.highlight,\x0apre\x20.highlight-comment,\x0apre\x20.selection-highlight,\x0apre\x20.selection-highlight-comment\x20{\x0a\x20\x20background:\x20#FFFF00;\x20}\x0a\x0apre\x20.selection,\x0apre\x20.selection-comment\x20{\x0a\x20\x20background:\x20#FF9632;\x20}\x0a\x0apre\x20.ln\x20{\x0a\x20\x20color:\x20#999;\x0a\x20\x20background:\x20#efefef;\x20}\x0a\x0a.ln\x20{\x0a\x20\x20-webkit-user-select:\x20none;\x0a\x20\x20-moz-user-select:\x20none;\x0a\x20\x20-ms-user-select:\x20none;\x0a\x20\x20user-select:\x20none;\x0a\x20\x20/*\x20Ensure\x208\x20characters\x20in\x20the\x20document\x20-\x20which\x20due\x20to\x20floating\x0a\x20\x20\x20*\x20point\x20rendering\x20issues,\x20might\x20have\x20a\x20width\x20of\x20less\x20than\x201\x20each\x20-\x20are\x208\x0a\x20\x20\x20*\x20characters\x20wide,\x20so\x20a\x20tab\x20in\x20the\x209th\x20position\x20indents\x20properly.\x20See\x0a\x20\x20\x20*\x20https://github.com/webcompat/web-bugs/issues/17530#issuecomment-402675091\x0a\x20\x20\x20*\x20for\x20more\x20information.\x20*/\x0a\x20\x20display:\x20inline-block;\x0a\x20\x20width:\x208ch;\x20}\x0a\x0a@media\x20print\x20{\x0a\x20\x20#sidebar\x20{\x0a\x20\x20\x20\x20display:\x20none\x20!important;\x20}\x0a\x20\x20#btn-printer\x20{\x0a\x20\x20\x20\x20display:\x20none\x20!important;\x20}\x20}\x0a\x0a#sidebar\x20{\x0a\x20\x20width:\x20280px;\x0a\x20\x20display:\x20block;\x0a\x20\x20background-color:\x20#05264c;\x0a\x20\x20color:\x20#FFF;\x0a\x20\x20position:\x20sticky;\x0a\x20\x20top:\x200;\x0a\x20\x20padding-bottom:\x2032px;\x0a\x20\x20overflow-y:\x20auto;\x0a\x20\x20height:\x20100vh;\x0a\x20\x20flex-shrink:\x200;\x20}\x0a\x20\x20#sidebar\x20.brand\x20{\x0a\x20\x20\x20\x20padding:\x2024px\x20!important;\x0a\x20\x20\x20\x20text-align:\x20center;\x20}\x0a\x20\x20\x20\x20#sidebar\x20.brand\x20a\x20{\x0a\x20\x20\x20\x20\x20\x20color:\x20#FFF;\x20}\x0a\x20\x20#sidebar\x20ul,\x0a\x20\x20#sidebar\x20li\x20{\x0a\x20\x20\x20\x20list-style:\x20none;\x0a\x20\x20\x20\x20margin:\x200;\x0a\x20\x20\x20\x20padding:\x200;\x20}\x0a\x20\x20#sidebar\x20a\x20{\x0a\x20\x20\x20\x20display:\x20inline-block;\x0a\x20\x20\x20\x20width:\x20100%;\x0a\x20\x20\x20\x20padding:\x204px\x201rem;\x0a\x20\x20\x20\x20line-height:\x201.4;\x0a\x20\x20\x20\x20color:\x20#c8e1ff;\x0a\x20\x20\x20\x20overflow:\x20hidden;\x0a\x20\x20\x20\x20text-overflow:\x20ellipsis;\x0a\x20\x20\x20\x20white-space:\x20nowrap;\x20}\x0a\x20\x20\x20\x20#sidebar\x20a::before\x20{\x0a\x20\x20\x20\x20\x20\x20content:\x20\"\";\x0a\x20\x20\x20\x20\x20\x20display:\x20inline-block;\x0a\x20\x20\x20\x20\x20\x20width:\x2012px;\x0a\x20\x20\x20\x20\x20\x20height:\x2012px;\x0a\x20\x20\x20\x20\x20\x20vertical-align:\x20middle;\x0a\x20\x20\x20\x20\x20\x20margin-right:\x200.2rem;\x0a\x20\x20\x20\x20\x20\x20background-repeat:\x20no-repeat;\x0a\x20\x20\x20\x20\x20\x20background-position:\x20center;\x0a\x20\x20\x20\x20\x20\x20background-size:\x2012px;\x0a\x20\x20\x20\x20\x20\x20background-color:\x20transparent;\x20}\x0a\x20\x20\x20\x20#sidebar\x20a:hover\x20{\x0a\x20\x20\x20\x20\x20\x20color:\x20#79b8ff;\x0a\x20\x20\x20\x20\x20\x20text-decoration:\x20none;\x0a\x20\x20\x20\x20\x20\x20font-weight:\x20normal;\x20}\x0a\x20\x20\x20\x20#sidebar\x20a.current\x20{\x0a\x20\x20\x20\x20\x20\x20font-weight:\x20500\x20!important;\x0a\x20\x20\x20\x20\x20\x20color:\x20#79b8ff;\x0a\x20\x20\x20\x20\x20\x20background-color:\x20#032f62;\x20}\x0a\x20\x20#sidebar\x20.sphinxsidebar\x20{\x0a\x20\x20\x20\x20overflow-y:\x20auto;\x20}\x0a\x20\x20#sidebar\x20.search-box\x20{\x0a\x20\x20\x20\x20position:\x20relative;\x0a\x20\x20\x20\x20padding:\x200\x201rem\x201rem;\x20}\x0a\x20\x20\x20\x20#sidebar\x20.search-box\x20input\x20{\x0a\x20\x20\x20\x20\x20\x20color:\x20#FFF;\x0a\x20\x20\x20\x20\x20\x20background-color:\x20#032f62;\x0a\x20\x20\x20\x20\x20\x20border-color:\x20#032f62;\x20}\x0a\x20\x20\x20\x20\x20\x20#sidebar\x20.search-box\x20input::placeholder\x20{\x0a\x20\x20\x20\x20\x20\x20\x20\x20color:\x20#79b8ff;\x20}\x0a\x20\x20\x20\x20#sidebar\x20.search-box\x20.search-dropdown\x20{\x0a\x20\x20\x20\x20\x20\x20display:\x20none;\x0a\x20\x20\x20\x20\x20\x20position:\x20absolute;\x0a\x20\x20\x20\x20\x20\x20z-index:\x2010;\x0a\x20\x20\x20\x20\x20\x20left:\x201rem;\x0a\x20\x20\x20\x20\x20\x20right:\x201rem;\x0a\x20\x20\x20\x20\x20\x20max-height:\x2060vh;\x0a\x20\x20\x20\x20\x20\x20overflow-y:\x20auto;\x0a\x20\x20\x20\x20\x20\x20background-color:\x20#032f62;\x0a\x20\x20\x20\x20\x20\x20border-radius:\x20.2rem;\x0a\x20\x20\x20\x20\x20\x20box-shadow:\x200\x204px\x2012px\x20rgba(0,\x200,\x200,\x200.3);\x20}\x0a\x20\x20\x20\x20\x20\x20#sidebar\x20.search-box\x20.search-dropdown.show\x20{\x0a\x20\x20\x20\x20\x20\x20\x20\x20display:\x20block;\x20}\x0a\x20\x20\x20\x20\x20\x20#sidebar\x20.search-box\x20.search-dropdown\x20a\x20{\x0a\x20\x20\x20\x20\x20\x20\x20\x20padding:\x204px\x20.5rem;\x20}\x0a\x20\x20\x20\x20\x20\x20\x20\x20#sidebar\x20.search-box\x20.search-dropdown\x20a::before\x20{\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20display:\x20none;\x20}\x0a\x20\x20\x20\x20\x20\x20\x20\x20#sidebar\x20.search-box\x20.search-dropdown\x20a\x20small\x20{\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20display:\x20block;\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20color:\x20#79b8ff;\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20overflow:\x20hidden;\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20text-overflow:\x20ellipsis;\x20}\x0a\x20\x20\x20\x20\x20\x20#sidebar\x20.search-box\x20.search-dropdown\x20.search-dropdown-kind\x20{\x0a\x20\x20\x20\x20\x20\x20\x20\x20margin-right:\x204px;\x0a\x20\x20\x20\x20\x20\x20\x20\x20font-size:\x2075%;\x0a\x20\x20\x20\x20\x20\x20\x20\x20color:\x20#79b8ff;\x20}\x0a\x20\x20#sidebar\x20.reference\x20{\x0a\x20\x20\x20\x20width:\x20100%;\x0a\x20\x20\x20\x20position:\x20relative;\x20}\x0a\x20\x20\x20\x20#sidebar\x20.reference.reference-package\x20a\x20{\x0a\x20\x20\x20\x20\x20\x20font-size:\x2016px;\x0a\x20\x20\x20\x20\x20\x20padding-left:\x200.5rem;\x20}\x0a\x20\x20\x20\x20\x20\x20#sidebar\x20.reference.reference-package\x20a::before\x20{\x0a\x20\x20\x20\x20\x20\x20\x20\x20background-image:\x20url(\"data:image/svg+xml,<svg\x20width='1em'\x20height='1em'\x20viewBox='0\x200\x2016\x2016'\x20class='bi\x20bi-folder'\x20fill='%23c8e1ff'\x20xmlns='http://www.w3.org/2000/svg'><path\x20d='M9.828\x204a3\x203\x200\x200\x201-2.12-.879l-.83-.828A1\x201\x200\x200\x200\x206.173\x202H2.5a1\x201\x200\x200\x200-1\x20.981L1.546\x204h-1L.5\x203a2\x202\x200\x200\x201\x202-2h3.672a2\x202\x200\x200\x201\x201.414.586l.828.828A2\x202\x200\x200\x200\x209.828\x203v1z'/><path\x20fill-rule='evenodd'\x20d='M13.81\x204H2.19a1\x201\x200\x200\x200-.996\x201.09l.637\x207a1\x201\x200\x200\x200\x20.995.91h10.348a1\x201\x200\x200\x200\x20.995-.91l.637-7A1\x201\x200\x200\x200\x2013.81\x204zM2.19\x203A2\x202\x200\x200\x200\x20.198\x205.181l.637\x207A2\x202\x200\x200\x200\x202.826\x2014h10.348a2\x202\x200\x200\x200\x201.991-1.819l.637-7A2\x202\x200\x200\x200\x2013.81\x203H2.19z'/></svg>\");\x20}\x0a\x20\x20\x20\x20#sidebar\x20.reference.reference-type\x20a\x20{\x0a\x20\x20\x20\x20\x20\x20padding-left:\x201.2rem;\x20}\x0a\x20\x20\x20\x20\x20\x20#sidebar\x20.reference.reference-type\x20a::before\x20{\x0a\x20\x20\x20\x20\x20\x20\x20\x20background-image:\x20url(\"data:image/svg+xml,<svg\x20width='1em'\x20height='1em'\x20viewBox='0\x200\x2016\x2016'\x20class='bi\x20bi-bezier'\x20fill='%23c8e1ff'\x20xmlns='http://www.w3.org/2000/svg'><path\x20d='M0,9.5\x20C-1.01453063e-16,8.67157288\x200.671572875,8\x201.5,8\x20L4.5,8\x20C4.89782473,8\x205.2793556,8.15803526\x205.56066017,8.43933983\x20C5.84196474,8.7206444\x206,9.10217527\x206,9.5\x20L6,12.5\x20C6,13.3284271\x205.32842712,14\x204.5,14\x20L1.5,14\x20C0.671572875,14\x201.01453063e-16,13.3284271\x200,12.5\x20L0,9.5\x20Z\x20M1.5,9\x20C1.22385763,9\x201,9.22385763\x201,9.5\x20L1,12.5\x20C1,12.7761424\x201.22385763,13\x201.5,13\x20L4.5,13\x20C4.77614237,13\x205,12.7761424\x205,12.5\x20L5,9.5\x20C5,9.22385763\x204.77614237,9\x204.5,9\x20L1.5,9\x20Z\x20M10,9.5\x20C10,8.67157288\x2010.6715729,8\x2011.5,8\x20L14.5,8\x20C15.3284271,8\x2016,8.67157288\x2016,9.5\x20L16,12.5\x20C16,13.3284271\x2015.3284271,14\x2014.5,14\x20L11.5,14\x20C10.6715729,14\x2010,13.3284271\x2010,12.5\x20L10,9.5\x20Z\x20M11.5,9\x20C11.2238576,9\x2011,9.22385763\x2011,9.5\x20L11,12.5\x20C11,12.7761424\x2011.2238576,13\x2011.5,13\x20L14.5,13\x20C14.7761424,13\x2015,12.7761424\x2015,12.5\x20L15,9.5\x20C15,9.22385763\x2014.7761424,9\x2014.5,9\x20L11.5,9\x20Z\x20M0,1.5\x20C0,0.671572875\x200.671572875,0\x201.5,0\x20L14.5,0\x20C15.3284271,0\x2016,0.671572875\x2016,1.5\x20L16,4.5\x20C16,5.32842712\x2015.3284271,6\x2014.5,6\x20L1.5,6\x20C0.671572875,6\x200,5.32842712\x200,4.5\x20L0,1.5\x20Z\x20M1.5,1\x20C1.22385763,1\x201,1.22385763\x201,1.5\x20L1,4.5\x20C1,4.77614237\x201.22385763,5\x201.5,5\x20L14.5,5\x20C14.7761424,5\x2015,4.77614237\x2015,4.5\x20L15,1.5\x20C15,1.22385763\x2014.7761424,1\x2014.5,1\x20L1.5,1\x20Z'></path></svg>\");\x20}\x0a\x20\x20\x20\x20#sidebar\x20.reference.reference-func,\x20#sidebar\x20.reference.reference-method\x20{\x0a\x20\x20\x20\x20\x20\x20border-bottom:\x20none;\x20}\x0a\x20\x20\x20\x20\x20\x20#sidebar\x20.reference.reference-func\x20a,\x20#sidebar\x20.reference.reference-method\x20a\x20{\x0a\x20\x20\x20\x20\x20\x20\x20\x20padding-left:\x202rem;\x20}\x0a\x20\x20\x20\x20\x20\x20\x20\x20#sidebar\x20.reference.reference-func\x20a::before,\x20#sidebar\x20.reference.reference-method\x20a::before\x20{\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20background-image:\x20url(\"data:image/svg+xml,<svg\x20width='1em'\x20height='1em'\x20viewBox='0\x200\x2016\x2016'\x20class='bi\x20bi-box'\x20fill='%23c8e1ff'\x20xmlns='http://www.w3.org/2000/svg'><path\x20fill-rule='evenodd'\x20d='M8.186\x201.113a.5.5\x200\x200\x200-.372\x200L1.846\x203.5\x208\x205.961\x2014.154\x203.5\x208.186\x201.113zM15\x204.239l-6.5\x202.6v7.922l6.5-2.6V4.24zM7.5\x2014.762V6.838L1\x204.239v7.923l6.5\x202.6zM7.443.184a1.5\x201.5\x200\x200\x201\x201.114\x200l7.129\x202.852A.5.5\x200\x200\x201\x2016\x203.5v8.662a1\x201\x200\x200\x201-.629.928l-7.185\x202.874a.5.5\x200\x200\x201-.372\x200L.63\x2013.09a1\x201\x200\x200\x201-.63-.928V3.5a.5.5\x200\x200\x201\x20.314-.464L7.443.184z'/></svg>\");\x20}\x0a\x20\x20#sidebar\x20.expand-icon\x20{\x0a\x20\x20\x20\x20display:\x20inline-block;\x0a\x20\x20\x20\x20width:\x202.5rem;\x0a\x20\x20\x20\x20top:\x200;\x0a\x20\x20\x20\x20bottom:\x200;\x0a\x20\x20\x20\x20right:\x200;\x0a\x20\x20\x20\x20position:\x20absolute;\x0a\x20\x20\x20\x20background-image:\x20url(\"data:image/svg+xml,<svg\x20width='1em'\x20height='1em'\x20viewBox='0\x200\x2016\x2016'\x20class='bi\x20bi-chevron-down'\x20fill='%23c8e1ff'\x20xmlns='http://www.w3.org/2000/svg'><path\x20fill-rule='evenodd'\x20d='M1.646\x204.646a.5.5\x200\x200\x201\x20.708\x200L8\x2010.293l5.646-5.647a.5.5\x200\x200\x201\x20.708.708l-6\x206a.5.5\x200\x200\x201-.708\x200l-6-6a.5.5\x200\x200\x201\x200-.708z'/></svg>\");\x0a\x20\x20\x20\x20background-repeat:\x20no-repeat;\x0a\x20\x20\x20\x20background-position:\x20center;\x0a\x20\x20\x20\x20background-size:\x2012px;\x0a\x20\x20\x20\x20border-radius:\x203px;\x0a\x20\x20\x20\x20opacity:\x20.75;\x20}\x0a\x20\x20\x20\x20#sidebar\x20.expand-icon.collapsed\x20{\x0a\x20\x20\x20\x20\x20\x20background-image:\x20url(\"data:image/svg+xml,<svg\x20width='1em'\x20height='1em'\x20viewBox='0\x200\x2016\x2016'\x20class='bi\x20bi-chevron-right'\x20fill='%23c8e1ff'\x20xmlns='http://www.w3.org/2000/svg'><path\x20fill-rule='evenodd'\x20d='M4.646\x201.646a.5.5\x200\x200\x201\x20.708\x200l6\x206a.5.5\x200\x200\x201\x200\x20.708l-6\x206a.5.5\x200\x200\x201-.708-.708L10.293\x208\x204.646\x202.354a.5.5\x200\x200\x201\x200-.708z'/></svg>\");\x20}\x0a\x20\x20\x20\x20#sidebar\x20.expand-icon:focus\x20{\x0a\x20\x20\x20\x20\x20\x20outline:\x200;\x0a\x20\x20\x20\x20\x20\x20box-shadow:\x20none;\x0a\x20\x20\x20\x20\x20\x20border:\x202px\x20solid;\x20}\x0a\x0a#main-column\x20{\x0a\x20\x20width:\x20100%\x20!important;\x0a\x20\x20margin-top:\x2025px;\x0a\x20\x20padding-left:\x2025px;\x0a\x20\x20padding-right:\x2025px;\x20}\x0a\x0a#reload-error\x20{\x0a\x20\x20max-width:\x201280px;\x0a\x20\x20margin:\x200\x20auto\x2016px;\x0a\x20\x20white-space:\x20pre-wrap;\x0a\x20\x20font-family:\x20Menlo,\x20monospace;\x0a\x20\x20font-size:\x200.875rem;\x20}\x0a\x0a.package-error\x20pre\x20{\x0a\x20\x20margin:\x200;\x0a\x20\x20padding:\x200;\x0a\x20\x20border:\x20none;\x0a\x20\x20background:\x20none;\x0a\x20\x20white-space:\x20pre-wrap;\x20}\x0a\x0a.badge-since\x20{\x0a\x20\x20margin-left:\x20.5rem;\x0a\x20\x20font-size:\x20.75rem;\x0a\x20\x20font-weight:\x20normal;\x0a\x20\x20vertical-align:\x20middle;\x0a\x20\x20color:\x20#6a737d;\x0a\x20\x20border:\x201px\x20solid\x20#d1d5da;\x20}\x0a\x0a.bundle-section\x20+\x20.bundle-section\x20{\x0a\x20\x20margin-top:\x203rem;\x0a\x20\x20padding-top:\x202rem;\x0a\x20\x20border-top:\x201px\x20solid\x20#eee;\x20}\x0a\x0a.api-diff\x20pre\x20{\x0a\x20\x20margin:\x200;\x0a\x20\x20padding:\x20.25rem\x20.5rem;\x0a\x20\x20white-space:\x20pre-wrap;\x20}\x0a\x0a.api-diff\x20.api-diff-old\x20{\x0a\x20\x20background:\x20#ffeef0;\x20}\x0a\x0a.api-diff\x20.api-diff-new\x20{\x0a\x20\x20background:\x20#e6ffed;\x20}\x0a\x0a#footer\x20{\x0a\x20\x20margin-top:\x2050px;\x0a\x20\x20margin-bottom:\x2020px;\x0a\x20\x20text-align:\x20center;\x20}\x0a\x0a.permalink\x20{\x0a\x20\x20display:\x20none;\x20}\x0a\x0a:hover\x20>\x20.permalink\x20{\x0a\x20\x20display:\x20inline;\x20}\x0a\x0a#documentation\x20{\x0a\x20\x20max-width:\x201280px;\x0a\x20\x20margin-right:\x20auto;\x0a\x20\x20margin-left:\x20auto;\x0a\x20\x20position:\x20relative;\x20}\x0a\x20\x20#documentation\x20#btn-printer\x20{\x0a\x20\x20\x20\x20position:\x20absolute;\x0a\x20\x20\x20\x20right:\x200;\x0a\x20\x20\x20\x20top:\x2015px;\x0a\x20\x20\x20\x20color:\x20#24292e;\x20}\x0a\x20\x20\x20\x20#documentation\x20#btn-printer:hover\x20{\x0a\x20\x20\x20\x20\x20\x20color:\x20#007bff;\x20}\x0a\x0a.markdown-body\x20{\x0a\x20\x20font-family:\x20-apple-system,\x20BlinkMacSystemFont,\x20\"Segoe\x20UI\",\x20Helvetica,\x20Arial,\x20sans-serif,\x20\"Apple\x20Color\x20Emoji\",\x20\"Segoe\x20UI\x20Emoji\";\x0a\x20\x20font-size:\x2016px;\x0a\x20\x20line-height:\x201.5;\x0a\x20\x20word-wrap:\x20break-word;\x20}\x0a\x20\x20.markdown-body\x20kbd\x20{\x0a\x20\x20\x20\x20display:\x20inline-block;\x0a\x20\x20\x20\x20padding:\x203px\x205px;\x0a\x20\x20\x20\x20font:\x2011px\x20\"SFMono-Regular\",\x20Consolas,\x20\"Liberation\x20Mono\",\x20Menlo,\x20monospace;\x0a\x20\x20\x20\x20line-height:\x2010px;\x0a\x20\x20\x20\x20color:\x20#444d56;\x0a\x20\x20\x20\x20vertical-align:\x20middle;\x0a\x20\x20\x20\x20background-color:\x20#fafbfc;\x0a\x20\x20\x20\x20border:\x20solid\x201px\x20#d1d5da;\x0a\x20\x20\x20\x20border-bottom-color:\x20#d1d5da;\x0a\x20\x20\x20\x20border-radius:\x206px;\x0a\x20\x20\x20\x20box-shadow:\x20inset\x200\x20-1px\x200\x20#d1d5da;\x20}\x0a\x20\x20.markdown-body\x20>\x20::before\x20{\x0a\x20\x20\x20\x20display:\x20table;\x0a\x20\x20\x20\x20content:\x20\"\";\x20}\x0a\x20\x20.markdown-body\x20>\x20::after\x20{\x0a\x20\x20\x20\x20display:\x20table;\x0a\x20\x20\x20\x20clear:\x20both;\x0a\x20\x20\x20\x20content:\x20\"\";\x20}\x0a\x20\x20.markdown-body\x20>\x20*:first-child\x20{\x0a\x20\x20\x20\x20margin-top:\x200\x20!important;\x20}\x0a\x20\x20.markdown-body\x20>\x20*:last-child\x20{\x0a\x20\x20\x20\x20margin-bottom:\x200\x20!important;\x20}\x0a\x20\x20.markdown-body\x20a:not([href])\x20{\x0a\x20\x20\x20\x20color:\x20inherit;\x0a\x20\x20\x20\x20text-decoration:\x20none;\x20}\x0a\x20\x20.markdown-body\x20.absent\x20{\x0a\x20\x20\x20\x20color:\x20#cb2431;\x20}\x0a\x20\x20.markdown-body\x20.anchor\x20{\x0a\x20\x20\x20\x20float:\x20left;\x0a\x20\x20\x20\x20padding-right:\x204px;\x0a\x20\x20\x20\x20margin-left:\x20-20px;\x0a\x20\x20\x20\x20line-height:\x201;\x20}\x0a\x20\x20.markdown-body\x20.anchor:focus\x20{\x0a\x20\x20\x20\x20outline:\x20none;\x20}\x0a\x20\x20.markdown-body\x20p,\x0a\x20\x20.markdown-body\x20blockquote,\x0a\x20\x20.markdown-body\x20ul,\x0a\x20\x20.markdown-body\x20ol,\x0a\x20\x20.markdown-body\x20dl,\x0a\x20\x20.markdown-body\x20table,\x0a\x20\x20.markdown-body\x20pre,\x0a\x20\x20.markdown-body\x20details\x20{\x0a\x20\x20\x20\x20margin-top:\x200;\x0a\x20\x20\x20\x20margin-bottom:\x2016px;\x20}\x0a\x20\x20.markdown-body\x20hr\x20{\x0a\x20\x20\x20\x20height:\x20.25em;\x0a\x20\x20\x20\x20padding:\x200;\x0a\x20\x20\x20\x20margin:\x2024px\x200;\x0a\x20\x20\x20\x20background-color:\x20#e1e4e8;\x0a\x20\x20\x20\x20border:\x200;\x20}\x0a\x20\x20.markdown-body\x20blockquote\x20{\x0a\x20\x20\x20\x20padding:\x200\x201em;\x0a\x20\x20\x20\x20color:\x20#6a737d;\x0a\x20\x20\x20\x20border-left:\x20.25em\x20solid\x20#dfe2e5;\x20}\x0a\x20\x20.markdown-body\x20blockquote\x20>\x20:first-child\x20{\x0a\x20\x20\x20\x20margin-top:\x200;\x20}\x0a\x20\x20.markdown-body\x20blockquote\x20>\x20:last-child\x20{\x0a\x20\x20\x20\x20margin-bottom:\x200;\x20}\x0a\x20\x20.markdown-body\x20h1,\x0a\x20\x20.markdown-body\x20h2,\x0a\x20\x20.markdown-body\x20h3,\x0a\x20\x20.markdown-body\x20h4,\x0a\x20\x20.markdown-body\x20h5,\x0a\x20\x20.markdown-body\x20h6\x20{\x0a\x20\x20\x20\x20margin-top:\x2024px;\x0a\x20\x20\x20\x20margin-bottom:\x2016px;\x0a\x20\x20\x20\x20font-weight:\x20600;\x0a\x20\x20\x20\x20line-height:\x201.25;\x20}\x0a\x20\x20.markdown-body\x20h1\x20.octicon-link,\x0a\x20\x20.markdown-body\x20h2\x20.octicon-link,\x0a\x20\x20.markdown-body\x20h3\x20.octicon-link,\x0a\x20\x20.markdown-body\x20h4\x20.octicon-link,\x0a\x20\x20.markdown-body\x20h5\x20.octicon-link,\x0a\x20\x20.markdown-body\x20h6\x20.octicon-link\x20{\x0a\x20\x20\x20\x20color:\x20#1b1f23;\x0a\x20\x20\x20\x20vertical-align:\x20middle;\x0a\x20\x20\x20\x20visibility:\x20hidden;\x20}\x0a\x20\x20.markdown-body\x20h1:hover\x20.anchor,\x0a\x20\x20.markdown-body\x20h2:hover\x20.anchor,\x0a\x20\x20.markdown-body\x20h3:hover\x20.anchor,\x0a\x20\x20.markdown-body\x20h4:hover\x20.anchor,\x0a\x20\x20.markdown-body\x20h5:hover\x20.anchor,\x0a\x20\x20.markdown-body\x20h6:hover\x20.anchor\x20{\x0a\x20\x20\x20\x20text-decoration:\x20none;\x20}\x0a\x20\x20.markdown-body\x20h1:hover\x20.anchor\x20.octicon-link,\x0a\x20\x20.markdown-body\x20h2:hover\x20.anchor\x20.octicon-link,\x0a\x20\x20.markdown-body\x20h3:hover\x20.anchor\x20.octicon-link,\x0a\x20\x20.markdown-body\x20h4:hover\x20.anchor\x20.octicon-link,\x0a\x20\x20.markdown-body\x20h5:hover\x20.anchor\x20.octicon-link,\x0a\x20\x20.markdown-body\x20h6:hover\x20.anchor\x20.octicon-link\x20{\x0a\x20\x20\x20\x20visibility:\x20visible;\x20}\x0a\x20\x20.markdown-body\x20h1\x20tt,\x0a\x20\x20.markdown-body\x20h1\x20code,\x0a\x20\x20.markdown-body\x20h2\x20tt,\x0a\x20\x20.markdown-body\x20h2\x20code,\x0a\x20\x20.markdown-body\x20h3\x20tt,\x0a\x20\x20.markdown-body\x20h3\x20code,\x0a\x20\x20.markdown-body\x20h4\x20tt,\x0a\x20\x20.markdown-body\x20h4\x20code,\x0a\x20\x20.markdown-body\x20h5\x20tt,\x0a\x20\x20.markdown-body\x20h5\x20code,\x0a\x20\x20.markdown-body\x20h6\x20tt,\x0a\x20\x20.markdown-body\x20h6\x20code\x20{\x0a\x20\x20\x20\x20font-size:\x20inherit;\x20}\x0a\x20\x20.markdown-body\x20h1\x20{\x0a\x20\x20\x20\x20padding-bottom:\x20.3em;\x0a\x20\x20\x20\x20font-size:\x202em;\x0a\x20\x20\x20\x20border-bottom:\x201px\x20solid\x20#eaecef;\x20}\x0a\x20\x20.markdown-body\x20h2\x20{\x0a\x20\x20\x20\x20padding-bottom:\x20.3em;\x0a\x20\x20\x20\x20font-size:\x201.5em;\x0a\x20\x20\x20\x20border-bottom:\x201px\x20solid\x20#eaecef;\x20}\x0a\x20\x20.markdown-body\x20h3\x20{\x0a\x20\x20\x20\x20font-size:\x201.25em;\x20}\x0a\x20\x20.markdown-body\x20h4\x20{\x0a\x20\x20\x20\x20font-size:\x201em;\x20}\x0a\x20\x20.markdown-body\x20h5\x20{\x0a\x20\x20\x20\x20font-size:\x20.875em;\x20}\x0a\x20\x20.markdown-body\x20h6\x20{\x0a\x20\x20\x20\x20font-size:\x20.85em;\x0a\x20\x20\x20\x20color:\x20#6a737d;\x20}\x0a\x20\x20.markdown-body\x20ul,\x0a\x20\x20.markdown-body\x20ol\x20{\x0a\x20\x20\x20\x20padding-left:\x202em;\x20}\x0a\x20\x20.markdown-body\x20ul.field-names\x20{\x0a\x20\x20\x20\x20margin:\x200;\x0a\x20\x20\x20\x20padding:\x200;\x0a\x20\x20\x20\x20list-style:\x20none;\x20}\x0a\x20\x20\x20\x20.markdown-body\x20ul.field-names\x20span.field-name\x20{\x0a\x20\x20\x20\x20\x20\x20font-weight:\x20500;\x20}\x0a\x20\x20.markdown-body\x20ul.list-implements,\x0a\x20\x20.markdown-body\x20ul.list-references,\x0a\x20\x20.markdown-body\x20ul.list-calls,\x0a\x20\x20.markdown-body\x20ul.list-imports\x20{\x0a\x20\x20\x20\x20padding:\x200;\x0a\x20\x20\x20\x20list-style:\x20none;\x20}\x0a\x20\x20.markdown-body\x20.import-graph-image\x20{\x0a\x20\x20\x20\x20overflow-x:\x20auto;\x20}\x0a\x20\x20.markdown-body\x20details.references-group\x20>\x20summary\x20{\x0a\x20\x20\x20\x20cursor:\x20pointer;\x0a\x20\x20\x20\x20font-weight:\x20500;\x20}\x0a\x20\x20.markdown-body\x20ul.no-list,\x0a\x20\x20.markdown-body\x20ol.no-list\x20{\x0a\x20\x20\x20\x20padding:\x200;\x0a\x20\x20\x20\x20list-style-type:\x20none;\x20}\x0a\x20\x20.markdown-body\x20ul\x20ul,\x0a\x20\x20.markdown-body\x20ul\x20ol,\x0a\x20\x20.markdown-body\x20ol\x20ol,\x0a\x20\x20.markdown-body\x20ol\x20ul\x20{\x0a\x20\x20\x20\x20margin-top:\x200;\x0a\x20\x20\x20\x20margin-bottom:\x200;\x20}\x0a\x20\x20.markdown-body\x20li\x20{\x0a\x20\x20\x20\x20word-wrap:\x20break-all;\x20}\x0a\x20\x20.markdown-body\x20li\x20>\x20p\x20{\x0a\x20\x20\x20\x20margin-top:\x2016px;\x20}\x0a\x20\x20.markdown-body\x20li\x20+\x20li\x20{\x0a\x20\x20\x20\x20margin-top:\x20.25em;\x20}\x0a\x20\x20.markdown-body\x20dl\x20{\x0a\x20\x20\x20\x20padding:\x200;\x20}\x0a\x20\x20.markdown-body\x20dl\x20dt\x20{\x0a\x20\x20\x20\x20padding:\x200;\x0a\x20\x20\x20\x20margin-top:\x2016px;\x0a\x20\x20\x20\x20font-size:\x201em;\x0a\x20\x20\x20\x20font-style:\x20italic;\x0a\x20\x20\x20\x20font-weight:\x20600;\x20}\x0a\x20\x20.markdown-body\x20dl\x20dd\x20{\x0a\x20\x20\x20\x20padding:\x200\x2016px;\x0a\x20\x20\x20\x20margin-bottom:\x2016px;\x20}\x0a\x20\x20.markdown-body\x20img\x20{\x0a\x20\x20\x20\x20max-width:\x20100%;\x0a\x20\x20\x20\x20box-sizing:\x20content-box;\x0a\x20\x20\x20\x20background-color:\x20#fff;\x20}\x0a\x20\x20.markdown-body\x20img[align=right]\x20{\x0a\x20\x20\x20\x20padding-left:\x2020px;\x20}\x0a\x20\x20.markdown-body\x20img[align=left]\x20{\x0a\x20\x20\x20\x20padding-right:\x2020px;\x20}\x0a\x20\x20.markdown-body\x20.emoji\x20{\x0a\x20\x20\x20\x20max-width:\x20none;\x0a\x20\x20\x20\x20vertical-align:\x20text-top;\x0a\x20\x20\x20\x20background-color:\x20transparent;\x20}\x0a\x20\x20.markdown-body\x20span.frame\x20{\x0a\x20\x20\x20\x20display:\x20block;\x0a\x20\x20\x20\x20overflow:\x20hidden;\x20}\x0a\x20\x20.markdown-body\x20span.frame\x20>\x20span\x20{\x0a\x20\x20\x20\x20display:\x20block;\x0a\x20\x20\x20\x20float:\x20left;\x0a\x20\x20\x20\x20width:\x20auto;\x0a\x20\x20\x20\x20padding:\x207px;\x0a\x20\x20\x20\x20margin:\x2013px\x200\x200;\x0a\x20\x20\x20\x20overflow:\x20hidden;\x0a\x20\x20\x20\x20border:\x201px\x20solid\x20#dfe2e5;\x20}\x0a\x20\x20.markdown-body\x20span.frame\x20span\x20img\x20{\x0a\x20\x20\x20\x20display:\x20block;\x0a\x20\x20\x20\x20float:\x20left;\x20}\x0a\x20\x20.markdown-body\x20span.frame\x20span\x20span\x20{\x0a\x20\x20\x20\x20display:\x20block;\x0a\x20\x20\x20\x20padding:\x205px\x200\x200;\x0a\x20\x20\x20\x20clear:\x20both;\x0a\x20\x20\x20\x20color:\x20#24292e;\x20}\x0a\x20\x20.markdown-body\x20span.align-center\x20{\x0a\x20\x20\x20\x20display:\x20block;\x0a\x20\x20\x20\x20overflow:\x20hidden;\x0a\x20\x20\x20\x20clear:\x20both;\x20}\x0a\x20\x20.markdown-body\x20span.align-center\x20>\x20span\x20{\x0a\x20\x20\x20\x20display:\x20block;\x0a\x20\x20\x20\x20margin:\x2013px\x20auto\x200;\x0a\x20\x20\x20\x20overflow:\x20hidden;\x0a\x20\x20\x20\x20text-align:\x20center;\x20}\x0a\x20\x20.markdown-body\x20span.align-center\x20span\x20img\x20{\x0a\x20\x20\x20\x20margin:\x200\x20auto;\x0a\x20\x20\x20\x20text-align:\x20center;\x20}\x0a\x20\x20.markdown-body\x20span.align-right\x20{\x0a\x20\x20\x20\x20display:\x20block;\x0a\x20\x20\x20\x20overflow:\x20hidden;\x0a\x20\x20\x20\x20clear:\x20both;\x20}\x0a\x20\x20.markdown-body\x20span.align-right\x20>\x20span\x20{\x0a\x20\x20\x20\x20display:\x20block;\x0a\x20\x20\x20\x20margin:\x2013px\x200\x200;\x0a\x20\x20\x20\x20overflow:\x20hidden;\x0a\x20\x20\x20\x20text-align:\x20right;\x20}\x0a\x20\x20.markdown-body\x20span.align-right\x20span\x20img\x20{\x0a\x20\x20\x20\x20margin:\x200;\x0a\x20\x20\x20\x20text-align:\x20right;\x20}\x0a\x20\x20.markdown-body\x20span.float-left\x20{\x0a\x20\x20\x20\x20display:\x20block;\x0a\x20\x20\x20\x20float:\x20left;\x0a\x20\x20\x20\x20margin-right:\x2013px;\x0a\x20\x20\x20\x20overflow:\x20hidden;\x20}\x0a\x20\x20.markdown-body\x20span.float-left\x20span\x20{\x0a\x20\x20\x20\x20margin:\x2013px\x200\x200;\x20}\x0a\x20\x20.markdown-body\x20span.float-right\x20{\x0a\x20\x20\x20\x20display:\x20block;\x0a\x20\x20\x20\x20float:\x20right;\x0a\x20\x20\x20\x20margin-left:\x2013px;\x0a\x20\x20\x20\x20overflow:\x20hidden;\x20}\x0a\x20\x20.markdown-body\x20span.float-right\x20>\x20span\x20{\x0a\x20\x20\x20\x20display:\x20block;\x0a\x20\x20\x20\x20margin:\x2013px\x20auto\x200;\x0a\x20\x20\x20\x20overflow:\x20hidden;\x0a\x20\x20\x20\x20text-align:\x20right;\x20}\x0a\x20\x20.markdown-body\x20code,\x0a\x20\x20.markdown-body\x20tt\x20{\x0a\x20\x20\x20\x20padding:\x20.2em\x20.4em;\x0a\x20\x20\x20\x20margin:\x200;\x0a\x20\x20\x20\x20font-size:\x2085%;\x0a\x20\x20\x20\x20background-color:\x20rgba(27,\x2031,\x2035,\x200.05);\x0a\x20\x20\x20\x20border-radius:\x206px;\x0a\x20\x20\x20\x20color:\x20inherit;\x20}\x0a\x20\x20.markdown-body\x20code\x20br,\x0a\x20\x20.markdown-body\x20tt\x20br\x20{\x0a\x20\x20\x20\x20display:\x20none;\x20}\x0a\x20\x20.markdown-body\x20del\x20code\x20{\x0a\x20\x20\x20\x20text-decoration:\x20inherit;\x20}\x0a\x20\x20.markdown-body\x20pre\x20{\x0a\x20\x20\x20\x20word-wrap:\x20normal;\x20}\x0a\x20\x20\x20\x20.markdown-body\x20pre\x20>\x20code\x20{\x0a\x20\x20\x20\x20\x20\x20padding:\x200;\x0a\x20\x20\x20\x20\x20\x20margin:\x200;\x0a\x20\x20\x20\x20\x20\x20font-size:\x20100%;\x0a\x20\x20\x20\x20\x20\x20word-break:\x20normal;\x0a\x20\x20\x20\x20\x20\x20white-space:\x20pre;\x0a\x20\x20\x20\x20\x20\x20background:\x20transparent;\x0a\x20\x20\x20\x20\x20\x20border:\x200;\x20}\x0a\x20\x20.markdown-body\x20.highlight\x20{\x0a\x20\x20\x20\x20margin-bottom:\x2016px;\x20}\x0a\x20\x20.markdown-body\x20.highlight\x20pre\x20{\x0a\x20\x20\x20\x20margin-bottom:\x200;\x0a\x20\x20\x20\x20word-break:\x20normal;\x20}\x0a\x20\x20.markdown-body\x20.highlight\x20pre,\x0a\x20\x20.markdown-body\x20pre\x20{\x0a\x20\x20\x20\x20padding:\x2016px;\x0a\x20\x20\x20\x20overflow:\x20auto;\x0a\x20\x20\x20\x20font-size:\x2085%;\x0a\x20\x20\x20\x20line-height:\x201.45;\x0a\x20\x20\x20\x20background-color:\x20#f6f8fa;\x0a\x20\x20\x20\x20border-radius:\x206px;\x20}\x0a\x20\x20.markdown-body\x20pre\x20code,\x0a\x20\x20.markdown-body\x20pre\x20tt\x20{\x0a\x20\x20\x20\x20display:\x20inline;\x0a\x20\x20\x20\x20max-width:\x20auto;\x0a\x20\x20\x20\x20padding:\x200;\x0a\x20\x20\x20\x20margin:\x200;\x0a\x20\x20\x20\x20overflow:\x20visible;\x0a\x20\x20\x20\x20line-height:\x20inherit;\x0a\x20\x20\x20\x20word-wrap:\x20normal;\x0a\x20\x20\x20\x20background-color:\x20transparent;\x0a\x20\x20\x20\x20border:\x200;\x20}\x0a\x20\x20.markdown-body\x20.csv-data\x20td,\x0a\x20\x20.markdown-body\x20.csv-data\x20th\x20{\x0a\x20\x20\x20\x20padding:\x205px;\x0a\x20\x20\x20\x20overflow:\x20hidden;\x0a\x20\x20\x20\x20font-size:\x2012px;\x0a\x20\x20\x20\x20line-height:\x201;\x0a\x20\x20\x20\x20text-align:\x20left;\x0a\x20\x20\x20\x20white-space:\x20nowrap;\x20}\x0a\x20\x20.markdown-body\x20.csv-data\x20.blob-num\x20{\x0a\x20\x20\x20\x20padding:\x2010px\x208px\x209px;\x0a\x20\x20\x20\x20text-align:\x20right;\x0a\x20\x20\x20\x20background:\x20#fff;\x0a\x20\x20\x20\x20border:\x200;\x20}\x0a\x20\x20.markdown-body\x20.csv-data\x20tr\x20{\x0a\x20\x20\x20\x20border-top:\x200;\x20}\x0a\x20\x20.markdown-body\x20.csv-data\x20th\x20{\x0a\x20\x20\x20\x20font-weight:\x20600;\x0a\x20\x20\x20\x20background:\x20#f6f8fa;\x0a\x20\x20\x20\x20border-top:\x200;\x20}\x0a\x20\x20.markdown-body\x20summary\x20{\x0a\x20\x20\x20\x20outline:\x20none;\x20}\x0a\x20\x20.markdown-body\x20summary\x20h1,\x0a\x20\x20.markdown-body\x20summary\x20h2,\x0a\x20\x20.markdown-body\x20summary\x20h3,\x0a\x20\x20.markdown-body\x20summary\x20h4,\x0a\x20\x20.markdown-body\x20summary\x20h5,\x0a\x20\x20.markdown-body\x20summary\x20h6\x20{\x0a\x20\x20\x20\x20display:\x20inline-block;\x0a\x20\x20\x20\x20margin-top:\x2010px;\x0a\x20\x20\x20\x20margin-bottom:\x2010px;\x20}\x0a\x20\x20.markdown-body\x20summary\x20h1\x20p,\x0a\x20\x20.markdown-body\x20summary\x20h2\x20p,\x0a\x20\x20.markdown-body\x20summary\x20h3\x20p,\x0a\x20\x20.markdown-body\x20summary\x20h4\x20p,\x0a\x20\x20.markdown-body\x20summary\x20h5\x20p,\x0a\x20\x20.markdown-body\x20summary\x20h6\x20p\x20{\x0a\x20\x20\x20\x20margin:\x200;\x0a\x20\x20\x20\x20padding:\x200;\x20}\x0a\x20\x20.markdown-body\x20.highlight\x20pre,\x0a\x20\x20.markdown-body\x20pre\x20{\x0a\x20\x20\x20\x20margin-top:\x2010px;\x20}\x0a\x20\x20.markdown-body\x20.height-constrained-code-block\x20pre\x20{\x0a\x20\x20\x20\x20max-height:\x20500px;\x0a\x20\x20\x20\x20overflow:\x20auto;\x20}\x0a\x20\x20.markdown-body\x20.breadcrumbs\x20a:not(:last-child)::after\x20{\x0a\x20\x20\x20\x20content:\x20\"/\";\x0a\x20\x20\x20\x20color:\x20#959da5;\x0a\x20\x20\x20\x20padding-right:\x204px;\x0a\x20\x20\x20\x20padding-left:\x208px;\x0a\x20\x20\x20\x20display:\x20inline-block;\x20}\x0a\x20\x20.markdown-body\x20ol\x20{\x0a\x20\x20\x20\x20counter-reset:\x20li;\x0a\x20\x20\x20\x20list-style:\x20none;\x0a\x20\x20\x20\x20position:\x20relative;\x0a\x20\x20\x20\x20padding-bottom:\x2010px;\x0a\x20\x20\x20\x20padding-left:\x200;\x20}\x0a\x20\x20.markdown-body\x20ol\x20>\x20li\x20{\x0a\x20\x20\x20\x20padding:\x2015px\x200\x2015px\x2055px;\x0a\x20\x20\x20\x20position:\x20relative;\x0a\x20\x20\x20\x20margin-bottom:\x205px;\x0a\x20\x20\x20\x20border-top:\x203px\x20solid\x20#eee;\x20}\x0a\x20\x20.markdown-body\x20ol\x20>\x20li:before\x20{\x0a\x20\x20\x20\x20content:\x20counter(li);\x0a\x20\x20\x20\x20counter-increment:\x20li;\x0a\x20\x20\x20\x20position:\x20absolute;\x0a\x20\x20\x20\x20top:\x2010px;\x0a\x20\x20\x20\x20left:\x200;\x0a\x20\x20\x20\x20height:\x20100%;\x0a\x20\x20\x20\x20width:\x2030px;\x0a\x20\x20\x20\x20padding:\x200\x2010px\x200\x200;\x0a\x20\x20\x20\x20color:\x20#999;\x0a\x20\x20\x20\x20font-size:\x2022px;\x0a\x20\x20\x20\x20font-weight:\x20bold;\x0a\x20\x20\x20\x20line-height:\x2035px;\x0a\x20\x20\x20\x20text-align:\x20right;\x20}\x0a\x20\x20.markdown-body\x20ol\x20>\x20li\x20>\x20p\x20{\x0a\x20\x20\x20\x20margin:\x200;\x20}\x0a\x20\x20.markdown-body\x20ol\x20>\x20li\x20>\x20p:first-child\x20{\x0a\x20\x20\x20\x20margin-top:\x200;\x20}\x0a\x20\x20.markdown-body\x20ol\x20>\x20li:after\x20{\x0a\x20\x20\x20\x20content:\x20\".\";\x0a\x20\x20\x20\x20display:\x20block;\x0a\x20\x20\x20\x20clear:\x20both;\x0a\x20\x20\x20\x20visibility:\x20hidden;\x0a\x20\x20\x20\x20line-height:\x200;\x0a\x20\x20\x20\x20height:\x200;\x20}\x0a\x20\x20.markdown-body\x20h1,\x0a\x20\x20.markdown-body\x20h2,\x0a\x20\x20.markdown-body\x20h3,\x0a\x20\x20.markdown-body\x20h4,\x0a\x20\x20.markdown-body\x20h5,\x0a\x20\x20.markdown-body\x20h6\x20{\x0a\x20\x20\x20\x20font-family:\x20Inter,\x20-apple-system,\x20BlinkMacSystemFont,\x20\"Segoe\x20UI\",\x20Helvetica,\x20Arial,\x20sans-serif,\x20\"Apple\x20Color\x20Emoji\",\x20\"Segoe\x20UI\x20Emoji\",\x20\"Segoe\x20UI\x20Symbol\";\x0a\x20\x20\x20\x20font-weight:\x20500;\x0a\x20\x20\x20\x20padding-top:\x2016px;\x20}\x0a\x20\x20.markdown-body\x20ol\x20>\x20li\x20{\x0a\x20\x20\x20\x20padding:\x208px\x200\x208px\x2048px;\x0a\x20\x20\x20\x20border:\x200;\x20}\x0a\x20\x20.markdown-body\x20ol\x20>\x20li:before\x20{\x0a\x20\x20\x20\x20top:\x202px;\x0a\x20\x20\x20\x20font-size:\x2016px;\x0a\x20\x20\x20\x20width:\x2024px;\x20}\x0a\x20\x20.markdown-body\x20ol\x20>\x20li\x20p:not(:first-child)\x20{\x0a\x20\x20\x20\x20margin-top:\x2015px;\x20}\x0a\x20\x20.markdown-body\x20ol\x20>\x20li\x20.extended-markdown\x20{\x0a\x20\x20\x20\x20margin-top:\x2015px;\x20}\x0a\x20\x20.markdown-body\x20ul\x20ul,\x0a\x20\x20.markdown-body\x20ul\x20ol,\x0a\x20\x20.markdown-body\x20ol\x20ol,\x0a\x20\x20.markdown-body\x20ol\x20ul\x20{\x0a\x20\x20\x20\x20margin-top:\x2015px;\x0a\x20\x20\x20\x20margin-bottom:\x2015px;\x20}\x0a\x20\x20.markdown-body\x20.lead-mktg\x20p\x20{\x0a\x20\x20\x20\x20color:\x20#24292e;\x20}\x0a\x20\x20.markdown-body\x20table\x20{\x0a\x20\x20\x20\x20display:\x20block;\x0a\x20\x20\x20\x20width:\x20100%;\x0a\x20\x20\x20\x20width:\x20max-content;\x0a\x20\x20\x20\x20max-width:\x20100%;\x0a\x20\x20\x20\x20overflow:\x20auto;\x20}\x0a\x20\x20.markdown-body\x20table\x20th\x20{\x0a\x20\x20\x20\x20font-weight:\x20600;\x20}\x0a\x20\x20.markdown-body\x20table\x20th,\x0a\x20\x20.markdown-body\x20table\x20td\x20{\x0a\x20\x20\x20\x20padding:\x206px\x2013px;\x0a\x20\x20\x20\x20border:\x201px\x20solid\x20#dfe2e5;\x20}\x0a\x20\x20.markdown-body\x20table\x20tr\x20{\x0a\x20\x20\x20\x20background-color:\x20#fff;\x0a\x20\x20\x20\x20border-top:\x201px\x20solid\x20#c6cbd1;\x20}\x0a\x20\x20.markdown-body\x20table\x20tr:nth-child(2n)\x20{\x0a\x20\x20\x20\x20background-color:\x20#f6f8fa;\x20}\x0a\x20\x20.markdown-body\x20table\x20img\x20{\x0a\x20\x20\x20\x20background-color:\x20transparent;\x20}\x0a\x20\x20.markdown-body\x20table\x20{\x0a\x20\x20\x20\x20display:\x20table;\x0a\x20\x20\x20\x20table-layout:\x20fixed;\x0a\x20\x20\x20\x20line-height:\x201.5;\x20}\x0a\x20\x20.markdown-body\x20div.featured-links\x20{\x0a\x20\x20\x20\x20padding-bottom:\x2030px;\x20}\x0a\x20\x20.markdown-body\x20div.featured-links-heading\x20{\x0a\x20\x20\x20\x20padding-top:\x2024px;\x20}\x0a\x20\x20.markdown-body\x20div.featured-links\x20p.link-with-intro-intro\x20{\x0a\x20\x20\x20\x20margin-bottom:\x205px;\x20}\x0a\x20\x20.markdown-body\x20div.featured-links\x20h4.link-with-intro-title\x20{\x0a\x20\x20\x20\x20margin-top:\x200;\x20}\x0a\x20\x20.markdown-body\x20.bg-blue-light\x20blockquote\x20{\x0a\x20\x20\x20\x20color:\x20#586069;\x20}\x0a\x20\x20.markdown-body\x20table\x20{\x0a\x20\x20\x20\x20border-collapse:\x20collapse;\x0a\x20\x20\x20\x20position:\x20relative;\x0a\x20\x20\x20\x20font-size:\x2090%;\x0a\x20\x20\x20\x20table-layout:\x20auto;\x20}\x0a\x20\x20.markdown-body\x20table\x20code\x20{\x0a\x20\x20\x20\x20font-size:\x20100%;\x0a\x20\x20\x20\x20background:\x20none;\x0a\x20\x20\x20\x20padding:\x200;\x0a\x20\x20\x20\x20font-weight:\x20bold;\x20}\x0a\x20\x20.markdown-body\x20table\x20thead\x20tr\x20{\x0a\x20\x20\x20\x20border:\x20none;\x20}\x0a\x20\x20.markdown-body\x20table\x20th\x20{\x0a\x20\x20\x20\x20font-weight:\x20normal;\x0a\x20\x20\x20\x20text-align:\x20left;\x0a\x20\x20\x20\x20position:\x20sticky;\x0a\x20\x20\x20\x20vertical-align:\x20top;\x0a\x20\x20\x20\x20z-index:\x201;\x0a\x20\x20\x20\x20top:\x200;\x0a\x20\x20\x20\x20background:\x20#fff;\x0a\x20\x20\x20\x20box-shadow:\x200\x203px\x200\x200\x20#959da5;\x0a\x20\x20\x20\x20padding:\x2012px\x208px;\x0a\x20\x20\x20\x20border:\x200px;\x20}\x0a\x20\x20.markdown-body\x20table\x20th:first-child,\x0a\x20\x20.markdown-body\x20table\x20td:first-child\x20{\x0a\x20\x20\x20\x20padding-left:\x200;\x20}\x0a\x20\x20.markdown-body\x20table\x20td\x20{\x0a\x20\x20\x20\x20padding:\x2010px\x208px;\x0a\x20\x20\x20\x20border:\x200px;\x0a\x20\x20\x20\x20vertical-align:\x20top;\x20}\x0a\x20\x20.markdown-body\x20table\x20td\x20p\x20{\x0a\x20\x20\x20\x20padding:\x200;\x0a\x20\x20\x20\x20margin:\x200;\x20}\x0a\x20\x20.markdown-body\x20table\x20tr:nth-child(2n)\x20{\x0a\x20\x20\x20\x20background:\x20none;\x20}\x0a\x20\x20.markdown-body\x20table\x20td.has-nested-table\x20{\x0a\x20\x20\x20\x20width:\x20100%;\x20}\x0a\x20\x20.markdown-body\x20table\x20td.has-nested-table\x20table.slim\x20{\x0a\x20\x20\x20\x20table-layout:\x20auto;\x20}\x0a\x0a.search-form\x20{\x0a\x20\x20margin-bottom:\x2024px;\x20}\x0a\x0a.search-results\x20{\x0a\x20\x20padding:\x200\x20!important;\x0a\x20\x20list-style:\x20none;\x20}\x0a\x20\x20.search-results\x20.search-result\x20{\x0a\x20\x20\x20\x20margin-bottom:\x2016px;\x20}\x0a\x20\x20.search-results\x20.search-result-kind\x20{\x0a\x20\x20\x20\x20min-width:\x2056px;\x0a\x20\x20\x20\x20margin-right:\x204px;\x20}\x0a\x20\x20.search-results\x20.search-result-path\x20{\x0a\x20\x20\x20\x20margin-left:\x208px;\x0a\x20\x20\x20\x20font-size:\x2085%;\x0a\x20\x20\x20\x20color:\x20#6a737d;\x20}\x0a\x20\x20.search-results\x20.search-result-snippet\x20{\x0a\x20\x20\x20\x20color:\x20#586069;\x20}\x0a\x20\x20\x20\x20.search-results\x20.search-result-snippet\x20.highlight\x20{\x0a\x20\x20\x20\x20\x20\x20background:\x20#fff5b1;\x20}\x0a\x0a.marker\x20{\x0a\x20\x20min-height:\x2017px;\x0a\x20\x20margin:\x2010px\x200\x2016px;\x0a\x20\x20padding:\x2016px;\x0a\x20\x20overflow:\x20auto;\x0a\x20\x20font-size:\x2090%;\x0a\x20\x20line-height:\x201.45;\x0a\x20\x20color:\x20#586069;\x0a\x20\x20background-color:\x20#f6f8fa;\x0a\x20\x20border:\x201px\x20solid\x20#e1e4e8;\x0a\x20\x20border-radius:\x206px;\x20}\x0a\x20\x20.marker::before\x20{\x0a\x20\x20\x20\x20content:\x20\"\";\x0a\x20\x20\x20\x20display:\x20block;\x0a\x20\x20\x20\x20float:\x20left;\x0a\x20\x20\x20\x20width:\x2014px;\x0a\x20\x20\x20\x20height:\x2014px;\x0a\x20\x20\x20\x20margin:\x203px\x205px\x200\x200;\x20}\x0a\x20\x20.marker\x20>\x20*:last-child\x20{\x0a\x20\x20\x20\x20margin-bottom:\x200;\x20}\x0a\x20\x20.marker.marker-ignore\x20{\x0a\x20\x20\x20\x20display:\x20none\x20!important;\x20}\x0a\x20\x20.marker.marker-note\x20{\x0a\x20\x20\x20\x20border-color:\x20#0366d6\x20!important;\x0a\x20\x20\x20\x20background-color:\x20#f1f8ff\x20!important;\x20}\x0a\x20\x20\x20\x20.marker.marker-note::before\x20{\x0a\x20\x20\x20\x20\x20\x20background-image:\x20url('data:image/svg+xml,<svg\x20width=\"1em\"\x20height=\"1em\"\x20viewBox=\"0\x200\x2016\x2016\"\x20class=\"bi\x20bi-question-circle\"\x20fill=\"%230366d6\"\x20xmlns=\"http://www.w3.org/2000/svg\"><path\x20fill-rule=\"evenodd\"\x20d=\"M8\x2015A7\x207\x200\x201\x200\x208\x201a7\x207\x200\x200\x200\x200\x2014zm0\x201A8\x208\x200\x201\x200\x208\x200a8\x208\x200\x200\x200\x200\x2016z\"/><path\x20d=\"M5.255\x205.786a.237.237\x200\x200\x200\x20.241.247h.825c.138\x200\x20.248-.113.266-.25.09-.656.54-1.134\x201.342-1.134.686\x200\x201.314.343\x201.314\x201.168\x200\x20.635-.374.927-.965\x201.371-.673.489-1.206\x201.06-1.168\x201.987l.003.217a.25.25\x200\x200\x200\x20.25.246h.811a.25.25\x200\x200\x200\x20.25-.25v-.105c0-.718.273-.927\x201.01-1.486.609-.463\x201.244-.977\x201.244-2.056\x200-1.511-1.276-2.241-2.673-2.241-1.267\x200-2.655.59-2.75\x202.286zm1.557\x205.763c0\x20.533.425.927\x201.01.927.609\x200\x201.028-.394\x201.028-.927\x200-.552-.42-.94-1.029-.94-.584\x200-1.009.388-1.009.94z\"/></svg>');\x0a\x20\x20\x20\x20\x20\x20background-repeat:\x20no-repeat;\x0a\x20\x20\x20\x20\x20\x20background-position:\x20center;\x0a\x20\x20\x20\x20\x20\x20background-size:\x2014px;\x20}\x0a\x20\x20.marker.marker-tip\x20{\x0a\x20\x20\x20\x20border-color:\x20#28a745\x20!important;\x0a\x20\x20\x20\x20background-color:\x20#f0fff4\x20!important;\x20}\x0a\x20\x20\x20\x20.marker.marker-tip::before\x20{\x0a\x20\x20\x20\x20\x20\x20background-image:\x20url('data:image/svg+xml,<svg\x20width=\"1em\"\x20height=\"1em\"\x20viewBox=\"0\x200\x2016\x2016\"\x20class=\"bi\x20bi-check-circle\"\x20fill=\"%2328a745\"\x20xmlns=\"http://www.w3.org/2000/svg\"><path\x20fill-rule=\"evenodd\"\x20d=\"M8\x2015A7\x207\x200\x201\x200\x208\x201a7\x207\x200\x200\x200\x200\x2014zm0\x201A8\x208\x200\x201\x200\x208\x200a8\x208\x200\x200\x200\x200\x2016z\"/><path\x20fill-rule=\"evenodd\"\x20d=\"M10.97\x204.97a.75.75\x200\x200\x201\x201.071\x201.05l-3.992\x204.99a.75.75\x200\x200\x201-1.08.02L4.324\x208.384a.75.75\x200\x201\x201\x201.06-1.06l2.094\x202.093\x203.473-4.425a.267.267\x200\x200\x201\x20.02-.022z\"/></svg>');\x0a\x20\x20\x20\x20\x20\x20background-repeat:\x20no-repeat;\x0a\x20\x20\x20\x20\x20\x20background-position:\x20center;\x0a\x20\x20\x20\x20\x20\x20background-size:\x2014px;\x20}\x0a\x20\x20.marker.marker-important\x20{\x0a\x20\x20\x20\x20border-color:\x20#6f42c1\x20!important;\x0a\x20\x20\x20\x20background-color:\x20#f5f0ff\x20!important;\x20}\x0a\x20\x20\x20\x20.marker.marker-important::before\x20{\x0a\x20\x20\x20\x20\x20\x20background-image:\x20url('data:image/svg+xml,<svg\x20width=\"1em\"\x20height=\"1em\"\x20viewBox=\"0\x200\x2016\x2016\"\x20class=\"bi\x20bi-info-circle\"\x20fill=\"%236f42c1\"\x20xmlns=\"http://www.w3.org/2000/svg\"><path\x20fill-rule=\"evenodd\"\x20d=\"M8\x2015A7\x207\x200\x201\x200\x208\x201a7\x207\x200\x200\x200\x200\x2014zm0\x201A8\x208\x200\x201\x200\x208\x200a8\x208\x200\x200\x200\x200\x2016z\"/><path\x20d=\"M8.93\x206.588l-2.29.287-.082.38.45.083c.294.07.352.176.288.469l-.738\x203.468c-.194.897.105\x201.319.808\x201.319.545\x200\x201.178-.252\x201.465-.598l.088-.416c-.2.176-.492.246-.686.246-.275\x200-.375-.193-.304-.533L8.93\x206.588zM9\x204.5a1\x201\x200\x201\x201-2\x200\x201\x201\x200\x200\x201\x202\x200z\"/></svg>');\x0a\x20\x20\x20\x20\x20\x20background-repeat:\x20no-repeat;\x0a\x20\x20\x20\x20\x20\x20background-position:\x20center;\x0a\x20\x20\x20\x20\x20\x20background-size:\x2014px;\x20}\x0a\x20\x20.marker.marker-warning\x20{\x0a\x20\x20\x20\x20border-color:\x20#b08800\x20!important;\x0a\x20\x20\x20\x20background-color:\x20#fffbdd\x20!important;\x20}\x0a\x20\x20\x20\x20.marker.marker-warning::before\x20{\x0a\x20\x20\x20\x20\x20\x20background-image:\x20url('data:image/svg+xml,<svg\x20width=\"1em\"\x20height=\"1em\"\x20viewBox=\"0\x200\x2016\x2016\"\x20class=\"bi\x20bi-exclamation-triangle\"\x20fill=\"%23b08800\"\x20xmlns=\"http://www.w3.org/2000/svg\"><path\x20fill-rule=\"evenodd\"\x20d=\"M7.938\x202.016a.146.146\x200\x200\x200-.054.057L1.027\x2013.74a.176.176\x200\x200\x200-.002.183c.016.03.037.05.054.06.015.01.034.017.066.017h13.713a.12.12\x200\x200\x200\x20.066-.017.163.163\x200\x200\x200\x20.055-.06.176.176\x200\x200\x200-.003-.183L8.12\x202.073a.146.146\x200\x200\x200-.054-.057A.13.13\x200\x200\x200\x208.002\x202a.13.13\x200\x200\x200-.064.016zm1.044-.45a1.13\x201.13\x200\x200\x200-1.96\x200L.165\x2013.233c-.457.778.091\x201.767.98\x201.767h13.713c.889\x200\x201.438-.99.98-1.767L8.982\x201.566z\"/><path\x20d=\"M7.002\x2012a1\x201\x200\x201\x201\x202\x200\x201\x201\x200\x200\x201-2\x200zM7.1\x205.995a.905.905\x200\x201\x201\x201.8\x200l-.35\x203.507a.552.552\x200\x200\x201-1.1\x200L7.1\x205.995z\"/></svg>');\x0a\x20\x20\x20\x20\x20\x20background-repeat:\x20no-repeat;\x0a\x20\x20\x20\x20\x20\x20background-position:\x20center;\x0a\x20\x20\x20\x20\x20\x20background-size:\x2014px;\x20}\x0a\x20\x20.marker.marker-caution\x20{\x0a\x20\x20\x20\x20border-color:\x20#d73a49\x20!important;\x0a\x20\x20\x20\x20background-color:\x20#ffeef0\x20!important;\x20}\x0a\x20\x20\x20\x20.marker.marker-caution::before\x20{\x0a\x20\x20\x20\x20\x20\x20background-image:\x20url('data:image/svg+xml,<svg\x20width=\"1em\"\x20height=\"1em\"\x20viewBox=\"0\x200\x2016\x2016\"\x20class=\"bi\x20bi-exclamation-octagon\"\x20fill=\"%23d73a49\"\x20xmlns=\"http://www.w3.org/2000/svg\"><path\x20fill-rule=\"evenodd\"\x20d=\"M4.54.146A.5.5\x200\x200\x201\x204.893\x200h6.214a.5.5\x200\x200\x201\x20.353.146l4.394\x204.394a.5.5\x200\x200\x201\x20.146.353v6.214a.5.5\x200\x200\x201-.146.353l-4.394\x204.394a.5.5\x200\x200\x201-.353.146H4.893a.5.5\x200\x200\x201-.353-.146L.146\x2011.46A.5.5\x200\x200\x201\x200\x2011.107V4.893a.5.5\x200\x200\x201\x20.146-.353L4.54.146zM5.1\x201L1\x205.1v5.8L5.1\x2015h5.8l4.1-4.1V5.1L10.9\x201H5.1z\"/><path\x20d=\"M7.002\x2011a1\x201\x200\x201\x201\x202\x200\x201\x201\x200\x200\x201-2\x200zM7.1\x204.995a.905.905\x200\x201\x201\x201.8\x200l-.35\x203.507a.552.552\x200\x200\x201-1.1\x200L7.1\x204.995z\"/></svg>');\x0a\x20\x20\x20\x20\x20\x20background-repeat:\x20no-repeat;\x0a\x20\x20\x20\x20\x20\x20background-position:\x20center;\x0a\x20\x20\x20\x20\x20\x20background-size:\x2014px;\x20}\x0a\x0ahtml[data-theme=\"dark\"]\x20{\x0a\x20\x20color-scheme:\x20dark;\x20}\x0a\x20\x20html[data-theme=\"dark\"]\x20body,\x0a\x20\x20html[data-theme=\"dark\"]\x20#documentation\x20#btn-printer,\x0a\x20\x20html[data-theme=\"dark\"]\x20.markdown-body\x20.lead-mktg\x20p\x20{\x0a\x20\x20\x20\x20color:\x20#c9d1d9;\x20}\x0a\x20\x20html[data-theme=\"dark\"]\x20body,\x0a\x20\x20html[data-theme=\"dark\"]\x20.markdown-body\x20table\x20tr\x20{\x0a\x20\x20\x20\x20background-color:\x20#0d1117;\x20}\x0a\x20\x20html[data-theme=\"dark\"]\x20.markdown-body\x20.highlight\x20pre,\x0a\x20\x20html[data-theme=\"dark\"]\x20.markdown-body\x20pre,\x0a\x20\x20html[data-theme=\"dark\"]\x20.markdown-body\x20table\x20tr:nth-child(2n),\x0a\x20\x20html[data-theme=\"dark\"]\x20.markdown-body\x20kbd\x20{\x0a\x20\x20\x20\x20color:\x20#c9d1d9;\x0a\x20\x20\x20\x20background-color:\x20#161b22;\x20}\x0a\x20\x20html[data-theme=\"dark\"]\x20.markdown-body\x20code,\x0a\x20\x20html[data-theme=\"dark\"]\x20.markdown-body\x20tt\x20{\x0a\x20\x20\x20\x20background-color:\x20rgba(240,\x20246,\x20252,\x200.15);\x20}\x0a\x20\x20html[data-theme=\"dark\"]\x20.markdown-body\x20table\x20th,\x0a\x20\x20html[data-theme=\"dark\"]\x20.markdown-body\x20table\x20td\x20{\x0a\x20\x20\x20\x20border-color:\x20#30363d;\x20}\x0a\x20\x20html[data-theme=\"dark\"]\x20pre\x20.comment\x20{\x0a\x20\x20\x20\x20color:\x20#8b949e;\x20}\x0a\x20\x20html[data-theme=\"dark\"]\x20.search-results,\x0a\x20\x20html[data-theme=\"dark\"]\x20.marker\x20{\x0a\x20\x20\x20\x20color:\x20#8b949e;\x20}\x0a\x20\x20html[data-theme=\"dark\"]\x20.marker\x20{\x0a\x20\x20\x20\x20background-color:\x20#161b22;\x20}\x0a\x0a@media\x20(prefers-color-scheme:\x20dark)\x20{\x0a\x20\x20html[data-theme=\"auto\"]\x20{\x0a\x20\x20\x20\x20color-scheme:\x20dark;\x20}\x0a\x20\x20\x20\x20html[data-theme=\"auto\"]\x20body,\x0a\x20\x20\x20\x20html[data-theme=\"auto\"]\x20#documentation\x20#btn-printer,\x0a\x20\x20\x20\x20html[data-theme=\"auto\"]\x20.markdown-body\x20.lead-mktg\x20p\x20{\x0a\x20\x20\x20\x20\x20\x20color:\x20#c9d1d9;\x20}\x0a\x20\x20\x20\x20html[data-theme=\"auto\"]\x20body,\x0a\x20\x20\x20\x20html[data-theme=\"auto\"]\x20.markdown-body\x20table\x20tr\x20{\x0a\x20\x20\x20\x20\x20\x20background-color:\x20#0d1117;\x20}\x0a\x20\x20\x20\x20html[data-theme=\"auto\"]\x20.markdown-body\x20.highlight\x20pre,\x0a\x20\x20\x20\x20html[data-theme=\"auto\"]\x20.markdown-body\x20pre,\x0a\x20\x20\x20\x20html[data-theme=\"auto\"]\x20.markdown-body\x20table\x20tr:nth-child(2n),\x0a\x20\x20\x20\x20html[data-theme=\"auto\"]\x20.markdown-body\x20kbd\x20{\x0a\x20\x20\x20\x20\x20\x20color:\x20#c9d1d9;\x0a\x20\x20\x20\x20\x20\x20background-color:\x20#161b22;\x20}\x0a\x20\x20\x20\x20html[data-theme=\"auto\"]\x20.markdown-body\x20code,\x0a\x20\x20\x20\x20html[data-theme=\"auto\"]\x20.markdown-body\x20tt\x20{\x0a\x20\x20\x20\x20\x20\x20background-color:\x20rgba(240,\x20246,\x20252,\x200.15);\x20}\x0a\x20\x20\x20\x20html[data-theme=\"auto\"]\x20.markdown-body\x20table\x20th,\x0a\x20\x20\x20\x20html[data-theme=\"auto\"]\x20.markdown-body\x20table\x20td\x20{\x0a\x20\x20\x20\x20\x20\x20border-color:\x20#30363d;\x20}\x0a\x20\x20\x20\x20html[data-theme=\"auto\"]\x20pre\x20.comment\x20{\x0a\x20\x20\x20\x20\x20\x20color:\x20#8b949e;\x20}\x0a\x20\x20\x20\x20html[data-theme=\"auto\"]\x20.search-results,\x0a\x20\x20\x20\x20html[data-theme=\"auto\"]\x20.marker\x20{\x0a\x20\x20\x20\x20\x20\x20color:\x20#8b949e;\x20}\x0a\x20\x20\x20\x20html[data-theme=\"auto\"]\x20.marker\x20{\x0a\x20\x20\x20\x20\x20\x20background-color:\x20#161b22;\x20}\x20}\x0a",
 
-	"type.html": "<!--\x20type.html\x20-->\x0a{{-\x20$package\x20:=\x20.Package\x20-}}\x0a\x0a{{-\x20with\x20.Type\x20-}}\x0a\x0a\x20\x20{{\x20$tname\x20:=\x20.Name\x20}}\x0a\x20\x20{{\x20$type_name_html\x20:=\x20html\x20.Name\x20}}\x0a\x0a\x20\x20<h1\x20id=\"type-title-{{\x20html\x20$package.Name\x20}}-{{-\x20$type_name_html\x20-}}\">{{-\x20$type_name_html\x20-}}</h1>\x0a\x0a\x20\x20{{\x20.Documentation.Body\x20|\x20unescaped\x20}}\x0a\x0a\x20\x20<!--\x0a\x20\x20\x20\x20<pre>\x0a\x20\x20\x20\x20\x20\x20{{-\x20node_html\x20$package\x20.Decl\x20true\x20|\x20unescaped\x20-}}\x0a\x20\x20\x20\x20</pre>\x0a\x20\x20-->\x0a\x0a\x20\x20<!--\x20fields\x20-->\x0a\x20\x20{{-\x20$fields\x20:=\x20indent_filter\x20.Fields\x20-}}\x0a\x0a\x20\x20{{\x20if\x20eq\x20.TypeSpec\x20\"struct\"\x20}}\x0a\x20\x20{{\x20if\x20gt\x20(len\x20$fields)\x200\x20}}\x0a\x20\x20<h2>Fields</h2>\x0a\x20\x20<table\x20class=\"table-fields\">\x0a\x20\x20\x20\x20<thead>\x0a\x20\x20\x20\x20\x20\x20<tr>\x0a\x20\x20\x20\x20\x20\x20\x20\x20<th>Name</th>\x0a\x20\x20\x20\x20\x20\x20\x20\x20<th>Type</th>\x0a\x20\x20\x20\x20\x20\x20\x20\x20<th>Description</th>\x0a\x20\x20\x20\x20\x20\x20</tr>\x0a\x20\x20\x20\x20</thead>\x0a\x20\x20\x20\x20<tbody>\x0a\x20\x20\x20\x20\x20\x20{{\x20range\x20$fields\x20}}\x0a\x20\x20\x20\x20\x20\x20<tr>\x0a\x20\x20\x20\x20\x20\x20\x20\x20<td>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<ul\x20class=\"field-names\">\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{range\x20.Names}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<li>{{\x20.Name\x20}}</li>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{end}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20</ul>\x0a\x20\x20\x20\x20\x20\x20\x20\x20</td>\x0a\x20\x20\x20\x20\x20\x20\x20\x20<td>{{\x20node_html\x20$package\x20.Field.Type\x20true\x20|\x20unescaped\x20}}</td>\x0a\x20\x20\x20\x20\x20\x20\x20\x20<td>{{\x20.Documentation\x20|\x20unescaped\x20}}</td>\x0a\x20\x20\x20\x20\x20\x20</tr>\x0a\x20\x20\x20\x20\x20\x20{{\x20end\x20}}\x0a\x20\x20\x20\x20</tbody>\x0a\x20\x20</table>\x0a\x20\x20{{\x20end\x20}}\x0a\x20\x20{{\x20end\x20}}\x0a\x20\x20<!--\x20end\x20fields\x20-->\x0a\x0a\x0a\x20\x20{{range\x20.Consts}}\x0a\x20\x20{{comment_html\x20.Doc\x20|\x20unescaped}}\x0a\x20\x20<pre>{{-\x20node_html\x20$package\x20.Decl\x20true\x20|\x20unescaped\x20-}}</pre>\x0a\x20\x20{{end}}\x0a\x0a\x20\x20{{range\x20.Vars}}\x0a\x20\x20{{comment_html\x20.Doc\x20|\x20unescaped}}\x0a\x20\x20<pre>{{-\x20node_html\x20$package\x20.Decl\x20true\x20|\x20unescaped\x20-}}</pre>\x0a\x20\x20{{end}}\x0a\x0a\x20\x20{{example_html\x20$package\x20$tname\x20|\x20unescaped}}\x0a\x0a\x20\x20<!--\x20funcs\x20-->\x0a\x20\x20{{-\x20$funcs\x20:=\x20indent_filter\x20.Funcs\x20-}}\x0a\x20\x20{{\x20with\x20$funcs}}\x0a\x20\x20\x20\x20<h2>Funcs</h2>\x0a\x0a\x20\x20\x20\x20{{\x20range\x20.\x20}}\x0a\x20\x20\x20\x20{{\x20$name_html\x20:=\x20html\x20.Name\x20}}\x0a\x20\x20\x20\x20<div\x20class=\"funcs\x20my-3\">\x0a\x20\x20\x20\x20\x20\x20<h3\x20id=\"{{$name_html}}\">\x0a\x20\x20\x20\x20\x20\x20\x20\x20func\x0a\x20\x20\x20\x20\x20\x20\x20\x20<a\x20href=\"/{{-\x20$package.ImportPath\x20-}}/{{-\x20$type_name_html\x20-}}.{{-\x20$name_html\x20-}}.html\"\x20title=\"{{-\x20$name_html\x20-}}\">{{-\x20$name_html\x20-}}</a>\x0a\x20\x20\x20\x20\x20\x20\x20\x20<a\x20class=\"permalink\"\x20href=\"#{{-\x20$name_html\x20-}}\">&#xb6;</a>\x0a\x20\x20\x20\x20\x20\x20</h3>\x0a\x0a\x20\x20\x20\x20\x20\x20{{\x20if\x20.Decl\x20}}\x0a\x20\x20\x20\x20\x20\x20<pre>{{-\x20node_html\x20$package\x20.Decl\x20true\x20|\x20unescaped\x20-}}</pre>\x0a\x20\x20\x20\x20\x20\x20{{\x20end\x20}}\x0a\x0a\x20\x20\x20\x20\x20\x20{{\x20.Documentation.Summary.HTML\x20|\x20unescaped\x20}}\x0a\x20\x20\x20\x20</div>\x0a\x20\x20\x20\x20{{\x20end\x20}}\x0a\x20\x20{{\x20end\x20}}\x0a\x20\x20<!--\x20end\x20funcs\x20-->\x0a\x0a\x20\x20<!--\x20methods\x20-->\x0a\x20\x20{{-\x20$methods\x20:=\x20indent_filter\x20.Methods\x20-}}\x0a\x20\x20{{\x20with\x20$methods\x20}}\x0a\x20\x20\x20\x20<h2>Methods</h2>\x0a\x0a\x20\x20\x20\x20{{\x20range\x20.\x20}}\x0a\x20\x20\x20\x20{{\x20$name_html\x20:=\x20html\x20.Name\x20}}\x0a\x20\x20\x20\x20<div\x20class=\"methods\x20my-3\">\x0a\x20\x20\x20\x20\x20\x20<h3\x20id=\"{{$name_html}}\">\x0a\x20\x20\x20\x20\x20\x20\x20\x20func\x20({{html\x20.Recv}})\x0a\x20\x20\x20\x20\x20\x20\x20\x20<a\x20href=\"/{{-\x20$package.ImportPath\x20-}}/{{-\x20$type_name_html\x20-}}.{{-\x20$name_html\x20-}}.html\"\x20title=\"{{-\x20$name_html\x20-}}\">{{-\x20$name_html\x20-}}</a>\x0a\x20\x20\x20\x20\x20\x20\x20\x20<a\x20class=\"permalink\"\x20href=\"#{{-\x20$name_html\x20-}}\">&#xb6;</a>\x0a\x20\x20\x20\x20\x20\x20</h3>\x0a\x0a\x20\x20\x20\x20\x20\x20{{\x20if\x20.Decl\x20}}\x0a\x20\x20\x20\x20\x20\x20<pre>{{-\x20node_html\x20$package\x20.Decl\x20true\x20|\x20unescaped\x20-}}</pre>\x0a\x20\x20\x20\x20\x20\x20{{\x20end\x20}}\x0a\x0a\x20\x20\x20\x20\x20\x20{{\x20.Documentation.Summary.HTML\x20|\x20unescaped\x20}}\x0a\x20\x20\x20\x20</div>\x0a\x20\x20\x20\x20{{\x20end\x20}}\x0a\x20\x20{{\x20end\x20}}\x0a\x20\x20<!--\x20end\x20methods\x20-->\x0a\x0a{{-\x20end\x20}}\x0a<!--\x20end\x20type.html\x20-->",
+	"type.html": "<!--\x20type.html\x20-->\x0a{{-\x20$package\x20:=\x20.Package\x20-}}\x0a\x0a{{-\x20with\x20.Type\x20-}}\x0a\x0a\x20\x20{{\x20$tname\x20:=\x20.Name\x20}}\x0a\x20\x20{{\x20$type_name_html\x20:=\x20html\x20.Name\x20}}\x0a\x0a\x20\x20<h1\x20id=\"type-title-{{\x20html\x20$package.Name\x20}}-{{-\x20$type_name_html\x20-}}\">{{-\x20$type_name_html\x20-}}\x0a\x20\x20\x20\x20{{-\x20with\x20since\x20\"type\"\x20\"\"\x20.Name\x20$package.ImportPath\x20}}\x20<span\x20class=\"badge\x20badge-since\"\x20title=\"Added\x20in\x20{{\x20.\x20}}\">since\x20{{\x20.\x20}}</span>{{\x20end\x20-}}\x0a\x20\x20</h1>\x0a\x0a\x20\x20{{\x20.Documentation.Body\x20|\x20unescaped\x20}}\x0a\x0a\x20\x20<!--\x0a\x20\x20\x20\x20<pre>\x0a\x20\x20\x20\x20\x20\x20{{-\x20node_html\x20$package\x20.Decl\x20true\x20|\x20unescaped\x20-}}\x0a\x20\x20\x20\x20</pre>\x0a\x20\x20-->\x0a\x0a\x20\x20<!--\x20fields\x20-->\x0a\x20\x20{{-\x20$fields\x20:=\x20indent_filter\x20.Fields\x20-}}\x0a\x0a\x20\x20{{\x20if\x20eq\x20.TypeSpec\x20\"struct\"\x20}}\x0a\x20\x20{{\x20if\x20gt\x20(len\x20$fields)\x200\x20}}\x0a\x20\x20<h2>Fields</h2>\x0a\x20\x20<table\x20class=\"table-fields\">\x0a\x20\x20\x20\x20<thead>\x0a\x20\x20\x20\x20\x20\x20<tr>\x0a\x20\x20\x20\x20\x20\x20\x20\x20<th>Name</th>\x0a\x20\x20\x20\x20\x20\x20\x20\x20<th>Type</th>\x0a\x20\x20\x20\x20\x20\x20\x20\x20<th>Description</th>\x0a\x20\x20\x20\x20\x20\x20</tr>\x0a\x20\x20\x20\x20</thead>\x0a\x20\x20\x20\x20<tbody>\x0a\x20\x20\x20\x20\x20\x20{{\x20range\x20$fields\x20}}\x0a\x20\x20\x20\x20\x20\x20<tr>\x0a\x20\x20\x20\x20\x20\x20\x20\x20<td>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<ul\x20class=\"field-names\">\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{range\x20.Names}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20<li\x20id=\"{{\x20$tname\x20}}.{{\x20.Name\x20}}\">{{\x20.Name\x20}}{{\x20with\x20since\x20\"field\"\x20$tname\x20.Name\x20$package.ImportPath\x20}}\x20<span\x20class=\"badge\x20badge-since\"\x20title=\"Added\x20in\x20{{\x20.\x20}}\">since\x20{{\x20.\x20}}</span>{{\x20end\x20}}</li>\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20{{end}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20</ul>\x0a\x20\x20\x20\x20\x20\x20\x20\x20</td>\x0a\x20\x20\x20\x20\x20\x20\x20\x20<td>{{\x20node_html\x20$package\x20.Field.Type\x20true\x20|\x20unescaped\x20}}</td>\x0a\x20\x20\x20\x20\x20\x20\x20\x20<td>{{\x20.Documentation\x20|\x20unescaped\x20}}</td>\x0a\x20\x20\x20\x20\x20\x20</tr>\x0a\x20\x20\x20\x20\x20\x20{{\x20end\x20}}\x0a\x20\x20\x20\x20</tbody>\x0a\x20\x20</table>\x0a\x20\x20{{\x20end\x20}}\x0a\x20\x20{{\x20end\x20}}\x0a\x20\x20<!--\x20end\x20fields\x20-->\x0a\x0a\x0a\x20\x20{{range\x20.Consts}}\x0a\x20\x20{{comment_html\x20.Doc\x20|\x20unescaped}}\x0a\x20\x20<pre>{{-\x20node_html\x20$package\x20.Decl\x20true\x20|\x20unescaped\x20-}}</pre>\x0a\x20\x20{{end}}\x0a\x0a\x20\x20{{range\x20.Vars}}\x0a\x20\x20{{comment_html\x20.Doc\x20|\x20unescaped}}\x0a\x20\x20<pre>{{-\x20node_html\x20$package\x20.Decl\x20true\x20|\x20unescaped\x20-}}</pre>\x0a\x20\x20{{end}}\x0a\x0a\x20\x20{{example_html\x20$package\x20$tname\x20|\x20unescaped}}\x0a\x0a\x20\x20<!--\x20funcs\x20-->\x0a\x20\x20{{-\x20$funcs\x20:=\x20indent_filter\x20.Funcs\x20-}}\x0a\x20\x20{{\x20with\x20$funcs}}\x0a\x20\x20\x20\x20<h2>Funcs</h2>\x0a\x0a\x20\x20\x20\x20{{\x20range\x20.\x20}}\x0a\x20\x20\x20\x20{{\x20$name_html\x20:=\x20html\x20.Name\x20}}\x0a\x20\x20\x20\x20<div\x20class=\"funcs\x20my-3\">\x0a\x20\x20\x20\x20\x20\x20<h3\x20id=\"{{$name_html}}\">\x0a\x20\x20\x20\x20\x20\x20\x20\x20func\x0a\x20\x20\x20\x20\x20\x20\x20\x20<a\x20href=\"{{-\x20func_url\x20$package.ImportPath\x20$type_name_html\x20.Name\x20-}}\"\x20title=\"{{-\x20$name_html\x20-}}\">{{-\x20$name_html\x20-}}</a>\x0a\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20if\x20.Decl\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20with\x20since\x20\"func\"\x20\"\"\x20.Name\x20$package.ImportPath\x20}}\x20<span\x20class=\"badge\x20badge-since\"\x20title=\"Added\x20in\x20{{\x20.\x20}}\">since\x20{{\x20.\x20}}</span>{{\x20end\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20else\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20with\x20since\x20\"method\"\x20$tname\x20.Name\x20$package.ImportPath\x20}}\x20<span\x20class=\"badge\x20badge-since\"\x20title=\"Added\x20in\x20{{\x20.\x20}}\">since\x20{{\x20.\x20}}</span>{{\x20end\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20<a\x20class=\"permalink\"\x20href=\"#{{-\x20$name_html\x20-}}\">&#xb6;</a>\x0a\x20\x20\x20\x20\x20\x20</h3>\x0a\x0a\x20\x20\x20\x20\x20\x20{{\x20if\x20.Decl\x20}}\x0a\x20\x20\x20\x20\x20\x20<pre>{{-\x20node_html\x20$package\x20.Decl\x20true\x20|\x20unescaped\x20-}}</pre>\x0a\x20\x20\x20\x20\x20\x20{{\x20end\x20}}\x0a\x0a\x20\x20\x20\x20\x20\x20{{\x20.Documentation.Summary.HTML\x20|\x20unescaped\x20}}\x0a\x20\x20\x20\x20</div>\x0a\x20\x20\x20\x20{{\x20end\x20}}\x0a\x20\x20{{\x20end\x20}}\x0a\x20\x20<!--\x20end\x20funcs\x20-->\x0a\x0a\x20\x20<!--\x20methods\x20-->\x0a\x20\x20{{-\x20$methods\x20:=\x20indent_filter\x20.Methods\x20-}}\x0a\x20\x20{{\x20with\x20$methods\x20}}\x0a\x20\x20\x20\x20<h2>Methods</h2>\x0a\x0a\x20\x20\x20\x20{{\x20range\x20.\x20}}\x0a\x20\x20\x20\x20{{\x20$name_html\x20:=\x20html\x20.Name\x20}}\x0a\x20\x20\x20\x20<div\x20class=\"methods\x20my-3\">\x0a\x20\x20\x20\x20\x20\x20<h3\x20id=\"{{$name_html}}\">\x0a\x20\x20\x20\x20\x20\x20\x20\x20func\x20({{html\x20.Recv}})\x0a\x20\x20\x20\x20\x20\x20\x20\x20<a\x20href=\"{{-\x20func_url\x20$package.ImportPath\x20$type_name_html\x20.Name\x20-}}\"\x20title=\"{{-\x20$name_html\x20-}}\">{{-\x20$name_html\x20-}}</a>\x0a\x20\x20\x20\x20\x20\x20\x20\x20{{-\x20with\x20since\x20\"method\"\x20.Recv\x20.Name\x20$package.ImportPath\x20}}\x20<span\x20class=\"badge\x20badge-since\"\x20title=\"Added\x20in\x20{{\x20.\x20}}\">since\x20{{\x20.\x20}}</span>{{\x20end\x20}}\x0a\x20\x20\x20\x20\x20\x20\x20\x20<a\x20class=\"permalink\"\x20href=\"#{{-\x20$name_html\x20-}}\">&#xb6;</a>\x0a\x20\x20\x20\x20\x20\x20</h3>\x0a\x0a\x20\x20\x20\x20\x20\x20{{\x20if\x20.Decl\x20}}\x0a\x20\x20\x20\x20\x20\x20<pre>{{-\x20node_html\x20$package\x20.Decl\x20true\x20|\x20unescaped\x20-}}</pre>\x0a\x20\x20\x20\x20\x20\x20{{\x20end\x20}}\x0a\x0a\x20\x20\x20\x20\x20\x20{{\x20.Documentation.Summary.HTML\x20|\x20unescaped\x20}}\x0a\x20\x20\x20\x20</div>\x0a\x20\x20\x20\x20{{\x20end\x20}}\x0a\x20\x20{{\x20end\x20}}\x0a\x20\x20<!--\x20end\x20methods\x20-->\x0a\x0a\x20\x20<!--\x20implements\x20-->\x0a\x20\x20{{-\x20with\x20implementations\x20$package.ImportPath\x20.Name\x20}}\x0a\x20\x20{{-\x20with\x20.Implements\x20}}\x0a\x20\x20<h2\x20id=\"implements\">Implements</h2>\x0a\x20\x20<ul\x20class=\"list-implements\">\x0a\x20\x20\x20\x20{{-\x20range\x20.\x20}}\x0a\x20\x20\x20\x20<li><code>{{\x20if\x20.Pointer\x20}}*{{\x20end\x20}}{{\x20$tname\x20}}</code>\x20implements\x20<a\x20href=\"{{\x20docLink\x20.ImportPath\x20.Name\x20}}\">{{\x20.Qualified\x20$package.ImportPath\x20}}</a></li>\x0a\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20</ul>\x0a\x20\x20{{-\x20end\x20}}\x0a\x0a\x20\x20{{-\x20with\x20.ImplementedBy\x20}}\x0a\x20\x20<h2\x20id=\"implemented-by\">Implemented\x20by</h2>\x0a\x20\x20<ul\x20class=\"list-implements\">\x0a\x20\x20\x20\x20{{-\x20range\x20.\x20}}\x0a\x20\x20\x20\x20<li><a\x20href=\"{{\x20docLink\x20.ImportPath\x20.Name\x20}}\"><code>{{\x20if\x20.Pointer\x20}}*{{\x20end\x20}}{{\x20.Qualified\x20$package.ImportPath\x20}}</code></a></li>\x0a\x20\x20\x20\x20{{-\x20end\x20}}\x0a\x20\x20</ul>\x0a\x20\x20{{-\x20end\x20}}\x0a\x20\x20{{-\x20end\x20}}\x0a\x20\x20<!--\x20end\x20implements\x20-->\x0a\x0a\x20\x20{{-\x20references_html\x20.Name\x20.Object\x20}}\x0a\x0a{{-\x20end\x20}}\x0a<!--\x20end\x20type.html\x20-->",
 }